@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 
 	"github.com/alecthomas/kong"
 	"github.com/steipete/spogo/internal/app"
@@ -54,7 +55,23 @@ func run(args []string, out io.Writer, errOut io.Writer) int {
 		return 1
 	}
 	ctx.SetCommandContext(context.Background())
-	if err := ctx.ValidateProfile(); err != nil {
+	profiles, err := ctx.FanOutProfiles()
+	if err != nil {
+		_, _ = fmt.Fprintln(errOut, err)
+		return 2
+	}
+	if profiles != nil {
+		if refusal := cli.FanOutRefusal(kctx.Command()); refusal != "" {
+			_, _ = fmt.Fprintln(errOut, refusal)
+			return 2
+		}
+		results := ctx.RunProfiles(profiles, func(profileCtx *app.Context) error {
+			return kctx.Run(profileCtx)
+		})
+		return ctx.EmitProfileResults(results)
+	}
+	// config commands are how a bad setting gets fixed, so they skip the check.
+	if err := ctx.ValidateProfile(); err != nil && !strings.HasPrefix(kctx.Command(), "config ") {
 		_, _ = fmt.Fprintln(errOut, err)
 		return 2
 	}
@@ -71,18 +88,41 @@ func normalizeArgs(args []string) []string {
 	}
 	front := make([]string, 0, 1)
 	rest := make([]string, 0, len(args))
-	for _, arg := range args {
-		if arg == "--no-input" {
+	negative := make([]string, 0, 1)
+	terminated := false
+	for i, arg := range args {
+		switch {
+		case terminated:
+			rest = append(rest, arg)
+		case arg == "--":
+			terminated = true
+			rest = append(rest, arg)
+		case arg == "--no-input":
 			front = append(front, arg)
-			continue
+		case isNegativeArg(arg) && i > 0 && !strings.HasPrefix(args[i-1], "-"):
+			negative = append(negative, arg)
+		default:
+			rest = append(rest, arg)
 		}
-		rest = append(rest, arg)
 	}
-	if len(front) == 0 {
+	if len(front) == 0 && len(negative) == 0 {
 		return args
 	}
-	normalized := make([]string, 0, len(args))
+	normalized := make([]string, 0, len(args)+1)
 	normalized = append(normalized, front...)
 	normalized = append(normalized, rest...)
+	if len(negative) > 0 {
+		if !terminated {
+			normalized = append(normalized, "--")
+		}
+		normalized = append(normalized, negative...)
+	}
 	return normalized
 }
+
+// isNegativeArg reports positional values such as `-10` or `-1:00` that the
+// parser would otherwise reject as unknown short flags. They are moved behind
+// `--` so relative `seek` and `volume` steps work without quoting tricks.
+func isNegativeArg(arg string) bool {
+	return len(arg) > 1 && arg[0] == '-' && arg[1] >= '0' && arg[1] <= '9'
+}
@@ -6,8 +6,12 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/alecthomas/kong"
+	"github.com/steipete/spogo/internal/cli"
 	"github.com/steipete/spogo/internal/cookies"
 )
 
@@ -63,6 +67,43 @@ func TestRunInvalidProfile(t *testing.T) {
 	}
 }
 
+func TestRunConfigFixesInvalidProfile(t *testing.T) {
+	out := &bytes.Buffer{}
+	errOut := &bytes.Buffer{}
+	configPath := filepath.Join(t.TempDir(), "config.toml")
+	if err := os.WriteFile(configPath, []byte("[profile.default]\nmarket = \"USA\"\n"), 0o644); err != nil {
+		t.Fatalf("config: %v", err)
+	}
+	if code := run([]string{"--config", configPath, "queue", "clear"}, out, errOut); code != 2 {
+		t.Fatalf("expected 2, got %d", code)
+	}
+	code := run([]string{"--config", configPath, "config", "set", "market", "us"}, out, errOut)
+	if code != 0 {
+		t.Fatalf("expected 0, got %d; err=%q", code, errOut.String())
+	}
+}
+
+func TestRunProfilesFanOut(t *testing.T) {
+	out := &bytes.Buffer{}
+	errOut := &bytes.Buffer{}
+	configPath := filepath.Join(t.TempDir(), "config.toml")
+	if err := os.WriteFile(configPath, []byte("[profile.work]\nmarket = \"US\"\n"), 0o644); err != nil {
+		t.Fatalf("config: %v", err)
+	}
+	if code := run([]string{"--config", configPath, "--profiles", "work,nope", "auth", "status"}, out, errOut); code != 2 {
+		t.Fatalf("expected 2 for unknown profile, got %d", code)
+	}
+	if code := run([]string{"--config", configPath, "--all-profiles", "config", "get"}, out, errOut); code != 2 || !strings.Contains(errOut.String(), "once per profile") {
+		t.Fatalf("expected refusal, got %d %q", code, errOut.String())
+	}
+	if code := run([]string{"--config", configPath, "--profile", "work", "--all-profiles", "status"}, out, errOut); code != 2 {
+		t.Fatalf("expected 2 for --profile with --all-profiles, got %d", code)
+	}
+	if code := run([]string{"--config", configPath, "--profiles", "work,default", "--plain", "alarm", "list"}, out, errOut); code != 0 {
+		t.Fatalf("expected 0, got %d; err=%q", code, errOut.String())
+	}
+}
+
 func TestRunCommandError(t *testing.T) {
 	out := &bytes.Buffer{}
 	errOut := &bytes.Buffer{}
@@ -114,3 +155,85 @@ func TestMain(t *testing.T) {
 		t.Fatalf("expected 0, got %d", got)
 	}
 }
+
+func TestNormalizeArgsMovesNegativeValues(t *testing.T) {
+	cases := []struct {
+		in   []string
+		want []string
+	}{
+		{[]string{"volume", "-10"}, []string{"volume", "--", "-10"}},
+		{[]string{"seek", "-1:00", "--device", "Kitchen"}, []string{"seek", "--device", "Kitchen", "--", "-1:00"}},
+		{[]string{"--timeout", "-5s", "status"}, []string{"--timeout", "-5s", "status"}},
+		{[]string{"volume", "--", "-10"}, []string{"volume", "--", "-10"}},
+		{[]string{"-5"}, []string{"-5"}},
+	}
+	for _, tc := range cases {
+		if got := normalizeArgs(tc.in); fmt.Sprint(got) != fmt.Sprint(tc.want) {
+			t.Fatalf("normalizeArgs(%v) = %v, want %v", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestCommandsParse(t *testing.T) {
+	cases := []struct {
+		args    []string
+		command string
+		check   func(*cli.CLI) bool
+	}{
+		{[]string{"volume", "-10"}, "volume set <level>", func(c *cli.CLI) bool { return c.Volume.Set.Level == "-10" }},
+		{[]string{"volume", "+5"}, "volume set <level>", func(c *cli.CLI) bool { return c.Volume.Set.Level == "+5" }},
+		{[]string{"seek", "-1:00", "--device", "Kitchen"}, "seek <position>", func(c *cli.CLI) bool { return c.Seek.Position == "-1:00" }},
+		{[]string{"volume", "fade", "20", "--over", "10s"}, "volume fade <target>", func(c *cli.CLI) bool {
+			return c.Volume.Fade.Target == "20" && c.Volume.Fade.Over == 10*time.Second
+		}},
+		{[]string{"alarm", "add", "07:00", "--days", "mon-fri", "--play", "spotify:playlist:p1", "--device", "Kitchen", "--volume-ramp", "5m"}, "alarm add <time>", func(c *cli.CLI) bool {
+			return c.Alarm.Add.Days == "mon-fri" && c.Globals.Device == "Kitchen" && c.Alarm.Add.VolumeRamp == "5m"
+		}},
+		{[]string{"device", "volume", "kitchen", "30"}, "device volume <device> <level>", func(c *cli.CLI) bool {
+			return c.Device.Volume.Device == "kitchen" && c.Device.Volume.Level == 30
+		}},
+		{[]string{"device", "set", "Kitchen", "--wait", "--wait-timeout", "5s"}, "device set <device>", func(c *cli.CLI) bool {
+			return c.Device.Set.Wait && c.Device.Set.WaitTimeout == 5*time.Second
+		}},
+		{[]string{"artist", "appears-on", "spotify:artist:a1", "--limit", "5", "--offset", "10"}, "artist appears-on <artist>", func(c *cli.CLI) bool {
+			return c.Artist.AppearsOn.Limit == 5 && c.Artist.AppearsOn.Offset == 10
+		}},
+		{[]string{"audiobook", "chapters", "spotify:audiobook:b1", "--limit", "5"}, "audiobook chapters <audiobook>", func(c *cli.CLI) bool { return c.Audiobook.Chapters.Limit == 5 }},
+		{[]string{"library", "audiobooks", "add", "b1"}, "library audiobooks add <i-ds>", nil},
+		{[]string{"top", "artists", "--range", "short", "--limit", "5"}, "top artists", func(c *cli.CLI) bool {
+			return c.Top.Artists.Range == "short" && c.Top.Artists.Limit == 5
+		}},
+		{[]string{"recent", "--after", "24h"}, "recent", func(c *cli.CLI) bool { return c.Recent.After == "24h" }},
+		{[]string{"me"}, "me", nil},
+		{[]string{"recommend", "--seed-tracks", "t1,t2", "--seed-genres", "jazz", "--into-playlist", "p1"}, "recommend", func(c *cli.CLI) bool {
+			return len(c.Recommend.SeedTracks) == 2 && c.Recommend.IntoPlaylist == "p1"
+		}},
+		{[]string{"browse", "new-releases", "--limit", "5"}, "browse new-releases", func(c *cli.CLI) bool { return c.Browse.NewReleases.Limit == 5 }},
+		{[]string{"radio", "a1", "--type", "artist"}, "radio <seed>", func(c *cli.CLI) bool { return c.Radio.Type == "artist" }},
+		{[]string{"profile", "copy", "work", "work-de", "--cookies"}, "profile copy <from> <to>", func(c *cli.CLI) bool {
+			return c.Profile.Copy.To == "work-de" && c.Profile.Copy.Cookies
+		}},
+		{[]string{"profile", "show"}, "profile show", nil},
+		{[]string{"config", "set", "market", "US"}, "config set <key> <value>", func(c *cli.CLI) bool { return c.Config.Set.Value == "US" }},
+		{[]string{"auth", "migrate"}, "auth migrate", func(c *cli.CLI) bool { return c.Auth.Migrate.To == "keyring" }},
+		{[]string{"auth", "migrate", "--to", "file"}, "auth migrate", func(c *cli.CLI) bool { return c.Auth.Migrate.To == "file" }},
+		{[]string{"auth", "check", "--warn-within", "72h"}, "auth check", func(c *cli.CLI) bool { return c.Auth.Check.WarnWithin == 72*time.Hour }},
+	}
+	for _, tc := range cases {
+		command := cli.New()
+		parser, err := kong.New(command, kong.Vars(cli.VersionVars()))
+		if err != nil {
+			t.Fatalf("kong: %v", err)
+		}
+		kctx, err := parser.Parse(normalizeArgs(tc.args))
+		if err != nil {
+			t.Fatalf("parse %v: %v", tc.args, err)
+		}
+		if kctx.Command() != tc.command {
+			t.Fatalf("parse %v: command %q, want %q", tc.args, kctx.Command(), tc.command)
+		}
+		if tc.check != nil && !tc.check(command) {
+			t.Fatalf("parse %v: unexpected fields %#v", tc.args, command)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+// Package alarm parses alarm schedules and works out when they fire next.
+package alarm
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/steipete/spogo/internal/config"
+)
+
+// weekOrder lists day names Monday first, the order schedules are stored and
+// shown in.
+var weekOrder = []string{"mon", "tue", "wed", "thu", "fri", "sat", "sun"}
+
+var dayIndex = map[string]int{"mon": 0, "tue": 1, "wed": 2, "thu": 3, "fri": 4, "sat": 5, "sun": 6}
+
+// ParseClock parses a 24h HH:MM time of day.
+func ParseClock(input string) (int, int, error) {
+	hourText, minuteText, ok := strings.Cut(strings.TrimSpace(input), ":")
+	if !ok {
+		return 0, 0, fmt.Errorf("time %q must be HH:MM", input)
+	}
+	hour, herr := strconv.Atoi(hourText)
+	minute, merr := strconv.Atoi(minuteText)
+	if herr != nil || merr != nil || hour < 0 || hour > 23 || minute < 0 || minute > 59 || len(minuteText) != 2 {
+		return 0, 0, fmt.Errorf("time %q must be HH:MM", input)
+	}
+	return hour, minute, nil
+}
+
+// ParseDays turns `mon-fri`, `sat,sun`, `weekdays`, `weekends` or `daily`
+// into canonical three-letter names, Monday first. Every day is returned as
+// nil, which is also what an alarm with no days means.
+func ParseDays(input string) ([]string, error) {
+	input = strings.ToLower(strings.TrimSpace(input))
+	switch input {
+	case "", "daily", "everyday", "all":
+		return nil, nil
+	case "weekdays":
+		input = "mon-fri"
+	case "weekends":
+		input = "sat-sun"
+	}
+	var selected [7]bool
+	for _, part := range strings.Split(input, ",") {
+		from, to, isRange := strings.Cut(strings.TrimSpace(part), "-")
+		start, err := parseDay(from)
+		if err != nil {
+			return nil, err
+		}
+		end := start
+		if isRange {
+			if end, err = parseDay(to); err != nil {
+				return nil, err
+			}
+		}
+		for day := start; ; day = (day + 1) % 7 {
+			selected[day] = true
+			if day == end {
+				break
+			}
+		}
+	}
+	days := make([]string, 0, 7)
+	for i, ok := range selected {
+		if ok {
+			days = append(days, weekOrder[i])
+		}
+	}
+	if len(days) == 7 {
+		return nil, nil
+	}
+	return days, nil
+}
+
+func parseDay(input string) (int, error) {
+	input = strings.TrimSpace(input)
+	if len(input) >= 3 {
+		if idx, ok := dayIndex[input[:3]]; ok && strings.HasPrefix(fullDayName(idx), input) {
+			return idx, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown day %q", input)
+}
+
+func fullDayName(idx int) string {
+	return strings.ToLower(time.Weekday((idx + 1) % 7).String())
+}
+
+// FormatDays renders stored days for display.
+func FormatDays(days []string) string {
+	if len(days) == 0 {
+		return "daily"
+	}
+	return strings.Join(days, ",")
+}
+
+// Validate checks the fields an alarm needs to fire.
+func Validate(a config.Alarm) error {
+	if _, _, err := ParseClock(a.Time); err != nil {
+		return err
+	}
+	if strings.TrimSpace(a.Play) == "" {
+		return errors.New("alarm needs something to play")
+	}
+	for _, day := range a.Days {
+		if _, ok := dayIndex[day]; !ok {
+			return fmt.Errorf("unknown day %q", day)
+		}
+	}
+	if a.Volume < 0 || a.Volume > 100 {
+		return errors.New("volume must be 0-100")
+	}
+	if _, err := Ramp(a); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Ramp returns the alarm's volume ramp duration (zero when unset).
+func Ramp(a config.Alarm) (time.Duration, error) {
+	if a.VolumeRamp == "" {
+		return 0, nil
+	}
+	ramp, err := time.ParseDuration(a.VolumeRamp)
+	if err != nil || ramp < 0 {
+		return 0, fmt.Errorf("invalid volume ramp %q", a.VolumeRamp)
+	}
+	return ramp, nil
+}
+
+// Next returns the first time strictly after `after` at which the alarm
+// fires, in after's location.
+func Next(a config.Alarm, after time.Time) (time.Time, error) {
+	hour, minute, err := ParseClock(a.Time)
+	if err != nil {
+		return time.Time{}, err
+	}
+	allowed := map[time.Weekday]bool{}
+	for _, day := range a.Days {
+		idx, ok := dayIndex[day]
+		if !ok {
+			return time.Time{}, fmt.Errorf("unknown day %q", day)
+		}
+		allowed[time.Weekday((idx+1)%7)] = true
+	}
+	year, month, day := after.Date()
+	for offset := 0; offset <= 7; offset++ {
+		candidate := time.Date(year, month, day+offset, hour, minute, 0, 0, after.Location())
+		if !candidate.After(after) {
+			continue
+		}
+		if len(allowed) == 0 || allowed[candidate.Weekday()] {
+			return candidate, nil
+		}
+	}
+	return time.Time{}, errors.New("alarm never fires")
+}
+
+// NextID picks the smallest positive numeric id not used by alarms.
+func NextID(alarms []config.Alarm) string {
+	used := map[string]bool{}
+	for _, a := range alarms {
+		used[a.ID] = true
+	}
+	for id := 1; ; id++ {
+		if !used[strconv.Itoa(id)] {
+			return strconv.Itoa(id)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package alarm
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steipete/spogo/internal/config"
+)
+
+func TestParseClock(t *testing.T) {
+	if h, m, err := ParseClock(" 7:05 "); err != nil || h != 7 || m != 5 {
+		t.Fatalf("got %d:%d %v", h, m, err)
+	}
+	for _, bad := range []string{"", "7", "24:00", "07:60", "07:5", "aa:bb", "-1:00"} {
+		if _, _, err := ParseClock(bad); err == nil {
+			t.Fatalf("expected error for %q", bad)
+		}
+	}
+}
+
+func TestParseDays(t *testing.T) {
+	cases := map[string]string{
+		"":             "",
+		"daily":        "",
+		"mon-sun":      "",
+		"mon-fri":      "mon,tue,wed,thu,fri",
+		"weekdays":     "mon,tue,wed,thu,fri",
+		"Weekends":     "sat,sun",
+		"sun,sat":      "sat,sun",
+		"fri-mon":      "mon,fri,sat,sun",
+		"Tuesday, thu": "tue,thu",
+		"wed":          "wed",
+	}
+	for input, want := range cases {
+		days, err := ParseDays(input)
+		if err != nil || strings.Join(days, ",") != want {
+			t.Fatalf("ParseDays(%q) = %v %v, want %q", input, days, err, want)
+		}
+	}
+	for _, bad := range []string{"funday", "mo", "mon-xyz", "monx"} {
+		if _, err := ParseDays(bad); err == nil {
+			t.Fatalf("expected error for %q", bad)
+		}
+	}
+	if FormatDays(nil) != "daily" || FormatDays([]string{"sat", "sun"}) != "sat,sun" {
+		t.Fatalf("format")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	ok := config.Alarm{Time: "07:00", Play: "spotify:playlist:p1", Days: []string{"mon"}, Volume: 40, VolumeRamp: "5m"}
+	if err := Validate(ok); err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+	bad := []config.Alarm{
+		{Time: "7am", Play: "x"},
+		{Time: "07:00"},
+		{Time: "07:00", Play: "x", Days: []string{"monday"}},
+		{Time: "07:00", Play: "x", Volume: 101},
+		{Time: "07:00", Play: "x", VolumeRamp: "soon"},
+		{Time: "07:00", Play: "x", VolumeRamp: "-1m"},
+	}
+	for _, a := range bad {
+		if err := Validate(a); err == nil {
+			t.Fatalf("expected error for %#v", a)
+		}
+	}
+	if ramp, err := Ramp(config.Alarm{}); err != nil || ramp != 0 {
+		t.Fatalf("ramp %v %v", ramp, err)
+	}
+}
+
+func TestNext(t *testing.T) {
+	// 2026-10-16 is a Friday.
+	friday := time.Date(2026, 10, 16, 6, 30, 0, 0, time.UTC)
+	daily := config.Alarm{Time: "07:00"}
+	if got, _ := Next(daily, friday); !got.Equal(time.Date(2026, 10, 16, 7, 0, 0, 0, time.UTC)) {
+		t.Fatalf("same day: %v", got)
+	}
+	atSeven := time.Date(2026, 10, 16, 7, 0, 0, 0, time.UTC)
+	if got, _ := Next(daily, atSeven); !got.Equal(time.Date(2026, 10, 17, 7, 0, 0, 0, time.UTC)) {
+		t.Fatalf("strictly after: %v", got)
+	}
+	weekdays := config.Alarm{Time: "07:00", Days: []string{"mon", "tue", "wed", "thu", "fri"}}
+	if got, _ := Next(weekdays, atSeven); !got.Equal(time.Date(2026, 10, 19, 7, 0, 0, 0, time.UTC)) {
+		t.Fatalf("skip weekend: %v", got)
+	}
+	if got, _ := Next(config.Alarm{Time: "06:00", Days: []string{"fri"}}, friday); got.Weekday() != time.Friday || got.Day() != 23 {
+		t.Fatalf("next week: %v", got)
+	}
+	if _, err := Next(config.Alarm{Time: "bad"}, friday); err == nil {
+		t.Fatalf("expected clock error")
+	}
+	if _, err := Next(config.Alarm{Time: "07:00", Days: []string{"xyz"}}, friday); err == nil {
+		t.Fatalf("expected day error")
+	}
+}
+
+func TestNextID(t *testing.T) {
+	if NextID(nil) != "1" {
+		t.Fatalf("first id")
+	}
+	if got := NextID([]config.Alarm{{ID: "1"}, {ID: "3"}}); got != "2" {
+		t.Fatalf("got %q", got)
+	}
+}
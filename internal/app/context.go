@@ -3,29 +3,32 @@ package app
 import (
 	"context"
 	"errors"
-	"fmt"
 	"os"
 	"time"
 
 	"github.com/steipete/spogo/internal/config"
 	"github.com/steipete/spogo/internal/output"
+	"github.com/steipete/spogo/internal/secrets"
 	"github.com/steipete/spogo/internal/spotify"
 )
 
 type Settings struct {
-	ConfigPath string
-	Profile    string
-	Timeout    time.Duration
-	Market     string
-	Language   string
-	Device     string
-	Engine     string
-	Format     output.Format
-	NoColor    bool
-	Quiet      bool
-	Verbose    bool
-	Debug      bool
-	NoInput    bool
+	ConfigPath  string
+	Profile     string
+	Profiles    []string
+	AllProfiles bool
+	Timeout     time.Duration
+	Market      string
+	Language    string
+	Device      string
+	Engine      string
+	WaitDevice  time.Duration
+	Format      output.Format
+	NoColor     bool
+	Quiet       bool
+	Verbose     bool
+	Debug       bool
+	NoInput     bool
 }
 
 type Context struct {
@@ -37,6 +40,7 @@ type Context struct {
 	Output     *output.Writer
 
 	spotifyClient spotify.API
+	secrets       secrets.Store
 	commandCtx    context.Context
 }
 
@@ -64,6 +68,27 @@ func (c *Context) SaveProfile(profile config.Profile) error {
 	return nil
 }
 
+// UpdateProfile edits the current profile in place and saves the config.
+// Unlike SaveProfile it leaves the default profile alone.
+func (c *Context) UpdateProfile(fn func(*config.Profile) error) error {
+	if c == nil {
+		return errors.New("nil context")
+	}
+	if c.Config == nil {
+		return errors.New("nil config")
+	}
+	profile := c.Config.Profile(c.ProfileKey)
+	if err := fn(&profile); err != nil {
+		return err
+	}
+	c.Config.SetProfile(c.ProfileKey, profile)
+	if err := config.Save(c.ConfigPath, c.Config); err != nil {
+		return err
+	}
+	c.Profile = profile
+	return nil
+}
+
 func (c *Context) ResolveCookiePath() string {
 	return config.CookiePath(c.ConfigPath, c.ProfileKey)
 }
@@ -72,7 +97,16 @@ func (c *Context) ResolveCachePath() string {
 	return config.CachePath(c.ConfigPath, c.ProfileKey)
 }
 
+func (c *Context) ResolveStatePath() string {
+	return config.StatePath(c.ConfigPath, c.ProfileKey)
+}
+
 func (c *Context) ClearCache() error {
+	if c.UsesKeyring() {
+		if err := c.Secrets().Delete(secrets.CacheKey(c.ProfileKey)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
 	path := c.ResolveCachePath()
 	if path == "" {
 		return nil
@@ -109,8 +143,11 @@ func (c *Context) SetCommandContext(ctx context.Context) {
 }
 
 func (c *Context) ValidateProfile() error {
-	if c.Profile.Market != "" && len(c.Profile.Market) != 2 {
-		return fmt.Errorf("market must be 2-letter country code")
+	if err := config.ValidateSetting("market", c.Profile.Market); err != nil {
+		return err
 	}
-	return nil
+	if err := c.Profile.ValidateDevice(c.Profile.Device); err != nil {
+		return err
+	}
+	return config.ValidateSetting("wait_device", c.Profile.WaitDevice)
 }
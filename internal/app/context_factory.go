@@ -5,18 +5,21 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
+	"github.com/steipete/spogo/internal/config"
 	"github.com/steipete/spogo/internal/cookies"
+	"github.com/steipete/spogo/internal/secrets"
 	"github.com/steipete/spogo/internal/spotify"
 )
 
 type engineName string
 
 const (
-	engineConnect     engineName = "connect"
-	engineWeb         engineName = "web"
-	engineAuto        engineName = "auto"
-	engineAppleScript engineName = "applescript"
+	engineConnect     engineName = config.EngineConnect
+	engineWeb         engineName = config.EngineWeb
+	engineAuto        engineName = config.EngineAuto
+	engineAppleScript engineName = config.EngineAppleScript
 )
 
 func (c *Context) Spotify() (spotify.API, error) {
@@ -49,7 +52,7 @@ func (c *Context) buildSpotifyClient(source cookies.Source) (spotify.API, error)
 	case engineAppleScript:
 		return c.newAppleScriptClient(source)
 	default:
-		return nil, fmt.Errorf("unknown engine %q (use auto, web, connect, or applescript)", c.engine())
+		return nil, fmt.Errorf("unknown engine %q (use %s)", c.engine(), strings.Join(config.Engines, ", "))
 	}
 }
 
@@ -89,14 +92,20 @@ func (c *Context) newAppleScriptClient(source cookies.Source) (spotify.API, erro
 }
 
 func (c *Context) newConnectClient(source cookies.Source) (*spotify.ConnectClient, error) {
-	return spotify.NewConnectClient(spotify.ConnectOptions{
-		Source:    source,
-		Market:    c.Profile.Market,
-		Language:  c.Profile.Language,
-		Device:    c.Profile.Device,
-		Timeout:   c.Settings.Timeout,
-		CachePath: c.ResolveCachePath(),
-	})
+	opts := spotify.ConnectOptions{
+		Source:     source,
+		Market:     c.Profile.Market,
+		Language:   c.Profile.Language,
+		Device:     c.Profile.Device,
+		Timeout:    c.Settings.Timeout,
+		CachePath:  c.ResolveCachePath(),
+		WaitDevice: c.waitDevice(),
+	}
+	if c.UsesKeyring() {
+		opts.CacheStore = c.Secrets()
+		opts.CacheKey = secrets.CacheKey(c.ProfileKey)
+	}
+	return spotify.NewConnectClient(opts)
 }
 
 func (c *Context) newWebClient(source cookies.Source) (*spotify.Client, error) {
@@ -109,6 +118,13 @@ func (c *Context) newWebClient(source cookies.Source) (*spotify.Client, error) {
 	})
 }
 
+// waitDevice is the profile's wait_device; ValidateProfile has already
+// rejected values that do not parse.
+func (c *Context) waitDevice() time.Duration {
+	wait, _ := time.ParseDuration(c.Profile.WaitDevice)
+	return wait
+}
+
 func (c *Context) engine() engineName {
 	engine := engineName(strings.ToLower(strings.TrimSpace(c.Profile.Engine)))
 	if engine == "" {
@@ -118,6 +134,9 @@ func (c *Context) engine() engineName {
 }
 
 func (c *Context) cookieSource() (cookies.Source, error) {
+	if c.UsesKeyring() {
+		return cookies.StoreSource{Store: c.Secrets(), Key: secrets.CookieKey(c.ProfileKey), Fallback: c.browserSource()}, nil
+	}
 	if c.Profile.CookiePath != "" {
 		return cookies.FileSource{Path: c.Profile.CookiePath}, nil
 	}
@@ -127,11 +146,15 @@ func (c *Context) cookieSource() (cookies.Source, error) {
 			return cookies.FileSource{Path: defaultPath}, nil
 		}
 	}
+	return c.browserSource(), nil
+}
+
+func (c *Context) browserSource() cookies.BrowserSource {
 	return cookies.BrowserSource{
 		Browser: defaultBrowser(c.Profile.Browser),
 		Profile: c.Profile.BrowserProfile,
 		Domain:  "spotify.com",
-	}, nil
+	}
 }
 
 func defaultBrowser(browser string) string {
@@ -0,0 +1,157 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/steipete/spogo/internal/output"
+)
+
+// ProfileResult is one profile's run under --profiles/--all-profiles, with
+// its output captured so runs can go in parallel.
+type ProfileResult struct {
+	Profile string
+	Stdout  bytes.Buffer
+	Stderr  bytes.Buffer
+	Err     error
+}
+
+// FanOutProfiles lists the profiles --profiles or --all-profiles asked for,
+// or nil when the command runs against a single profile.
+func (c *Context) FanOutProfiles() ([]string, error) {
+	if c.Settings.AllProfiles {
+		return c.Config.ProfileNames(), nil
+	}
+	names := []string{}
+	seen := map[string]bool{}
+	for _, name := range c.Settings.Profiles {
+		name = strings.TrimSpace(name)
+		if name == "" || seen[name] {
+			continue
+		}
+		if !c.Config.HasProfile(name) {
+			return nil, fmt.Errorf("unknown profile %q", name)
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		return nil, nil
+	}
+	return names, nil
+}
+
+// RunProfiles runs fn once per profile, concurrently, each with its own
+// Context built as if --profile and --no-input had been passed.
+func (c *Context) RunProfiles(names []string, fn func(*Context) error) []*ProfileResult {
+	results := make([]*ProfileResult, len(names))
+	var wg sync.WaitGroup
+	for i, name := range names {
+		result := &ProfileResult{Profile: name}
+		results[i] = result
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result.Err = c.runProfile(result, fn)
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+func (c *Context) runProfile(result *ProfileResult, fn func(*Context) error) error {
+	settings := c.Settings
+	settings.Profile = result.Profile
+	settings.Profiles = nil
+	settings.AllProfiles = false
+	// Runs share stdin and their stderr is only shown at the end, so none of
+	// them may prompt: pickers, interactive search and the keyring passphrase
+	// all behave as under --no-input.
+	settings.NoInput = true
+	ctx, err := NewContext(settings)
+	if err != nil {
+		return err
+	}
+	ctx.Output.Out = &result.Stdout
+	ctx.Output.Err = &result.Stderr
+	ctx.SetCommandContext(c.CommandContext())
+	if err := ctx.ValidateProfile(); err != nil {
+		return WrapExit(2, err)
+	}
+	return fn(ctx)
+}
+
+// EmitProfileResults merges the captured runs in profile order and returns
+// the exit code of the first profile that failed. JSON is one object keyed
+// by profile; plain lines are prefixed with the profile; human output gets
+// a header per profile. Errors and warnings go to stderr prefixed with the
+// profile.
+func (c *Context) EmitProfileResults(results []*ProfileResult) int {
+	code := 0
+	keyed := map[string]any{}
+	plain := []string{}
+	human := []string{}
+	for _, result := range results {
+		for _, line := range outputLines(&result.Stderr) {
+			_, _ = c.Output.Err.Write([]byte(result.Profile + ": " + line + "\n"))
+		}
+		if result.Err != nil {
+			c.Output.Errorf("%s: %v", result.Profile, result.Err)
+			keyed[result.Profile] = map[string]any{"error": result.Err.Error()}
+			if code == 0 {
+				code = ExitCode(result.Err)
+			}
+		} else {
+			keyed[result.Profile] = captured(result.Stdout.Bytes())
+		}
+		lines := outputLines(&result.Stdout)
+		for _, line := range lines {
+			plain = append(plain, result.Profile+"\t"+line)
+		}
+		if len(human) > 0 {
+			human = append(human, "")
+		}
+		human = append(human, c.Output.Theme.Bold(result.Profile))
+		for _, line := range lines {
+			human = append(human, "  "+line)
+		}
+	}
+	var err error
+	switch c.Output.Format {
+	case output.FormatJSON:
+		err = c.Output.Emit(keyed, nil, nil)
+	case output.FormatPlain:
+		err = c.Output.WriteLines(plain)
+	default:
+		err = c.Output.Emit(nil, nil, human)
+	}
+	if err != nil && code == 0 {
+		c.Output.Errorf("%v", err)
+		code = 1
+	}
+	return code
+}
+
+// captured keeps a profile's JSON output as-is; anything else (or nothing)
+// is passed through as a string.
+func captured(data []byte) any {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return map[string]any{}
+	}
+	if json.Valid(data) {
+		return json.RawMessage(data)
+	}
+	return string(data)
+}
+
+func outputLines(buf *bytes.Buffer) []string {
+	text := strings.TrimRight(buf.String(), "\n")
+	if text == "" {
+		return nil
+	}
+	return strings.Split(text, "\n")
+}
@@ -0,0 +1,133 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steipete/spogo/internal/config"
+	"github.com/steipete/spogo/internal/output"
+	"github.com/steipete/spogo/internal/spotify"
+)
+
+func fanOutContext(t *testing.T, format output.Format, settings Settings) (*Context, *bytes.Buffer, *bytes.Buffer) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.toml")
+	cfg := config.Default()
+	cfg.SetProfile("work", config.Profile{Market: "US"})
+	cfg.SetProfile("home", config.Profile{Market: "DE"})
+	cfg.SetProfile("broken", config.Profile{Market: "USA"})
+	if err := config.Save(path, cfg); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	settings.ConfigPath = path
+	settings.Format = format
+	ctx, err := NewContext(settings)
+	if err != nil {
+		t.Fatalf("new context: %v", err)
+	}
+	out := &bytes.Buffer{}
+	errOut := &bytes.Buffer{}
+	ctx.Output.Out = out
+	ctx.Output.Err = errOut
+	return ctx, out, errOut
+}
+
+func emitMarket(ctx *Context) error {
+	if ctx.ProfileKey == "home" {
+		_, _ = ctx.Output.Err.Write([]byte("warning\n"))
+		return spotify.APIError{Status: 401, Message: "expired"}
+	}
+	return ctx.Output.Emit(map[string]any{"market": ctx.Profile.Market}, []string{"market\t" + ctx.Profile.Market}, []string{"Market " + ctx.Profile.Market})
+}
+
+func TestFanOutProfiles(t *testing.T) {
+	ctx, _, _ := fanOutContext(t, output.FormatPlain, Settings{Profiles: []string{"work", " home", "work", ""}})
+	names, err := ctx.FanOutProfiles()
+	if err != nil || strings.Join(names, ",") != "work,home" {
+		t.Fatalf("names %q %v", names, err)
+	}
+	ctx.Settings.Profiles = []string{"work", "nope"}
+	if _, err := ctx.FanOutProfiles(); err == nil || !strings.Contains(err.Error(), "nope") {
+		t.Fatalf("expected unknown profile, got %v", err)
+	}
+	ctx.Settings.Profiles = nil
+	if names, err := ctx.FanOutProfiles(); err != nil || names != nil {
+		t.Fatalf("expected no fan-out, got %q %v", names, err)
+	}
+	ctx.Settings.AllProfiles = true
+	if names, _ := ctx.FanOutProfiles(); strings.Join(names, ",") != "broken,default,home,work" {
+		t.Fatalf("all %q", names)
+	}
+}
+
+func TestRunProfilesForcesNoInput(t *testing.T) {
+	ctx, _, _ := fanOutContext(t, output.FormatPlain, Settings{})
+	results := ctx.RunProfiles([]string{"work", "home"}, func(profileCtx *Context) error {
+		if !profileCtx.Settings.NoInput {
+			return errors.New("prompting allowed")
+		}
+		return nil
+	})
+	for _, result := range results {
+		if result.Err != nil {
+			t.Fatalf("%s: %v", result.Profile, result.Err)
+		}
+	}
+	if ctx.Settings.NoInput {
+		t.Fatalf("caller settings should be untouched")
+	}
+}
+
+func TestRunProfilesJSON(t *testing.T) {
+	ctx, out, errOut := fanOutContext(t, output.FormatJSON, Settings{})
+	code := ctx.EmitProfileResults(ctx.RunProfiles([]string{"work", "home", "broken"}, emitMarket))
+	if code != 3 {
+		t.Fatalf("code %d", code)
+	}
+	var payload map[string]map[string]string
+	if err := json.Unmarshal(out.Bytes(), &payload); err != nil {
+		t.Fatalf("json: %v %s", err, out.String())
+	}
+	if payload["work"]["market"] != "US" || payload["home"]["error"] == "" || !strings.Contains(payload["broken"]["error"], "market") {
+		t.Fatalf("payload %#v", payload)
+	}
+	if !strings.Contains(errOut.String(), "home: warning\n") || !strings.Contains(errOut.String(), "broken: market") {
+		t.Fatalf("stderr %q", errOut.String())
+	}
+}
+
+func TestRunProfilesPlainAndHuman(t *testing.T) {
+	ctx, out, _ := fanOutContext(t, output.FormatPlain, Settings{Market: "SE"})
+	if code := ctx.EmitProfileResults(ctx.RunProfiles([]string{"work", "default"}, emitMarket)); code != 0 {
+		t.Fatalf("code %d", code)
+	}
+	if out.String() != "work\tmarket\tSE\ndefault\tmarket\tSE\n" {
+		t.Fatalf("plain %q", out.String())
+	}
+
+	ctx, out, _ = fanOutContext(t, output.FormatHuman, Settings{})
+	results := ctx.RunProfiles([]string{"work", "broken"}, emitMarket)
+	if code := ctx.EmitProfileResults(results); code != 2 {
+		t.Fatalf("code %d", code)
+	}
+	if out.String() != "work\n  Market US\n\nbroken\n" {
+		t.Fatalf("human %q", out.String())
+	}
+
+	ctx, out, _ = fanOutContext(t, output.FormatJSON, Settings{})
+	results = ctx.RunProfiles([]string{"work"}, func(*Context) error { return errors.New("boom") })
+	results = append(results, ctx.RunProfiles([]string{"home"}, func(c *Context) error {
+		_, err := c.Output.Out.Write([]byte("not json"))
+		return err
+	})...)
+	if code := ctx.EmitProfileResults(results); code != 1 {
+		t.Fatalf("code %d", code)
+	}
+	if !strings.Contains(out.String(), `"home": "not json"`) {
+		t.Fatalf("json %q", out.String())
+	}
+}
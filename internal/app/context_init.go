@@ -62,5 +62,13 @@ func applySettings(profile config.Profile, settings Settings) config.Profile {
 	if settings.Engine != "" {
 		profile.Engine = settings.Engine
 	}
+	if settings.WaitDevice > 0 {
+		profile.WaitDevice = settings.WaitDevice.String()
+	}
+	if profile.Device != "" && !profile.IsDeviceGroup(profile.Device) {
+		// Aliases are spogo names; engines need the real device. A group is
+		// left as is for ValidateProfile to reject.
+		profile.Device = profile.DeviceTargets(profile.Device)[0]
+	}
 	return profile
 }
@@ -0,0 +1,87 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/steipete/spogo/internal/secrets"
+	"golang.org/x/term"
+)
+
+const (
+	keyringService = "spogo"
+	// passphraseEnv unlocks the encrypted fallback when there is no keyring.
+	passphraseEnv = "SPOGO_COOKIE_PASSPHRASE"
+)
+
+// UsesKeyring reports whether the profile keeps cookies and tokens in the
+// secrets store (cookie_store = "keyring") instead of plaintext files.
+func (c *Context) UsesKeyring() bool {
+	return strings.EqualFold(strings.TrimSpace(c.Profile.CookieStore), "keyring")
+}
+
+// Secrets is the OS keyring, falling back to passphrase-encrypted files
+// under <config dir>/secrets when no secret service is available.
+func (c *Context) Secrets() secrets.Store {
+	if c.secrets == nil {
+		file := &secrets.EncryptedFile{Dir: filepath.Join(filepath.Dir(c.ConfigPath), "secrets")}
+		file.Passphrase = func() (string, error) {
+			return c.secretsPassphrase(file.Empty())
+		}
+		c.secrets = secrets.Fallback{
+			Primary:   secrets.Keyring{Service: keyringService},
+			Secondary: file,
+		}
+	}
+	return c.secrets
+}
+
+// SetSecrets replaces the secrets store; intended for tests.
+func (c *Context) SetSecrets(store secrets.Store) {
+	c.secrets = store
+}
+
+// Swapped in tests; the real ones need a terminal on stdin.
+var (
+	stdinIsTerminal = func() bool { return term.IsTerminal(int(os.Stdin.Fd())) }
+	readPassword    = func() ([]byte, error) { return term.ReadPassword(int(os.Stdin.Fd())) }
+)
+
+// secretsPassphrase asks twice when nothing is encrypted yet: a typo there
+// would seal the cookies under a passphrase nobody knows.
+func (c *Context) secretsPassphrase(confirm bool) (string, error) {
+	if passphrase := os.Getenv(passphraseEnv); passphrase != "" {
+		return passphrase, nil
+	}
+	if c.Settings.NoInput || !stdinIsTerminal() {
+		return "", fmt.Errorf("no keyring available; set %s to use the encrypted cookie file", passphraseEnv)
+	}
+	passphrase, err := c.promptPassphrase("Passphrase for encrypted cookies: ")
+	if err != nil || !confirm {
+		return passphrase, err
+	}
+	again, err := c.promptPassphrase("Repeat the passphrase (it cannot be recovered): ")
+	if err != nil {
+		return "", err
+	}
+	if again != passphrase {
+		return "", errors.New("passphrases do not match")
+	}
+	return passphrase, nil
+}
+
+func (c *Context) promptPassphrase(prompt string) (string, error) {
+	_, _ = fmt.Fprint(c.Output.Err, prompt)
+	data, err := readPassword()
+	_, _ = fmt.Fprintln(c.Output.Err)
+	if err != nil {
+		return "", err
+	}
+	if len(data) == 0 {
+		return "", errors.New("empty passphrase")
+	}
+	return string(data), nil
+}
@@ -0,0 +1,136 @@
+package app
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steipete/spogo/internal/config"
+	"github.com/steipete/spogo/internal/cookies"
+	"github.com/steipete/spogo/internal/output"
+	"github.com/steipete/spogo/internal/secrets"
+)
+
+func TestSecretsDefaultsToKeyringWithEncryptedFallback(t *testing.T) {
+	dir := t.TempDir()
+	ctx := &Context{ConfigPath: filepath.Join(dir, "config.toml")}
+	fallback, ok := ctx.Secrets().(secrets.Fallback)
+	if !ok {
+		t.Fatalf("expected fallback store, got %T", ctx.Secrets())
+	}
+	if keyring, ok := fallback.Primary.(secrets.Keyring); !ok || keyring.Service != "spogo" {
+		t.Fatalf("primary %#v", fallback.Primary)
+	}
+	file, ok := fallback.Secondary.(*secrets.EncryptedFile)
+	if !ok || file.Dir != filepath.Join(dir, "secrets") {
+		t.Fatalf("secondary %#v", fallback.Secondary)
+	}
+	memory := &secrets.Memory{}
+	ctx.SetSecrets(memory)
+	if ctx.Secrets() != memory {
+		t.Fatalf("expected replaced store")
+	}
+}
+
+func TestUsesKeyring(t *testing.T) {
+	for value, want := range map[string]bool{"": false, "file": false, "keyring": true, " Keyring ": true} {
+		ctx := &Context{Profile: config.Profile{CookieStore: value}}
+		if got := ctx.UsesKeyring(); got != want {
+			t.Fatalf("%q: got %v", value, got)
+		}
+	}
+}
+
+func TestSecretsPassphrase(t *testing.T) {
+	ctx := &Context{Settings: Settings{NoInput: true}}
+	t.Setenv(passphraseEnv, "hunter2")
+	if got, err := ctx.secretsPassphrase(true); err != nil || got != "hunter2" {
+		t.Fatalf("got %q %v", got, err)
+	}
+	t.Setenv(passphraseEnv, "")
+	if _, err := ctx.secretsPassphrase(false); err == nil || !strings.Contains(err.Error(), passphraseEnv) {
+		t.Fatalf("expected passphrase error, got %v", err)
+	}
+}
+
+func TestSecretsPassphrasePrompt(t *testing.T) {
+	t.Setenv(passphraseEnv, "")
+	prevTerminal, prevRead := stdinIsTerminal, readPassword
+	t.Cleanup(func() { stdinIsTerminal, readPassword = prevTerminal, prevRead })
+	stdinIsTerminal = func() bool { return true }
+	answers := []string{}
+	readPassword = func() ([]byte, error) {
+		answer := answers[0]
+		answers = answers[1:]
+		return []byte(answer), nil
+	}
+	ctx, _, errOut := fanOutContext(t, output.FormatPlain, Settings{})
+
+	answers = []string{"hunter2"}
+	if got, err := ctx.secretsPassphrase(false); err != nil || got != "hunter2" || strings.Count(errOut.String(), "Passphrase") != 1 {
+		t.Fatalf("existing secrets: %q %v %q", got, err, errOut.String())
+	}
+	errOut.Reset()
+	answers = []string{"hunter2", "hunter2"}
+	if got, err := ctx.secretsPassphrase(true); err != nil || got != "hunter2" || !strings.Contains(errOut.String(), "Repeat the passphrase") {
+		t.Fatalf("confirmed: %q %v %q", got, err, errOut.String())
+	}
+	answers = []string{"hunter2", "hunter3"}
+	if _, err := ctx.secretsPassphrase(true); err == nil || !strings.Contains(err.Error(), "do not match") {
+		t.Fatalf("expected mismatch, got %v", err)
+	}
+	answers = []string{""}
+	if _, err := ctx.secretsPassphrase(false); err == nil || !strings.Contains(err.Error(), "empty") {
+		t.Fatalf("expected empty passphrase error, got %v", err)
+	}
+
+	// The first secret sealed through Secrets() asks twice; later ones once.
+	ctx.SetSecrets(nil)
+	fallback := ctx.Secrets().(secrets.Fallback)
+	file := fallback.Secondary.(*secrets.EncryptedFile)
+	answers = []string{"hunter2", "hunter2"}
+	if err := file.Set(secrets.CookieKey("default"), []byte("[]")); err != nil || len(answers) != 0 {
+		t.Fatalf("first seal: %v, unused answers %q", err, answers)
+	}
+	ctx.SetSecrets(nil)
+	file = ctx.Secrets().(secrets.Fallback).Secondary.(*secrets.EncryptedFile)
+	answers = []string{"hunter2"}
+	if data, err := file.Get(secrets.CookieKey("default")); err != nil || string(data) != "[]" {
+		t.Fatalf("reopen: %q %v", data, err)
+	}
+}
+
+func TestCookieSourceKeyring(t *testing.T) {
+	ctx := &Context{ProfileKey: "work", Profile: config.Profile{CookieStore: "keyring", CookiePath: "/tmp/cookies.json"}}
+	ctx.SetSecrets(&secrets.Memory{})
+	src, err := ctx.cookieSource()
+	if err != nil {
+		t.Fatalf("cookie source: %v", err)
+	}
+	store, ok := src.(cookies.StoreSource)
+	if !ok || store.Key != "cookies/work" {
+		t.Fatalf("expected store source, got %#v", src)
+	}
+	if browser, ok := store.Fallback.(cookies.BrowserSource); !ok || browser.Browser != "chrome" {
+		t.Fatalf("expected browser fallback, got %#v", store.Fallback)
+	}
+}
+
+func TestClearCacheKeyring(t *testing.T) {
+	dir := t.TempDir()
+	store := &secrets.Memory{}
+	ctx := &Context{ConfigPath: filepath.Join(dir, "config.toml"), ProfileKey: "default", Profile: config.Profile{CookieStore: "keyring"}}
+	ctx.SetSecrets(store)
+	if err := store.Set(secrets.CacheKey("default"), []byte("{}")); err != nil {
+		t.Fatalf("set: %v", err)
+	}
+	if err := ctx.ClearCache(); err != nil {
+		t.Fatalf("clear cache: %v", err)
+	}
+	if store.Has(secrets.CacheKey("default")) {
+		t.Fatalf("expected cache entry removed")
+	}
+	if err := ctx.ClearCache(); err != nil {
+		t.Fatalf("clear missing cache: %v", err)
+	}
+}
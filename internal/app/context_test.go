@@ -2,8 +2,10 @@ package app
 
 import (
 	"context"
+	"errors"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -63,6 +65,13 @@ func TestClearCache(t *testing.T) {
 	}
 }
 
+func TestResolveStatePath(t *testing.T) {
+	ctx := &Context{ConfigPath: filepath.Join("cfg", "config.toml"), ProfileKey: "work"}
+	if got := ctx.ResolveStatePath(); got != filepath.Join("cfg", "state", "work.json") {
+		t.Fatalf("state path: %s", got)
+	}
+}
+
 func TestValidateProfile(t *testing.T) {
 	ctx := &Context{Profile: config.Profile{Market: "USA"}}
 	if err := ctx.ValidateProfile(); err == nil {
@@ -110,6 +119,41 @@ func TestSaveProfile(t *testing.T) {
 	}
 }
 
+func TestUpdateProfile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.toml")
+	cfg := config.Default()
+	cfg.SetProfile("work", config.Profile{Market: "DE"})
+	ctx := &Context{Config: cfg, ConfigPath: path, ProfileKey: "work"}
+	if err := ctx.UpdateProfile(func(p *config.Profile) error {
+		p.Device = "Kitchen"
+		return nil
+	}); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	loaded, err := config.Load(path)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	if got := loaded.Profile("work"); got.Market != "DE" || got.Device != "Kitchen" || loaded.DefaultProfile != config.DefaultProfile {
+		t.Fatalf("profile %#v default %q", got, loaded.DefaultProfile)
+	}
+	if ctx.Profile.Device != "Kitchen" {
+		t.Fatalf("context profile not updated")
+	}
+	boom := errors.New("boom")
+	if err := ctx.UpdateProfile(func(*config.Profile) error { return boom }); !errors.Is(err, boom) {
+		t.Fatalf("expected boom, got %v", err)
+	}
+	if err := (&Context{}).UpdateProfile(func(*config.Profile) error { return nil }); err == nil {
+		t.Fatalf("expected nil config error")
+	}
+	var nilCtx *Context
+	if err := nilCtx.UpdateProfile(func(*config.Profile) error { return nil }); err == nil {
+		t.Fatalf("expected nil context error")
+	}
+}
+
 func TestSaveProfileNilContext(t *testing.T) {
 	var ctx *Context
 	if err := ctx.SaveProfile(config.Profile{Market: "US"}); err == nil {
@@ -193,8 +237,17 @@ func TestSpotifyAutoEngine(t *testing.T) {
 
 func TestSpotifyUnknownEngine(t *testing.T) {
 	ctx := &Context{Profile: config.Profile{CookiePath: "/tmp/cookies.json", Engine: "nope"}}
-	if _, err := ctx.Spotify(); err == nil {
-		t.Fatalf("expected error")
+	if _, err := ctx.Spotify(); err == nil || !strings.Contains(err.Error(), strings.Join(config.Engines, ", ")) {
+		t.Fatalf("expected error listing engines, got %v", err)
+	}
+}
+
+func TestEveryConfigEngineBuilds(t *testing.T) {
+	for _, engine := range config.Engines {
+		ctx := &Context{Profile: config.Profile{CookiePath: "/tmp/cookies.json", Engine: engine}}
+		if _, err := ctx.buildSpotifyClient(cookies.FileSource{Path: "/tmp/cookies.json"}); err != nil && strings.Contains(err.Error(), "unknown engine") {
+			t.Fatalf("%s: %v", engine, err)
+		}
 	}
 }
 
@@ -339,6 +392,39 @@ func TestApplySettingsKeepsEmptyValues(t *testing.T) {
 	}
 }
 
+func TestApplySettingsExpandsDeviceAlias(t *testing.T) {
+	base := config.Profile{
+		Devices:      map[string]string{"kitchen": "Echo Kitchen"},
+		DeviceGroups: map[string][]string{"office": {"Desk A", "Desk B"}},
+	}
+	if got := applySettings(base, Settings{Device: "Kitchen"}).Device; got != "Echo Kitchen" {
+		t.Fatalf("alias: %q", got)
+	}
+	grouped := &Context{Profile: applySettings(base, Settings{Device: "office"})}
+	if grouped.Profile.Device != "office" {
+		t.Fatalf("group should be kept, got %q", grouped.Profile.Device)
+	}
+	if err := grouped.ValidateProfile(); err == nil || !strings.Contains(err.Error(), "device group") {
+		t.Fatalf("expected group rejection, got %v", err)
+	}
+}
+
+func TestWaitDeviceSetting(t *testing.T) {
+	profile := applySettings(config.Profile{WaitDevice: "5s"}, Settings{WaitDevice: 20 * time.Second})
+	ctx := &Context{Profile: profile}
+	if err := ctx.ValidateProfile(); err != nil || ctx.waitDevice() != 20*time.Second {
+		t.Fatalf("wait %v %v", ctx.waitDevice(), err)
+	}
+	ctx.Profile = applySettings(config.Profile{WaitDevice: "5s"}, Settings{})
+	if ctx.waitDevice() != 5*time.Second {
+		t.Fatalf("profile wait %v", ctx.waitDevice())
+	}
+	ctx.Profile.WaitDevice = "soon"
+	if err := ctx.ValidateProfile(); err == nil {
+		t.Fatalf("expected invalid wait_device")
+	}
+}
+
 func TestValidateProfileOK(t *testing.T) {
 	ctx := &Context{Profile: config.Profile{Market: "US"}}
 	if err := ctx.ValidateProfile(); err != nil {
@@ -408,6 +494,22 @@ func (dummySpotify) Playlists(context.Context, int, int) ([]spotify.Item, int, e
 	return nil, 0, nil
 }
 
+func (dummySpotify) LibraryShows(context.Context, int, int) ([]spotify.Item, int, error) {
+	return nil, 0, nil
+}
+
+func (dummySpotify) LibraryEpisodes(context.Context, int, int) ([]spotify.Item, int, error) {
+	return nil, 0, nil
+}
+
+func (dummySpotify) ShowEpisodes(context.Context, string, int, int) ([]spotify.Item, int, error) {
+	return nil, 0, nil
+}
+
+func (dummySpotify) AlbumTracks(context.Context, string, int, int) ([]spotify.Item, int, error) {
+	return nil, 0, nil
+}
+
 func (dummySpotify) PlaylistTracks(context.Context, string, int, int) ([]spotify.Item, int, error) {
 	return nil, 0, nil
 }
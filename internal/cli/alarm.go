@@ -0,0 +1,293 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/steipete/spogo/internal/alarm"
+	"github.com/steipete/spogo/internal/app"
+	"github.com/steipete/spogo/internal/config"
+	"github.com/steipete/spogo/internal/spotify"
+)
+
+const (
+	// alarmPoll caps how long `alarm run` sleeps between config reloads.
+	alarmPoll = time.Minute
+	// alarmGrace is how late an alarm may still fire, e.g. after the machine
+	// wakes from sleep; anything older is skipped.
+	alarmGrace = 5 * time.Minute
+)
+
+// alarmNow is the clock used by `alarm run`; tests pin it.
+var alarmNow = time.Now
+
+type AlarmCmd struct {
+	Add    AlarmAddCmd    `kong:"cmd,help='Schedule an alarm.'"`
+	List   AlarmListCmd   `kong:"cmd,help='List alarms.'"`
+	Remove AlarmRemoveCmd `kong:"cmd,help='Remove an alarm.'"`
+	Run    AlarmRunCmd    `kong:"cmd,help='Fire alarms as they come due.'"`
+}
+
+type AlarmAddCmd struct {
+	Time       string `arg:"" required:"" help:"Local time HH:MM (24h)."`
+	Play       string `required:"" help:"Spotify URI/URL to play."`
+	Days       string `help:"Days (mon-fri, sat,sun, weekdays, weekends, daily)." default:"daily"`
+	Volume     int    `help:"Volume 1-100 to play at (default: leave as is)."`
+	VolumeRamp string `name:"volume-ramp" help:"Fade in from silence over this duration (e.g. 5m)."`
+	Shuffle    bool   `help:"Enable shuffle before playing."`
+}
+
+type AlarmListCmd struct{}
+
+type AlarmRemoveCmd struct {
+	ID string `arg:"" required:"" help:"Alarm id."`
+}
+
+type AlarmRunCmd struct {
+	Once bool `help:"Exit after the first alarm fires."`
+}
+
+// Run stores the alarm in the current profile. The global --device names the
+// device the alarm transfers playback to.
+func (cmd *AlarmAddCmd) Run(ctx *app.Context) error {
+	days, err := alarm.ParseDays(cmd.Days)
+	if err != nil {
+		return app.WrapExit(2, err)
+	}
+	hour, minute, err := alarm.ParseClock(cmd.Time)
+	if err != nil {
+		return app.WrapExit(2, err)
+	}
+	res, err := spotify.ParseResource(cmd.Play)
+	if err != nil {
+		return app.WrapExit(2, err)
+	}
+	if res.URI == "" {
+		return app.WrapExit(2, errors.New("--play needs a Spotify URI or URL"))
+	}
+	entry := config.Alarm{
+		Time:       fmt.Sprintf("%02d:%02d", hour, minute),
+		Days:       days,
+		Play:       res.URI,
+		Device:     strings.TrimSpace(ctx.Settings.Device),
+		Volume:     cmd.Volume,
+		VolumeRamp: strings.TrimSpace(cmd.VolumeRamp),
+		Shuffle:    cmd.Shuffle,
+	}
+	if err := alarm.Validate(entry); err != nil {
+		return app.WrapExit(2, err)
+	}
+	err = ctx.UpdateProfile(func(profile *config.Profile) error {
+		entry.ID = alarm.NextID(profile.Alarms)
+		profile.Alarms = append(profile.Alarms, entry)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	next, _ := alarm.Next(entry, alarmNow())
+	payload := alarmPayload(entry, next)
+	payload["status"] = "ok"
+	return emitOK(ctx, payload, fmt.Sprintf("Alarm %s set for %s (next %s)", entry.ID, entry.Time, next.Format("Mon Jan 2 15:04")))
+}
+
+func (cmd *AlarmListCmd) Run(ctx *app.Context) error {
+	now := alarmNow()
+	alarms := ctx.Profile.Alarms
+	payload := make([]map[string]any, 0, len(alarms))
+	plain := make([]string, 0, len(alarms))
+	human := make([]string, 0, len(alarms))
+	for _, entry := range alarms {
+		next, err := alarm.Next(entry, now)
+		payload = append(payload, alarmPayload(entry, next))
+		nextText := "-"
+		if err == nil {
+			nextText = next.Format(time.RFC3339)
+		}
+		plain = append(plain, fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t%s", entry.ID, entry.Time, alarm.FormatDays(entry.Days), entry.Play, entry.Device, nextText))
+		line := fmt.Sprintf("%s  %s %s → %s", entry.ID, entry.Time, alarm.FormatDays(entry.Days), entry.Play)
+		if entry.Device != "" {
+			line += " on " + entry.Device
+		}
+		if err == nil {
+			line += ctx.Output.Theme.Muted(" · next " + next.Format("Mon Jan 2 15:04"))
+		}
+		human = append(human, line)
+	}
+	if len(human) == 0 {
+		human = append(human, "No alarms")
+	}
+	return ctx.Output.Emit(payload, plain, human)
+}
+
+func (cmd *AlarmRemoveCmd) Run(ctx *app.Context) error {
+	id := strings.TrimSpace(cmd.ID)
+	err := ctx.UpdateProfile(func(profile *config.Profile) error {
+		for i, entry := range profile.Alarms {
+			if entry.ID == id {
+				profile.Alarms = append(profile.Alarms[:i:i], profile.Alarms[i+1:]...)
+				return nil
+			}
+		}
+		return fmt.Errorf("no alarm %q", id)
+	})
+	if err != nil {
+		return err
+	}
+	return emitOK(ctx, map[string]any{"status": "ok", "id": id}, fmt.Sprintf("Removed alarm %s", id))
+}
+
+// Run stays in the foreground and fires alarms as they come due. The config
+// is re-read every cycle, so alarms added or removed elsewhere take effect
+// without a restart. Failures are reported and the loop keeps going.
+func (cmd *AlarmRunCmd) Run(ctx *app.Context) error {
+	client, cmdCtx, err := spotifyClient(ctx)
+	if err != nil {
+		return err
+	}
+	runCtx, stop := signal.NotifyContext(cmdCtx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	alarms := reloadAlarms(ctx, ctx.Profile.Alarms)
+	last := alarmNow()
+	if !ctx.Output.Quiet {
+		_, _ = fmt.Fprintf(ctx.Output.Err, "Watching %d alarm(s); Ctrl-C to stop\n", len(alarms))
+	}
+	for {
+		now := alarmNow()
+		wait := alarmPoll
+		for _, entry := range alarms {
+			due, err := alarm.Next(entry, last)
+			if err != nil {
+				continue
+			}
+			if due.After(now) {
+				wait = min(wait, due.Sub(now))
+				continue
+			}
+			if now.Sub(due) > alarmGrace {
+				ctx.Output.Errorf("alarm %s: skipped, missed %s", entry.ID, due.Format("Mon 15:04"))
+				continue
+			}
+			if err := fireAlarm(runCtx, ctx, client, entry); err != nil {
+				if cmd.Once {
+					return fmt.Errorf("alarm %s: %w", entry.ID, err)
+				}
+				ctx.Output.Errorf("alarm %s: %v", entry.ID, err)
+				continue
+			}
+			if cmd.Once {
+				return nil
+			}
+		}
+		last = now
+		if err := waitFor(runCtx, wait); err != nil {
+			if cmdCtx.Err() == nil {
+				return nil // interrupted
+			}
+			return err
+		}
+		alarms = reloadAlarms(ctx, alarms)
+	}
+}
+
+// reloadAlarms re-reads the profile's alarms from disk, keeping the previous
+// set when the config cannot be read (e.g. mid-write by another process).
+func reloadAlarms(ctx *app.Context, previous []config.Alarm) []config.Alarm {
+	cfg, err := config.Load(ctx.ConfigPath)
+	if err != nil {
+		ctx.Output.Errorf("reload config: %v", err)
+		return previous
+	}
+	return cfg.Profile(ctx.ProfileKey).Alarms
+}
+
+// fireAlarm moves playback to the alarm's device, starts it and, with a
+// ramp, fades in from silence to the alarm volume (or the device's current
+// volume when none is set).
+func fireAlarm(runCtx context.Context, ctx *app.Context, client spotify.API, entry config.Alarm) error {
+	ramp, err := alarm.Ramp(entry)
+	if err != nil {
+		return err
+	}
+	res, err := spotify.ParseResource(entry.Play)
+	if err != nil {
+		return err
+	}
+	if entry.Device != "" {
+		devices, err := client.Devices(runCtx)
+		if err != nil {
+			return err
+		}
+		device, err := resolveDevice(ctx, devices, entry.Device)
+		if err != nil {
+			return err
+		}
+		if err := client.Transfer(runCtx, device.ID); err != nil {
+			return err
+		}
+	}
+	target := entry.Volume
+	if ramp > 0 && target == 0 {
+		status, err := client.Playback(runCtx)
+		if err != nil {
+			return err
+		}
+		target = status.Device.Volume
+	}
+	fadeIn := ramp > 0 && target > 0
+	switch {
+	case fadeIn:
+		if err := client.Volume(runCtx, 0); err != nil {
+			return err
+		}
+	case target > 0:
+		if err := client.Volume(runCtx, target); err != nil {
+			return err
+		}
+	}
+	uri, err := playbackURI(runCtx, client, res)
+	if err != nil {
+		return err
+	}
+	if err := startPlayback(runCtx, client, uri, entry.Shuffle); err != nil {
+		return err
+	}
+	if fadeIn {
+		if err := fadeVolume(runCtx, client, 0, target, ramp, fadeCurves["linear"]); err != nil {
+			return err
+		}
+	}
+	payload := alarmPayload(entry, time.Time{})
+	payload["status"] = "fired"
+	payload["fired_at"] = alarmNow().Format(time.RFC3339)
+	return ctx.Output.Emit(payload, []string{"fired\t" + entry.ID}, []string{fmt.Sprintf("Alarm %s: playing %s", entry.ID, entry.Play)})
+}
+
+func alarmPayload(entry config.Alarm, next time.Time) map[string]any {
+	payload := map[string]any{
+		"id":      entry.ID,
+		"time":    entry.Time,
+		"days":    alarm.FormatDays(entry.Days),
+		"play":    entry.Play,
+		"shuffle": entry.Shuffle,
+	}
+	if entry.Device != "" {
+		payload["device"] = entry.Device
+	}
+	if entry.Volume > 0 {
+		payload["volume"] = entry.Volume
+	}
+	if entry.VolumeRamp != "" {
+		payload["volume_ramp"] = entry.VolumeRamp
+	}
+	if !next.IsZero() {
+		payload["next"] = next.Format(time.RFC3339)
+	}
+	return payload
+}
@@ -0,0 +1,234 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steipete/spogo/internal/app"
+	"github.com/steipete/spogo/internal/config"
+	"github.com/steipete/spogo/internal/output"
+	"github.com/steipete/spogo/internal/spotify"
+	"github.com/steipete/spogo/internal/testutil"
+)
+
+// pinClock fixes alarmNow and makes waitFor advance it instead of sleeping.
+func pinClock(t *testing.T, start time.Time) *time.Time {
+	t.Helper()
+	now := start
+	origNow, origWait := alarmNow, waitFor
+	t.Cleanup(func() { alarmNow, waitFor = origNow, origWait })
+	alarmNow = func() time.Time { return now }
+	waitFor = func(ctx context.Context, d time.Duration) error {
+		now = now.Add(d)
+		return ctx.Err()
+	}
+	return &now
+}
+
+func TestAlarmAddListRemove(t *testing.T) {
+	// 2026-10-16 is a Friday.
+	pinClock(t, time.Date(2026, 10, 16, 8, 0, 0, 0, time.Local))
+	ctx, _, _ := testutil.NewTestContext(t, output.FormatHuman)
+	ctx.ConfigPath = filepath.Join(t.TempDir(), "config.toml")
+	ctx.Config = config.Default()
+	ctx.ProfileKey = "default"
+	ctx.Settings.Device = "Kitchen"
+	add := AlarmAddCmd{Time: "7:00", Play: "https://open.spotify.com/playlist/p1", Days: "mon-fri", VolumeRamp: "5m"}
+	if err := add.Run(ctx); err != nil {
+		t.Fatalf("add: %v", err)
+	}
+	ctx.Settings.Device = ""
+	if err := (&AlarmAddCmd{Time: "09:30", Play: "spotify:album:a1", Days: "daily", Volume: 30}).Run(ctx); err != nil {
+		t.Fatalf("add: %v", err)
+	}
+	cfg, err := config.Load(ctx.ConfigPath)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	alarms := cfg.Profile("default").Alarms
+	if len(alarms) != 2 || alarms[0].ID != "1" || alarms[0].Time != "07:00" || alarms[0].Play != "spotify:playlist:p1" || strings.Join(alarms[0].Days, ",") != "mon,tue,wed,thu,fri" || alarms[1].ID != "2" {
+		t.Fatalf("alarms %#v", alarms)
+	}
+
+	listCtx, out, _ := testutil.NewTestContext(t, output.FormatPlain)
+	listCtx.Profile = cfg.Profile("default")
+	if err := (&AlarmListCmd{}).Run(listCtx); err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 2 || !strings.HasPrefix(lines[0], "1\t07:00\tmon,tue,wed,thu,fri\tspotify:playlist:p1\tKitchen\t2026-10-19T07:00:00") || !strings.Contains(lines[1], "daily") {
+		t.Fatalf("list %q", lines)
+	}
+
+	if err := (&AlarmRemoveCmd{ID: "1"}).Run(ctx); err != nil {
+		t.Fatalf("remove: %v", err)
+	}
+	if len(ctx.Profile.Alarms) != 1 || ctx.Profile.Alarms[0].ID != "2" {
+		t.Fatalf("after remove %#v", ctx.Profile.Alarms)
+	}
+	if err := (&AlarmRemoveCmd{ID: "9"}).Run(ctx); err == nil {
+		t.Fatalf("expected missing alarm error")
+	}
+	if err := (&AlarmAddCmd{Time: "06:00", Play: "spotify:track:t1"}).Run(ctx); err != nil || ctx.Profile.Alarms[1].ID != "1" {
+		t.Fatalf("id reuse: %v %#v", err, ctx.Profile.Alarms)
+	}
+}
+
+func TestAlarmAddValidation(t *testing.T) {
+	ctx, _, _ := testutil.NewTestContext(t, output.FormatPlain)
+	cases := []AlarmAddCmd{
+		{Time: "7am", Play: "spotify:track:t1"},
+		{Time: "07:00", Play: "spotify:track:t1", Days: "someday"},
+		{Time: "07:00", Play: "abc123"},
+		{Time: "07:00", Play: "spotify:track:t1", Volume: 150},
+		{Time: "07:00", Play: "spotify:track:t1", VolumeRamp: "later"},
+	}
+	for _, cmd := range cases {
+		if err := cmd.Run(ctx); err == nil || app.ExitCode(err) != 2 {
+			t.Fatalf("%#v: expected usage error, got %v", cmd, err)
+		}
+	}
+}
+
+func TestAlarmListEmpty(t *testing.T) {
+	ctx, out, _ := testutil.NewTestContext(t, output.FormatHuman)
+	if err := (&AlarmListCmd{}).Run(ctx); err != nil || !strings.Contains(out.String(), "No alarms") {
+		t.Fatalf("list %q %v", out.String(), err)
+	}
+}
+
+func TestAlarmRunFiresWithRamp(t *testing.T) {
+	now := pinClock(t, time.Date(2026, 10, 16, 6, 58, 30, 0, time.Local))
+	ctx, out, errOut := testutil.NewTestContext(t, output.FormatPlain)
+	ctx.ConfigPath = filepath.Join(t.TempDir(), "config.toml")
+	ctx.ProfileKey = "default"
+	cfg := config.Default()
+	cfg.SetProfile("default", config.Profile{Alarms: []config.Alarm{
+		{ID: "1", Time: "07:00", Play: "spotify:playlist:p1", Device: "Kitchen", Volume: 20, VolumeRamp: "10s", Shuffle: true},
+		{ID: "2", Time: "07:00", Days: []string{"sat"}, Play: "spotify:album:a1"},
+	}})
+	if err := config.Save(ctx.ConfigPath, cfg); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	var calls []string
+	ctx.SetSpotify(&testutil.SpotifyMock{
+		DevicesFn: func(context.Context) ([]spotify.Device, error) {
+			return []spotify.Device{{ID: "d1", Name: "Kitchen"}}, nil
+		},
+		TransferFn: func(_ context.Context, id string) error { calls = append(calls, "transfer "+id); return nil },
+		VolumeFn: func(_ context.Context, level int) error {
+			calls = append(calls, "volume "+formatClock(level*1000))
+			return nil
+		},
+		ShuffleFn: func(_ context.Context, on bool) error { calls = append(calls, "shuffle "+onOff(on)); return nil },
+		PlayFn:    func(_ context.Context, uri string) error { calls = append(calls, "play "+uri); return nil },
+	})
+	if err := (&AlarmRunCmd{Once: true}).Run(ctx); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	got := strings.Join(calls, "|")
+	if !strings.HasPrefix(got, "transfer d1|volume 0:00|shuffle on|play spotify:playlist:p1|volume 0:01|") || !strings.HasSuffix(got, "volume 0:20") {
+		t.Fatalf("calls %v", calls)
+	}
+	if now.Before(time.Date(2026, 10, 16, 7, 0, 10, 0, time.Local)) {
+		t.Fatalf("clock did not advance through the ramp: %v", now)
+	}
+	if strings.TrimSpace(out.String()) != "fired\t1" || !strings.Contains(errOut.String(), "Watching 2 alarm(s)") {
+		t.Fatalf("out %q err %q", out.String(), errOut.String())
+	}
+}
+
+func TestFireAlarmRampsToCurrentVolume(t *testing.T) {
+	stubWait(t, -1)
+	ctx, out, _ := testutil.NewTestContext(t, output.FormatJSON)
+	var levels []int
+	client := &testutil.SpotifyMock{
+		PlaybackFn: func(context.Context) (spotify.PlaybackStatus, error) {
+			return spotify.PlaybackStatus{Device: spotify.Device{Volume: 3}}, nil
+		},
+		VolumeFn: func(_ context.Context, level int) error { levels = append(levels, level); return nil },
+		PlayFn:   func(context.Context, string) error { return nil },
+	}
+	entry := config.Alarm{ID: "1", Time: "07:00", Play: "spotify:track:t1", VolumeRamp: "2s"}
+	if err := fireAlarm(context.Background(), ctx, client, entry); err != nil {
+		t.Fatalf("fire: %v", err)
+	}
+	if len(levels) != 4 || levels[0] != 0 || levels[3] != 3 || !strings.Contains(out.String(), `"status": "fired"`) {
+		t.Fatalf("levels %v out %q", levels, out.String())
+	}
+	levels = nil
+	if err := fireAlarm(context.Background(), ctx, client, config.Alarm{ID: "2", Play: "spotify:track:t1", Volume: 55}); err != nil || len(levels) != 1 || levels[0] != 55 {
+		t.Fatalf("fixed volume: %v %v", levels, err)
+	}
+	client.PlaybackFn = func(context.Context) (spotify.PlaybackStatus, error) {
+		return spotify.PlaybackStatus{}, errors.New("no status")
+	}
+	if err := fireAlarm(context.Background(), ctx, client, entry); err == nil {
+		t.Fatalf("expected status error")
+	}
+}
+
+func TestAlarmRunErrors(t *testing.T) {
+	now := pinClock(t, time.Date(2026, 10, 16, 6, 59, 0, 0, time.Local))
+	ctx, _, errOut := testutil.NewTestContext(t, output.FormatPlain)
+	ctx.ConfigPath = filepath.Join(t.TempDir(), "config.toml")
+	ctx.ProfileKey = "default"
+	cfg := config.Default()
+	cfg.SetProfile("default", config.Profile{Alarms: []config.Alarm{{ID: "1", Time: "07:00", Play: "spotify:track:t1", Device: "Kitchen"}}})
+	if err := config.Save(ctx.ConfigPath, cfg); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	ctx.SetSpotify(&testutil.SpotifyMock{
+		DevicesFn: func(context.Context) ([]spotify.Device, error) { return nil, errors.New("offline") },
+	})
+	if err := (&AlarmRunCmd{Once: true}).Run(ctx); err == nil || !strings.Contains(err.Error(), "alarm 1: offline") {
+		t.Fatalf("expected fire error, got %v", err)
+	}
+
+	// Without --once a failure is logged and the loop keeps waiting.
+	*now = time.Date(2026, 10, 16, 6, 59, 0, 0, time.Local)
+	waits := 0
+	waitFor = func(ctx context.Context, d time.Duration) error {
+		if waits++; waits > 3 {
+			return context.Canceled
+		}
+		*now = now.Add(d)
+		return nil
+	}
+	err := (&AlarmRunCmd{}).Run(ctx)
+	if err != nil || !strings.Contains(errOut.String(), "alarm 1: offline") {
+		t.Fatalf("err %v stderr %q", err, errOut.String())
+	}
+}
+
+func TestAlarmRunSkipsMissedAlarms(t *testing.T) {
+	now := pinClock(t, time.Date(2026, 10, 16, 6, 59, 0, 0, time.Local))
+	ctx, _, errOut := testutil.NewTestContext(t, output.FormatPlain)
+	ctx.ConfigPath = filepath.Join(t.TempDir(), "config.toml")
+	ctx.ProfileKey = "default"
+	cfg := config.Default()
+	cfg.SetProfile("default", config.Profile{Alarms: []config.Alarm{{ID: "1", Time: "07:00", Play: "spotify:track:t1"}}})
+	if err := config.Save(ctx.ConfigPath, cfg); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	played := 0
+	ctx.SetSpotify(&testutil.SpotifyMock{PlayFn: func(context.Context, string) error { played++; return nil }})
+	waits := 0
+	waitFor = func(ctx context.Context, d time.Duration) error {
+		if waits++; waits > 1 {
+			return context.Canceled
+		}
+		*now = now.Add(time.Hour) // machine slept through the alarm
+		return nil
+	}
+	if err := (&AlarmRunCmd{}).Run(ctx); err != nil {
+		t.Fatalf("interrupted run: %v", err)
+	}
+	if played != 0 || !strings.Contains(errOut.String(), "alarm 1: skipped") {
+		t.Fatalf("played %d stderr %q", played, errOut.String())
+	}
+}
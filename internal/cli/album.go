@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/steipete/spogo/internal/app"
+	"github.com/steipete/spogo/internal/output"
+	"github.com/steipete/spogo/internal/spotify"
+)
+
+type AlbumTracksCmd struct {
+	Album  string `arg:"" required:"" help:"Album ID/URL/URI."`
+	Limit  int    `help:"Limit results." default:"50"`
+	Offset int    `help:"Offset results." default:"0"`
+}
+
+func (cmd *AlbumTracksCmd) Run(ctx *app.Context) error {
+	client, cmdCtx, err := spotifyClient(ctx)
+	if err != nil {
+		return err
+	}
+	album, err := spotify.ParseTypedID(cmd.Album, "album")
+	if err != nil {
+		return err
+	}
+	items, total, err := client.AlbumTracks(cmdCtx, album.ID, clampLimit(cmd.Limit), cmd.Offset)
+	if err != nil {
+		return err
+	}
+	plain := make([]string, 0, len(items))
+	human := make([]string, 0, len(items)+1)
+	if ctx.Output.Format == output.FormatHuman {
+		human = append(human, fmt.Sprintf("Tracks: %d", total))
+	}
+	for _, item := range items {
+		plain = append(plain, albumTrackPlain(item))
+		human = append(human, albumTrackHuman(ctx.Output, item))
+	}
+	payload := map[string]any{"album": album.URI, "total": total, "offset": cmd.Offset, "items": items}
+	return ctx.Output.Emit(payload, plain, human)
+}
+
+func albumTrackPlain(item spotify.Item) string {
+	return fmt.Sprintf("%d\t%d\t%s\t%s\t%s\t%d\t%t\t%s", item.DiscNumber, item.TrackNumber, item.ID, item.Name,
+		strings.Join(item.Artists, ", "), item.DurationMS, item.IsPlayable, item.URI)
+}
+
+func albumTrackHuman(w *output.Writer, item spotify.Item) string {
+	line := fmt.Sprintf("%s %s — %s %s", w.Theme.Muted(fmt.Sprintf("%d-%02d", max(item.DiscNumber, 1), item.TrackNumber)),
+		w.Theme.Accent(item.Name), strings.Join(item.Artists, ", "), w.Theme.Muted("· "+formatClock(item.DurationMS)))
+	if !item.IsPlayable {
+		line += " " + w.Theme.Muted("(unavailable)")
+	}
+	return line
+}
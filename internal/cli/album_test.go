@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/steipete/spogo/internal/output"
+	"github.com/steipete/spogo/internal/spotify"
+	"github.com/steipete/spogo/internal/testutil"
+)
+
+func albumTracksMock(t *testing.T) *testutil.SpotifyMock {
+	return &testutil.SpotifyMock{
+		AlbumTracksFn: func(_ context.Context, id string, limit, offset int) ([]spotify.Item, int, error) {
+			if id != "a1" || limit != 50 || offset != 2 {
+				t.Fatalf("unexpected args %q %d %d", id, limit, offset)
+			}
+			return []spotify.Item{
+				{ID: "t3", URI: "spotify:track:t3", Name: "Three", Type: "track", Artists: []string{"Band"}, TrackNumber: 3, DiscNumber: 1, DurationMS: 185000, IsPlayable: true},
+				{ID: "t4", URI: "spotify:track:t4", Name: "Four", Type: "track", TrackNumber: 4, DiscNumber: 1},
+			}, 10, nil
+		},
+	}
+}
+
+func TestAlbumTracksCmdPlain(t *testing.T) {
+	ctx, out, _ := testutil.NewTestContext(t, output.FormatPlain)
+	ctx.SetSpotify(albumTracksMock(t))
+	if err := (&AlbumTracksCmd{Album: "https://open.spotify.com/album/a1", Limit: 80, Offset: 2}).Run(ctx); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 2 || lines[0] != "1\t3\tt3\tThree\tBand\t185000\ttrue\tspotify:track:t3" {
+		t.Fatalf("output %q", out.String())
+	}
+}
+
+func TestAlbumTracksCmdHumanAndJSON(t *testing.T) {
+	ctx, out, _ := testutil.NewTestContext(t, output.FormatHuman)
+	ctx.SetSpotify(albumTracksMock(t))
+	if err := (&AlbumTracksCmd{Album: "a1", Limit: 50, Offset: 2}).Run(ctx); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	text := out.String()
+	if !strings.Contains(text, "Tracks: 10") || !strings.Contains(text, "1-03") || !strings.Contains(text, "3:05") || !strings.Contains(text, "(unavailable)") {
+		t.Fatalf("output %q", text)
+	}
+
+	ctx, out, _ = testutil.NewTestContext(t, output.FormatJSON)
+	ctx.SetSpotify(albumTracksMock(t))
+	if err := (&AlbumTracksCmd{Album: "spotify:album:a1", Limit: 50, Offset: 2}).Run(ctx); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	var payload struct {
+		Album string `json:"album"`
+		Total int    `json:"total"`
+		Items []struct {
+			TrackNumber int `json:"track_number"`
+			DiscNumber  int `json:"disc_number"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal(out.Bytes(), &payload); err != nil {
+		t.Fatalf("json: %v", err)
+	}
+	if payload.Album != "spotify:album:a1" || payload.Total != 10 || payload.Items[1].TrackNumber != 4 || payload.Items[1].DiscNumber != 1 {
+		t.Fatalf("payload %#v", payload)
+	}
+}
+
+func TestAlbumTracksCmdErrors(t *testing.T) {
+	ctx, _, _ := testutil.NewTestContext(t, output.FormatPlain)
+	ctx.SetSpotify(&testutil.SpotifyMock{
+		AlbumTracksFn: func(context.Context, string, int, int) ([]spotify.Item, int, error) {
+			return nil, 0, errors.New("boom")
+		},
+	})
+	if err := (&AlbumTracksCmd{Album: "a1"}).Run(ctx); err == nil {
+		t.Fatalf("expected error")
+	}
+	if err := (&AlbumTracksCmd{Album: "spotify:track:t1"}).Run(ctx); err == nil {
+		t.Fatalf("expected invalid id error")
+	}
+}
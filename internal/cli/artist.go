@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"context"
+	"errors"
+
+	"github.com/steipete/spogo/internal/app"
+	"github.com/steipete/spogo/internal/spotify"
+)
+
+type ArtistTopCmd struct {
+	Artist string `arg:"" required:"" help:"Artist ID/URL/URI."`
+	Limit  int    `help:"Limit results." default:"10"`
+}
+
+type ArtistDiscographyArgs struct {
+	Artist string `arg:"" required:"" help:"Artist ID/URL/URI."`
+	Limit  int    `help:"Limit results." default:"20"`
+	Offset int    `help:"Offset results." default:"0"`
+}
+
+type ArtistAlbumsCmd struct{ ArtistDiscographyArgs }
+
+type ArtistSinglesCmd struct{ ArtistDiscographyArgs }
+
+type ArtistAppearsOnCmd struct{ ArtistDiscographyArgs }
+
+type ArtistRelatedCmd struct {
+	Artist string `arg:"" required:"" help:"Artist ID/URL/URI."`
+	Limit  int    `help:"Limit results." default:"20"`
+}
+
+func (cmd *ArtistTopCmd) Run(ctx *app.Context) error {
+	return runArtistList(ctx, cmd.Artist, func(cmdCtx context.Context, catalog spotify.ArtistCatalog, id string) ([]spotify.Item, error) {
+		return catalog.ArtistTopTracks(cmdCtx, id, clampLimit(cmd.Limit))
+	})
+}
+
+func (cmd *ArtistAlbumsCmd) Run(ctx *app.Context) error {
+	return cmd.run(ctx, spotify.AlbumGroupAlbum)
+}
+
+func (cmd *ArtistSinglesCmd) Run(ctx *app.Context) error {
+	return cmd.run(ctx, spotify.AlbumGroupSingle)
+}
+
+func (cmd *ArtistAppearsOnCmd) Run(ctx *app.Context) error {
+	return cmd.run(ctx, spotify.AlbumGroupAppearsOn)
+}
+
+func (cmd *ArtistRelatedCmd) Run(ctx *app.Context) error {
+	return runArtistList(ctx, cmd.Artist, func(cmdCtx context.Context, catalog spotify.ArtistCatalog, id string) ([]spotify.Item, error) {
+		return catalog.RelatedArtists(cmdCtx, id, clampLimit(cmd.Limit))
+	})
+}
+
+func (args ArtistDiscographyArgs) run(ctx *app.Context, group spotify.AlbumGroup) error {
+	catalog, cmdCtx, id, err := artistCatalog(ctx, args.Artist)
+	if err != nil {
+		return err
+	}
+	items, total, err := catalog.ArtistAlbums(cmdCtx, id, group, clampLimit(args.Limit), args.Offset)
+	if err != nil {
+		return err
+	}
+	return emitItems(ctx, items, total, map[string]any{"group": group, "offset": args.Offset})
+}
+
+func runArtistList(ctx *app.Context, input string, fetch func(context.Context, spotify.ArtistCatalog, string) ([]spotify.Item, error)) error {
+	catalog, cmdCtx, id, err := artistCatalog(ctx, input)
+	if err != nil {
+		return err
+	}
+	items, err := fetch(cmdCtx, catalog, id)
+	if err != nil {
+		return err
+	}
+	return emitItems(ctx, items, len(items), nil)
+}
+
+func artistCatalog(ctx *app.Context, input string) (spotify.ArtistCatalog, context.Context, string, error) {
+	res, err := spotify.ParseTypedID(input, "artist")
+	if err != nil {
+		return nil, nil, "", err
+	}
+	client, cmdCtx, err := spotifyClient(ctx)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	catalog, ok := client.(spotify.ArtistCatalog)
+	if !ok {
+		return nil, nil, "", errors.New("artist browsing not supported by engine")
+	}
+	return catalog, cmdCtx, res.ID, nil
+}
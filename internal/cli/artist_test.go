@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/steipete/spogo/internal/app"
+	"github.com/steipete/spogo/internal/output"
+	"github.com/steipete/spogo/internal/spotify"
+	"github.com/steipete/spogo/internal/testutil"
+)
+
+func TestArtistDiscographyCmds(t *testing.T) {
+	var groups []spotify.AlbumGroup
+	mock := &testutil.SpotifyMock{
+		ArtistAlbumsFn: func(_ context.Context, id string, group spotify.AlbumGroup, limit, offset int) ([]spotify.Item, int, error) {
+			if id != "a1" || limit != 50 || offset != 5 {
+				t.Fatalf("unexpected args %q %d %d", id, limit, offset)
+			}
+			groups = append(groups, group)
+			return []spotify.Item{{URI: "spotify:album:x", Name: "Release", Type: "album"}}, 12, nil
+		},
+	}
+	args := ArtistDiscographyArgs{Artist: "spotify:artist:a1", Limit: 99, Offset: 5}
+	for _, cmd := range []interface{ Run(*app.Context) error }{
+		&ArtistAlbumsCmd{args}, &ArtistSinglesCmd{args}, &ArtistAppearsOnCmd{args},
+	} {
+		ctx, out, _ := testutil.NewTestContext(t, output.FormatJSON)
+		ctx.SetSpotify(mock)
+		if err := cmd.Run(ctx); err != nil {
+			t.Fatalf("run: %v", err)
+		}
+		var payload map[string]any
+		if err := json.Unmarshal(out.Bytes(), &payload); err != nil {
+			t.Fatalf("json: %v", err)
+		}
+		if payload["total"] != float64(12) || payload["offset"] != float64(5) {
+			t.Fatalf("payload %#v", payload)
+		}
+	}
+	want := []spotify.AlbumGroup{spotify.AlbumGroupAlbum, spotify.AlbumGroupSingle, spotify.AlbumGroupAppearsOn}
+	if len(groups) != 3 || groups[0] != want[0] || groups[1] != want[1] || groups[2] != want[2] {
+		t.Fatalf("groups %v", groups)
+	}
+}
+
+func TestArtistTopAndRelatedCmds(t *testing.T) {
+	ctx, out, _ := testutil.NewTestContext(t, output.FormatPlain)
+	ctx.SetSpotify(&testutil.SpotifyMock{
+		ArtistTopTracksFn: func(_ context.Context, id string, limit int) ([]spotify.Item, error) {
+			if limit != 3 {
+				t.Fatalf("limit %d", limit)
+			}
+			return []spotify.Item{{URI: "spotify:track:t1", Name: "Hit", Type: "track"}}, nil
+		},
+		RelatedArtistsFn: func(context.Context, string, int) ([]spotify.Item, error) {
+			return []spotify.Item{{URI: "spotify:artist:r1", Name: "Friend", Type: "artist"}}, nil
+		},
+	})
+	if err := (&ArtistTopCmd{Artist: "https://open.spotify.com/artist/a1", Limit: 3}).Run(ctx); err != nil {
+		t.Fatalf("top: %v", err)
+	}
+	if err := (&ArtistRelatedCmd{Artist: "a1"}).Run(ctx); err != nil {
+		t.Fatalf("related: %v", err)
+	}
+	if !strings.Contains(out.String(), "Hit") || !strings.Contains(out.String(), "Friend") {
+		t.Fatalf("output %q", out.String())
+	}
+}
+
+func TestArtistCmdErrors(t *testing.T) {
+	ctx, _, _ := testutil.NewTestContext(t, output.FormatPlain)
+	ctx.SetSpotify(&testutil.SpotifyMock{
+		RelatedArtistsFn: func(context.Context, string, int) ([]spotify.Item, error) {
+			return nil, errors.New("boom")
+		},
+		ArtistAlbumsFn: func(context.Context, string, spotify.AlbumGroup, int, int) ([]spotify.Item, int, error) {
+			return nil, 0, errors.New("boom")
+		},
+	})
+	if err := (&ArtistRelatedCmd{Artist: "a1"}).Run(ctx); err == nil {
+		t.Fatalf("expected related error")
+	}
+	if err := (&ArtistAlbumsCmd{ArtistDiscographyArgs{Artist: "a1"}}).Run(ctx); err == nil {
+		t.Fatalf("expected albums error")
+	}
+	if err := (&ArtistTopCmd{Artist: "spotify:album:a1"}).Run(ctx); err == nil {
+		t.Fatalf("expected invalid id error")
+	}
+	ctx.SetSpotify(struct{ spotify.API }{&testutil.SpotifyMock{}})
+	if err := (&ArtistSinglesCmd{ArtistDiscographyArgs{Artist: "a1"}}).Run(ctx); err == nil || !strings.Contains(err.Error(), "not supported") {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+}
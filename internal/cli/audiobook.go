@@ -0,0 +1,158 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/steipete/spogo/internal/app"
+	"github.com/steipete/spogo/internal/spotify"
+)
+
+type AudiobookCmd struct {
+	Info     InfoAudiobookCmd     `kong:"cmd,help='Audiobook info (authors, narrators, chapters).'"`
+	Chapters AudiobookChaptersCmd `kong:"cmd,help='List audiobook chapters with resume points.'"`
+}
+
+type ChapterCmd struct {
+	Info InfoChapterCmd `kong:"cmd,help='Chapter info.'"`
+}
+
+type InfoAudiobookCmd struct{ InfoArgs }
+
+type InfoChapterCmd struct{ InfoArgs }
+
+type AudiobookChaptersCmd struct {
+	Audiobook string `arg:"" required:"" help:"Audiobook ID/URL/URI."`
+	Limit     int    `help:"Limit results." default:"20"`
+	Offset    int    `help:"Offset results." default:"0"`
+}
+
+type LibraryAudiobooksCmd struct {
+	List   LibraryAudiobooksListCmd   `kong:"cmd,help='List saved audiobooks.'"`
+	Add    LibraryAudiobooksAddCmd    `kong:"cmd,help='Save audiobooks.'"`
+	Remove LibraryAudiobooksRemoveCmd `kong:"cmd,help='Remove saved audiobooks.'"`
+}
+
+type LibraryAudiobooksListCmd struct {
+	Limit  int `help:"Limit results." default:"50"`
+	Offset int `help:"Offset results." default:"0"`
+}
+
+type LibraryAudiobooksAddCmd struct {
+	IDs []string `arg:"" required:"" help:"Audiobook IDs/URLs/URIs."`
+}
+
+type LibraryAudiobooksRemoveCmd struct {
+	IDs []string `arg:"" required:"" help:"Audiobook IDs/URLs/URIs."`
+}
+
+// uriAliases lists the URI type Spotify also uses for a kind: audiobooks and
+// chapters are frequently shared as show and episode links.
+var uriAliases = map[string]string{
+	"audiobook": "show",
+	"chapter":   "episode",
+}
+
+func (cmd *InfoAudiobookCmd) Run(ctx *app.Context) error {
+	catalog, cmdCtx, id, err := audiobookCatalog(ctx, cmd.ID, "audiobook")
+	if err != nil {
+		return err
+	}
+	item, err := catalog.GetAudiobook(cmdCtx, id)
+	if err != nil {
+		return err
+	}
+	return emitItem(ctx, item)
+}
+
+func (cmd *InfoChapterCmd) Run(ctx *app.Context) error {
+	catalog, cmdCtx, id, err := audiobookCatalog(ctx, cmd.ID, "chapter")
+	if err != nil {
+		return err
+	}
+	item, err := catalog.GetChapter(cmdCtx, id)
+	if err != nil {
+		return err
+	}
+	return emitItem(ctx, item)
+}
+
+func (cmd *AudiobookChaptersCmd) Run(ctx *app.Context) error {
+	catalog, cmdCtx, id, err := audiobookCatalog(ctx, cmd.Audiobook, "audiobook")
+	if err != nil {
+		return err
+	}
+	items, total, err := catalog.AudiobookChapters(cmdCtx, id, clampLimit(cmd.Limit), cmd.Offset)
+	if err != nil {
+		return err
+	}
+	plain := make([]string, 0, len(items))
+	human := make([]string, 0, len(items))
+	for _, item := range items {
+		plain = append(plain, fmt.Sprintf("chapter\t%d\t%s\t%s\t%d\t%d\t%t\t%s", item.ChapterNumber, item.ID, item.Name,
+			item.DurationMS, item.ResumePositionMS, item.FullyPlayed, item.URI))
+		human = append(human, fmt.Sprintf("%s %s", ctx.Output.Theme.Muted(fmt.Sprintf("%3d", item.ChapterNumber)), episodeHuman(ctx.Output, item)))
+	}
+	payload := map[string]any{"audiobook": "spotify:audiobook:" + id, "total": total, "offset": cmd.Offset, "items": items}
+	return ctx.Output.Emit(payload, plain, human)
+}
+
+func (cmd *LibraryAudiobooksListCmd) Run(ctx *app.Context) error {
+	catalog, cmdCtx, _, err := audiobookCatalog(ctx, "", "")
+	if err != nil {
+		return err
+	}
+	items, total, err := catalog.LibraryAudiobooks(cmdCtx, clampLimit(cmd.Limit), cmd.Offset)
+	if err != nil {
+		return err
+	}
+	return emitItems(ctx, items, total, nil)
+}
+
+func (cmd *LibraryAudiobooksAddCmd) Run(ctx *app.Context) error {
+	return libraryModify(ctx, cmd.IDs, "audiobook", "/me/audiobooks", "PUT")
+}
+
+func (cmd *LibraryAudiobooksRemoveCmd) Run(ctx *app.Context) error {
+	return libraryModify(ctx, cmd.IDs, "audiobook", "/me/audiobooks", "DELETE")
+}
+
+// audiobookCatalog resolves input (when given) and the engine's audiobook
+// support in one step, like artistCatalog.
+func audiobookCatalog(ctx *app.Context, input, kind string) (spotify.AudiobookCatalog, context.Context, string, error) {
+	id := ""
+	if kind != "" {
+		res, err := parseAliasedID(input, kind)
+		if err != nil {
+			return nil, nil, "", err
+		}
+		id = res.ID
+	}
+	client, cmdCtx, err := spotifyClient(ctx)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	catalog, ok := client.(spotify.AudiobookCatalog)
+	if !ok {
+		return nil, nil, "", errors.New("audiobooks not supported by engine")
+	}
+	return catalog, cmdCtx, id, nil
+}
+
+// parseAliasedID is ParseTypedID that also accepts kind's show/episode alias.
+func parseAliasedID(input, kind string) (spotify.Resource, error) {
+	res, err := spotify.ParseTypedID(strings.TrimSpace(input), kind)
+	if err == nil {
+		return res, nil
+	}
+	if alias, ok := uriAliases[kind]; ok {
+		if aliased, aerr := spotify.ParseTypedID(strings.TrimSpace(input), alias); aerr == nil {
+			aliased.Type = kind
+			aliased.URI = "spotify:" + kind + ":" + aliased.ID
+			return aliased, nil
+		}
+	}
+	return spotify.Resource{}, err
+}
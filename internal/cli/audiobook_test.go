@@ -0,0 +1,146 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/steipete/spogo/internal/output"
+	"github.com/steipete/spogo/internal/spotify"
+	"github.com/steipete/spogo/internal/testutil"
+)
+
+func audiobookMock() *testutil.SpotifyMock {
+	return &testutil.SpotifyMock{
+		GetAudiobookFn: func(_ context.Context, id string) (spotify.Item, error) {
+			return spotify.Item{
+				ID: id, URI: "spotify:show:" + id, Name: "Dune", Type: "audiobook",
+				Authors: []string{"Frank Herbert"}, Narrators: []string{"Scott Brick", "Orlagh Cassidy"}, TotalChapters: 48,
+			}, nil
+		},
+		GetChapterFn: func(_ context.Context, id string) (spotify.Item, error) {
+			return spotify.Item{ID: id, URI: "spotify:episode:" + id, Name: "Chapter 3", Type: "chapter", ChapterNumber: 3, DurationMS: 60000}, nil
+		},
+		AudiobookChaptersFn: func(_ context.Context, id string, limit, offset int) ([]spotify.Item, int, error) {
+			return []spotify.Item{
+				{ID: "c1", URI: "spotify:episode:c1", Name: "Opening", Type: "chapter", ChapterNumber: 1, DurationMS: 1200000, FullyPlayed: true},
+				{ID: "c2", URI: "spotify:episode:c2", Name: "Arrakis", Type: "chapter", ChapterNumber: 2, DurationMS: 1800000, ResumePositionMS: 600000},
+			}, 48, nil
+		},
+		LibraryAudiobooksFn: func(context.Context, int, int) ([]spotify.Item, int, error) {
+			return []spotify.Item{{ID: "b1", Name: "Dune", Type: "audiobook", Authors: []string{"Frank Herbert"}}}, 1, nil
+		},
+	}
+}
+
+func TestAudiobookInfoCmd(t *testing.T) {
+	ctx, out, _ := testutil.NewTestContext(t, output.FormatHuman)
+	ctx.SetSpotify(audiobookMock())
+	// Audiobooks are often shared as show links.
+	if err := (&InfoAudiobookCmd{InfoArgs{ID: "https://open.spotify.com/show/b1"}}).Run(ctx); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	text := out.String()
+	if !strings.Contains(text, "Frank Herbert") || !strings.Contains(text, "read by Scott Brick, Orlagh Cassidy") || !strings.Contains(text, "48 chapters") {
+		t.Fatalf("output %q", text)
+	}
+
+	ctx, out, _ = testutil.NewTestContext(t, output.FormatPlain)
+	ctx.SetSpotify(audiobookMock())
+	if err := (&InfoChapterCmd{InfoArgs{ID: "spotify:chapter:c3"}}).Run(ctx); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if got := strings.TrimSpace(out.String()); got != "chapter\tc3\tChapter 3\t3\t60000" {
+		t.Fatalf("plain %q", got)
+	}
+
+	if err := (&InfoAudiobookCmd{InfoArgs{ID: "spotify:track:t1"}}).Run(ctx); err == nil {
+		t.Fatalf("expected type error, got %v", err)
+	}
+}
+
+func TestAudiobookChaptersCmd(t *testing.T) {
+	ctx, out, _ := testutil.NewTestContext(t, output.FormatPlain)
+	ctx.SetSpotify(audiobookMock())
+	if err := (&AudiobookChaptersCmd{Audiobook: "b1"}).Run(ctx); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 2 || lines[1] != "chapter\t2\tc2\tArrakis\t1800000\t600000\tfalse\tspotify:episode:c2" {
+		t.Fatalf("plain %q", lines)
+	}
+
+	ctx, out, _ = testutil.NewTestContext(t, output.FormatJSON)
+	ctx.SetSpotify(audiobookMock())
+	if err := (&AudiobookChaptersCmd{Audiobook: "spotify:audiobook:b1", Offset: 2}).Run(ctx); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	var payload struct {
+		Audiobook string           `json:"audiobook"`
+		Total     int              `json:"total"`
+		Items     []map[string]any `json:"items"`
+	}
+	if err := json.Unmarshal(out.Bytes(), &payload); err != nil {
+		t.Fatalf("json: %v", err)
+	}
+	if payload.Audiobook != "spotify:audiobook:b1" || payload.Total != 48 || payload.Items[0]["chapter_number"] != float64(1) {
+		t.Fatalf("payload %#v", payload)
+	}
+}
+
+func TestLibraryAudiobooksCmds(t *testing.T) {
+	var calls []string
+	mock := audiobookMock()
+	mock.LibraryModifyFn = func(_ context.Context, path string, ids []string, method string) error {
+		calls = append(calls, method+" "+path+" "+strings.Join(ids, ","))
+		return nil
+	}
+	ctx, out, _ := testutil.NewTestContext(t, output.FormatPlain)
+	ctx.SetSpotify(mock)
+	if err := (&LibraryAudiobooksListCmd{Limit: 10}).Run(ctx); err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if !strings.HasPrefix(out.String(), "audiobook\tb1\tDune\tFrank Herbert") {
+		t.Fatalf("plain %q", out.String())
+	}
+	if err := (&LibraryAudiobooksAddCmd{IDs: []string{"spotify:audiobook:b1", "spotify:show:b2"}}).Run(ctx); err != nil {
+		t.Fatalf("add: %v", err)
+	}
+	if err := (&LibraryAudiobooksRemoveCmd{IDs: []string{"b3"}}).Run(ctx); err != nil {
+		t.Fatalf("remove: %v", err)
+	}
+	if strings.Join(calls, ";") != "PUT /me/audiobooks b1,b2;DELETE /me/audiobooks b3" {
+		t.Fatalf("calls %q", calls)
+	}
+}
+
+func TestAudiobookCmdsUnsupportedEngine(t *testing.T) {
+	ctx, _, _ := testutil.NewTestContext(t, output.FormatPlain)
+	ctx.SetSpotify(struct{ spotify.API }{&testutil.SpotifyMock{}})
+	if err := (&LibraryAudiobooksListCmd{}).Run(ctx); err == nil || !strings.Contains(err.Error(), "not supported") {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+	if err := (&AudiobookChaptersCmd{Audiobook: "b1"}).Run(ctx); err == nil {
+		t.Fatalf("expected unsupported")
+	}
+}
+
+func TestPlayAudiobookUsesShowURI(t *testing.T) {
+	var played []string
+	ctx, _, _ := testutil.NewTestContext(t, output.FormatPlain)
+	ctx.SetSpotify(&testutil.SpotifyMock{
+		PlayFn: func(_ context.Context, uri string) error {
+			played = append(played, uri)
+			return nil
+		},
+	})
+	for _, cmd := range []*PlayCmd{{Item: "spotify:audiobook:b1"}, {Item: "c1", Type: "chapter"}} {
+		if err := cmd.Run(ctx); err != nil {
+			t.Fatalf("play: %v", err)
+		}
+	}
+	if strings.Join(played, ",") != "spotify:show:b1,spotify:episode:c1" {
+		t.Fatalf("played %q", played)
+	}
+}
@@ -1,14 +1,22 @@
 package cli
 
+import "time"
+
 type AuthCmd struct {
-	Status AuthStatusCmd `kong:"cmd,help='Show cookie status.'"`
-	Import AuthImportCmd `kong:"cmd,help='Import browser cookies.'"`
-	Paste  AuthPasteCmd  `kong:"cmd,help='Paste cookie values from the browser.'"`
-	Clear  AuthClearCmd  `kong:"cmd,help='Clear stored cookies.'"`
+	Status  AuthStatusCmd  `kong:"cmd,help='Show cookie status.'"`
+	Check   AuthCheckCmd   `kong:"cmd,help='Verify cookies against Spotify and report expiry.'"`
+	Import  AuthImportCmd  `kong:"cmd,help='Import browser cookies.'"`
+	Paste   AuthPasteCmd   `kong:"cmd,help='Paste cookie values from the browser.'"`
+	Clear   AuthClearCmd   `kong:"cmd,help='Clear stored cookies.'"`
+	Migrate AuthMigrateCmd `kong:"cmd,help='Move cookies and tokens between files and the keyring.'"`
 }
 
 type AuthStatusCmd struct{}
 
+type AuthCheckCmd struct {
+	WarnWithin time.Duration `name:"warn-within" help:"Warn when a cookie expires within this window." default:"168h"`
+}
+
 type AuthImportCmd struct {
 	Browser    string `help:"Browser name (chrome|brave|edge|firefox|safari)."`
 	Profile    string `name:"browser-profile" help:"Browser profile name."`
@@ -24,6 +32,10 @@ type AuthPasteCmd struct {
 
 type AuthClearCmd struct{}
 
+type AuthMigrateCmd struct {
+	To string `help:"Destination (keyring|file)." default:"keyring"`
+}
+
 type authStatusPayload struct {
 	CookieCount int    `json:"cookie_count"`
 	HasSPDC     bool   `json:"has_sp_dc"`
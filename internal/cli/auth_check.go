@@ -0,0 +1,200 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/steipete/spogo/internal/app"
+	"github.com/steipete/spogo/internal/spotify"
+)
+
+// auth check exit codes on top of the shared 3 (bad cookies) and 4 (network):
+// exitLoggedOut means Spotify only issued an anonymous token, exitClientToken
+// that the client token Connect playback needs could not be fetched.
+const (
+	exitLoggedOut   = 5
+	exitClientToken = 6
+)
+
+var (
+	checkAuth    = spotify.CheckAuth
+	authCheckNow = time.Now
+)
+
+type authCheckPayload struct {
+	Status               string               `json:"status"`
+	Error                string               `json:"error,omitempty"`
+	Source               string               `json:"source"`
+	LoggedIn             bool                 `json:"logged_in"`
+	Cookies              []authCookieExpiry   `json:"cookies"`
+	TokenExpiresAt       string               `json:"token_expires_at,omitempty"`
+	TokenTTLSeconds      int                  `json:"token_ttl_seconds"`
+	ClientTokenValid     bool                 `json:"client_token_valid"`
+	ClientTokenExpiresAt string               `json:"client_token_expires_at,omitempty"`
+	ClientTokenError     string               `json:"client_token_error,omitempty"`
+	Account              *spotify.UserProfile `json:"account,omitempty"`
+	Warnings             []string             `json:"warnings,omitempty"`
+}
+
+// authCookieExpiry leaves Expires empty for session cookies.
+type authCookieExpiry struct {
+	Name    string `json:"name"`
+	Expires string `json:"expires,omitempty"`
+}
+
+// cookieList serves already-read cookies to the token exchange.
+type cookieList []*http.Cookie
+
+func (l cookieList) Cookies(context.Context) ([]*http.Cookie, error) {
+	return l, nil
+}
+
+// Run exchanges the cookies for fresh tokens, bypassing the token cache, and
+// reports the result even when the check fails; the error then carries an
+// exit code a cron job can act on.
+func (cmd *AuthCheckCmd) Run(ctx *app.Context) error {
+	jar, source, err := readCookies(ctx)
+	if err != nil {
+		return app.WrapExit(3, err)
+	}
+	now := authCheckNow()
+	payload := authCheckPayload{Source: source, Cookies: []authCookieExpiry{}}
+	var expired *http.Cookie
+	for _, name := range []string{"sp_dc", "sp_t", "sp_key"} {
+		cookie := findCookie(jar, name)
+		if cookie == nil {
+			continue
+		}
+		entry := authCookieExpiry{Name: name}
+		if !cookie.Expires.IsZero() {
+			entry.Expires = cookie.Expires.UTC().Format(time.RFC3339)
+			switch left := cookie.Expires.Sub(now); {
+			case left <= 0:
+				if name == "sp_dc" {
+					expired = cookie
+				} else {
+					payload.Warnings = append(payload.Warnings, fmt.Sprintf("%s expired on %s", name, cookie.Expires.Format(time.DateOnly)))
+				}
+			case left <= cmd.WarnWithin:
+				payload.Warnings = append(payload.Warnings, fmt.Sprintf("%s expires in %s (%s); run spogo auth import to refresh", name, roundDays(left), cookie.Expires.Format(time.DateOnly)))
+			}
+		}
+		payload.Cookies = append(payload.Cookies, entry)
+	}
+	switch {
+	case findCookie(jar, "sp_dc") == nil:
+		return emitAuthCheck(ctx, payload, "missing", app.WrapExit(3, errors.New("no sp_dc cookie; run spogo auth import")))
+	case expired != nil:
+		return emitAuthCheck(ctx, payload, "expired", app.WrapExit(3, fmt.Errorf("sp_dc expired on %s; run spogo auth import", expired.Expires.Format(time.DateOnly))))
+	}
+
+	check, err := checkAuth(ctx.CommandContext(), cookieList(jar), &http.Client{Timeout: ctx.EnsureTimeout()})
+	if err != nil {
+		status := "rejected"
+		var netErr net.Error
+		if errors.As(err, &netErr) {
+			status = "unreachable"
+		}
+		return emitAuthCheck(ctx, payload, status, fmt.Errorf("token exchange failed: %w", err))
+	}
+	payload.LoggedIn = !check.Token.Anonymous
+	payload.TokenExpiresAt = check.Token.ExpiresAt.UTC().Format(time.RFC3339)
+	payload.TokenTTLSeconds = max(0, int(check.Token.ExpiresAt.Sub(now).Seconds()))
+	if check.ClientTokenErr == nil {
+		payload.ClientTokenValid = true
+		payload.ClientTokenExpiresAt = check.ClientTokenExpiresAt.UTC().Format(time.RFC3339)
+	} else {
+		payload.ClientTokenError = check.ClientTokenErr.Error()
+	}
+	if check.AccountErr == nil && check.Account.ID != "" {
+		account := check.Account
+		payload.Account = &account
+	} else if check.AccountErr != nil {
+		payload.Warnings = append(payload.Warnings, fmt.Sprintf("account lookup failed: %v", check.AccountErr))
+	}
+	switch {
+	case check.Token.Anonymous:
+		return emitAuthCheck(ctx, payload, "logged_out", app.WrapExit(exitLoggedOut, errors.New("cookies are logged out: Spotify issued an anonymous token (sp_dc expired or revoked); run spogo auth import")))
+	case check.ClientTokenErr != nil:
+		return emitAuthCheck(ctx, payload, "client_token_failed", app.WrapExit(exitClientToken, fmt.Errorf("client token: %w (connect playback will fail)", check.ClientTokenErr)))
+	}
+	return emitAuthCheck(ctx, payload, "ok", nil)
+}
+
+func emitAuthCheck(ctx *app.Context, payload authCheckPayload, status string, checkErr error) error {
+	payload.Status = status
+	if checkErr != nil {
+		payload.Error = checkErr.Error()
+	}
+	for _, warning := range payload.Warnings {
+		_, _ = fmt.Fprintln(ctx.Output.Err, "warning: "+warning)
+	}
+	spdcExpires := ""
+	for _, cookie := range payload.Cookies {
+		if cookie.Name == "sp_dc" {
+			spdcExpires = cookie.Expires
+		}
+	}
+	accountID := ""
+	if payload.Account != nil {
+		accountID = payload.Account.ID
+	}
+	plain := []string{fmt.Sprintf("%s\t%t\t%s\t%d\t%t\t%s", status, payload.LoggedIn, accountID, payload.TokenTTLSeconds, payload.ClientTokenValid, spdcExpires)}
+	if err := ctx.Output.Emit(payload, plain, authCheckHuman(payload)); err != nil {
+		return err
+	}
+	return checkErr
+}
+
+func authCheckHuman(payload authCheckPayload) []string {
+	lines := []string{fmt.Sprintf("Status: %s", payload.Status)}
+	for _, cookie := range payload.Cookies {
+		if cookie.Expires == "" {
+			lines = append(lines, fmt.Sprintf("%s: session cookie (%s)", cookie.Name, payload.Source))
+		} else {
+			lines = append(lines, fmt.Sprintf("%s: expires %s (%s)", cookie.Name, cookie.Expires, payload.Source))
+		}
+	}
+	if payload.TokenExpiresAt == "" {
+		return lines
+	}
+	switch {
+	case payload.Account != nil:
+		lines = append(lines, fmt.Sprintf("Account: %s (%s)", firstNonEmpty(payload.Account.DisplayName, payload.Account.ID), payload.Account.ID))
+	case payload.LoggedIn:
+		lines = append(lines, "Account: logged in")
+	default:
+		lines = append(lines, "Account: anonymous")
+	}
+	lines = append(lines, fmt.Sprintf("Access token: valid for %s", time.Duration(payload.TokenTTLSeconds)*time.Second))
+	if payload.ClientTokenValid {
+		lines = append(lines, fmt.Sprintf("Client token: valid until %s", payload.ClientTokenExpiresAt))
+	} else {
+		lines = append(lines, fmt.Sprintf("Client token: failed (%s)", payload.ClientTokenError))
+	}
+	return lines
+}
+
+func findCookie(jar []*http.Cookie, name string) *http.Cookie {
+	for _, cookie := range jar {
+		if cookie.Name == name {
+			return cookie
+		}
+	}
+	return nil
+}
+
+func roundDays(d time.Duration) string {
+	if d < 24*time.Hour {
+		return d.Round(time.Minute).String()
+	}
+	days := int(d.Hours() / 24)
+	if days == 1 {
+		return "1 day"
+	}
+	return fmt.Sprintf("%d days", days)
+}
@@ -0,0 +1,148 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steipete/spogo/internal/app"
+	"github.com/steipete/spogo/internal/cookies"
+	"github.com/steipete/spogo/internal/output"
+	"github.com/steipete/spogo/internal/spotify"
+	"github.com/steipete/spogo/internal/testutil"
+)
+
+func stubAuthCheck(t *testing.T, check spotify.AuthCheck, err error) *[]*http.Cookie {
+	t.Helper()
+	seen := &[]*http.Cookie{}
+	prevCheck, prevNow := checkAuth, authCheckNow
+	checkAuth = func(ctx context.Context, source cookies.Source, client *http.Client) (spotify.AuthCheck, error) {
+		*seen, _ = source.Cookies(ctx)
+		return check, err
+	}
+	authCheckNow = func() time.Time { return time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC) }
+	t.Cleanup(func() { checkAuth, authCheckNow = prevCheck, prevNow })
+	return seen
+}
+
+func authCheckContext(t *testing.T, format output.Format, jar []*http.Cookie) (*app.Context, *bytes.Buffer, *bytes.Buffer) {
+	t.Helper()
+	ctx, out, errOut := testutil.NewTestContext(t, format)
+	path := filepath.Join(t.TempDir(), "cookies.json")
+	if err := cookies.Write(path, jar); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	ctx.Profile.CookiePath = path
+	return ctx, out, errOut
+}
+
+func TestAuthCheckOK(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	seen := stubAuthCheck(t, spotify.AuthCheck{
+		Token:                spotify.Token{AccessToken: "access", ExpiresAt: now.Add(time.Hour)},
+		ClientTokenExpiresAt: now.Add(10 * time.Minute),
+		Account:              spotify.UserProfile{ID: "steipete", DisplayName: "Peter"},
+	}, nil)
+	ctx, out, errOut := authCheckContext(t, output.FormatJSON, []*http.Cookie{
+		{Name: "sp_dc", Value: "token", Expires: now.Add(3 * 24 * time.Hour)},
+		{Name: "sp_t", Value: "device"},
+	})
+	if err := (&AuthCheckCmd{WarnWithin: 7 * 24 * time.Hour}).Run(ctx); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if len(*seen) != 2 {
+		t.Fatalf("expected stored cookies, got %#v", *seen)
+	}
+	var payload authCheckPayload
+	if err := json.Unmarshal([]byte(out.String()), &payload); err != nil {
+		t.Fatalf("json: %v %q", err, out.String())
+	}
+	if payload.Status != "ok" || !payload.LoggedIn || payload.Account.ID != "steipete" || payload.TokenTTLSeconds != 3600 || !payload.ClientTokenValid {
+		t.Fatalf("payload %#v", payload)
+	}
+	if payload.Cookies[0].Expires != "2026-10-21T12:00:00Z" || payload.Cookies[1].Expires != "" {
+		t.Fatalf("cookies %#v", payload.Cookies)
+	}
+	if !strings.Contains(errOut.String(), "warning: sp_dc expires in 3 days (2026-10-21)") {
+		t.Fatalf("expected expiry warning, got %q", errOut.String())
+	}
+}
+
+func TestAuthCheckFailures(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	valid := []*http.Cookie{{Name: "sp_dc", Value: "token"}, {Name: "sp_t", Value: "device", Expires: now.Add(-time.Hour)}}
+	tests := []struct {
+		name   string
+		jar    []*http.Cookie
+		check  spotify.AuthCheck
+		err    error
+		code   int
+		plain  string
+		stderr string
+	}{
+		{name: "missing", jar: []*http.Cookie{{Name: "sp_t", Value: "device"}}, code: 3, plain: "missing\tfalse\t\t0\tfalse\t"},
+		{name: "expired", jar: []*http.Cookie{{Name: "sp_dc", Value: "token", Expires: now.Add(-24 * time.Hour)}}, code: 3, plain: "expired\tfalse\t\t0\tfalse\t2026-10-17T12:00:00Z"},
+		{name: "rejected", jar: valid, err: spotify.APIError{Status: 401}, code: 3, plain: "rejected\t", stderr: "sp_t expired on 2026-10-18"},
+		{name: "offline", jar: valid, err: timeoutError{}, code: 4, plain: "unreachable\t"},
+		{
+			name:  "logged out",
+			jar:   valid,
+			check: spotify.AuthCheck{Token: spotify.Token{Anonymous: true, ExpiresAt: now.Add(time.Hour)}, ClientTokenExpiresAt: now},
+			code:  exitLoggedOut, plain: "logged_out\tfalse\t\t3600\ttrue\t",
+		},
+		{
+			name:  "client token",
+			jar:   valid,
+			check: spotify.AuthCheck{Token: spotify.Token{ExpiresAt: now.Add(time.Minute)}, ClientTokenErr: errors.New("boom"), AccountErr: errors.New("rate limited")},
+			code:  exitClientToken, plain: "client_token_failed\ttrue\t\t60\tfalse\t", stderr: "account lookup failed: rate limited",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stubAuthCheck(t, tt.check, tt.err)
+			ctx, out, errOut := authCheckContext(t, output.FormatPlain, tt.jar)
+			err := (&AuthCheckCmd{WarnWithin: time.Hour}).Run(ctx)
+			if got := app.ExitCode(err); got != tt.code {
+				t.Fatalf("exit code %d, want %d (%v)", got, tt.code, err)
+			}
+			if !strings.HasPrefix(out.String(), tt.plain) {
+				t.Fatalf("plain %q, want prefix %q", out.String(), tt.plain)
+			}
+			if !strings.Contains(errOut.String(), tt.stderr) {
+				t.Fatalf("stderr %q, want %q", errOut.String(), tt.stderr)
+			}
+		})
+	}
+}
+
+func TestAuthCheckHuman(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	stubAuthCheck(t, spotify.AuthCheck{
+		Token:          spotify.Token{Anonymous: true, ExpiresAt: now.Add(30 * time.Minute)},
+		ClientTokenErr: errors.New("missing sp_t cookie"),
+	}, nil)
+	ctx, out, _ := authCheckContext(t, output.FormatHuman, []*http.Cookie{{Name: "sp_dc", Value: "token", Expires: now.Add(30 * 24 * time.Hour)}})
+	if err := (&AuthCheckCmd{WarnWithin: time.Hour}).Run(ctx); app.ExitCode(err) != exitLoggedOut {
+		t.Fatalf("expected logged out, got %v", err)
+	}
+	for _, want := range []string{"Status: logged_out", "sp_dc: expires 2026-11-17T12:00:00Z (file)", "Account: anonymous", "Access token: valid for 30m0s", "Client token: failed (missing sp_t cookie)"} {
+		if !strings.Contains(out.String(), want) {
+			t.Fatalf("missing %q in %q", want, out.String())
+		}
+	}
+	if roundDays(90*time.Minute) != "1h30m0s" || roundDays(30*time.Hour) != "1 day" {
+		t.Fatalf("roundDays")
+	}
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
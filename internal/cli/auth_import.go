@@ -9,6 +9,7 @@ import (
 	"github.com/steipete/spogo/internal/config"
 	"github.com/steipete/spogo/internal/cookies"
 	"github.com/steipete/spogo/internal/output"
+	"github.com/steipete/spogo/internal/secrets"
 )
 
 func (cmd *AuthImportCmd) Run(ctx *app.Context) error {
@@ -52,6 +53,9 @@ func normalizeBrowserProfile(primary, fallback string) string {
 }
 
 func saveCookies(ctx *app.Context, path string, cookiesList []*http.Cookie, profileCfg config.Profile) error {
+	if ctx.UsesKeyring() {
+		return saveKeyringCookies(ctx, path, cookiesList, profileCfg)
+	}
 	if path == "" {
 		path = ctx.ResolveCookiePath()
 	}
@@ -70,3 +74,27 @@ func saveCookies(ctx *app.Context, path string, cookiesList []*http.Cookie, prof
 	payload := map[string]any{"cookie_count": len(cookiesList), "path": path}
 	return ctx.Output.Emit(payload, plain, human)
 }
+
+func saveKeyringCookies(ctx *app.Context, path string, cookiesList []*http.Cookie, profileCfg config.Profile) error {
+	if path != "" {
+		return fmt.Errorf("--cookie-path does not apply with cookie_store = keyring")
+	}
+	data, err := cookies.Encode(cookiesList)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Secrets().Set(secrets.CookieKey(ctx.ProfileKey), data); err != nil {
+		return err
+	}
+	profileCfg.CookiePath = ""
+	if err := ctx.SaveProfile(profileCfg); err != nil {
+		return err
+	}
+	if err := ctx.ClearCache(); err != nil {
+		return err
+	}
+	human := []string{fmt.Sprintf("Saved %d cookies to the keyring", len(cookiesList))}
+	plain := []string{fmt.Sprintf("%d\tkeyring", len(cookiesList))}
+	payload := map[string]any{"cookie_count": len(cookiesList), "store": "keyring"}
+	return ctx.Output.Emit(payload, plain, human)
+}
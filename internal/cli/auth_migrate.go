@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/steipete/spogo/internal/app"
+	"github.com/steipete/spogo/internal/config"
+	"github.com/steipete/spogo/internal/cookies"
+	"github.com/steipete/spogo/internal/secrets"
+)
+
+// Run moves the profile's cookies and Connect token cache between plaintext
+// files and the secrets store, then flips cookie_store. The source copies
+// are only removed once the destination and the config are written.
+func (cmd *AuthMigrateCmd) Run(ctx *app.Context) error {
+	switch cmd.To {
+	case "keyring":
+		return migrateToKeyring(ctx)
+	case "file":
+		return migrateToFile(ctx)
+	default:
+		return fmt.Errorf("unknown destination %q (use keyring or file)", cmd.To)
+	}
+}
+
+func migrateToKeyring(ctx *app.Context) error {
+	if ctx.UsesKeyring() {
+		return errors.New("cookies are already in the keyring")
+	}
+	cookiePath := activeCookiePath(ctx)
+	cookieData, err := os.ReadFile(cookiePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("no cookie file at %s; run spogo auth import first", cookiePath)
+		}
+		return err
+	}
+	jar, err := cookies.Decode(cookieData)
+	if err != nil {
+		return fmt.Errorf("%s: %w", cookiePath, err)
+	}
+	if cookieData, err = cookies.Encode(jar); err != nil {
+		return err
+	}
+	store := ctx.Secrets()
+	if err := store.Set(secrets.CookieKey(ctx.ProfileKey), cookieData); err != nil {
+		return err
+	}
+	cachePath := ctx.ResolveCachePath()
+	cacheData, err := os.ReadFile(cachePath)
+	movedCache := err == nil
+	if movedCache {
+		var compact bytes.Buffer
+		if json.Compact(&compact, cacheData) == nil {
+			movedCache = store.Set(secrets.CacheKey(ctx.ProfileKey), compact.Bytes()) == nil
+		} else {
+			// A corrupt cache is rebuilt on demand; it is not worth keeping.
+			movedCache = false
+		}
+	}
+	err = ctx.UpdateProfile(func(profile *config.Profile) error {
+		profile.CookieStore = "keyring"
+		profile.CookiePath = ""
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, path := range []string{cookiePath, cachePath} {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	return emitMigrated(ctx, "keyring", len(jar), movedCache, "the keyring")
+}
+
+func migrateToFile(ctx *app.Context) error {
+	if !ctx.UsesKeyring() {
+		return errors.New("cookies are already stored in files")
+	}
+	store := ctx.Secrets()
+	cookieKey := secrets.CookieKey(ctx.ProfileKey)
+	cacheKey := secrets.CacheKey(ctx.ProfileKey)
+	cookieData, err := store.Get(cookieKey)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return errors.New("no cookies in the keyring; run spogo auth import first")
+		}
+		return err
+	}
+	jar, err := cookies.Decode(cookieData)
+	if err != nil {
+		return err
+	}
+	cookiePath := ctx.ResolveCookiePath()
+	if err := cookies.Write(cookiePath, jar); err != nil {
+		return err
+	}
+	cacheData, err := store.Get(cacheKey)
+	movedCache := err == nil
+	if movedCache {
+		cachePath := ctx.ResolveCachePath()
+		if err := os.MkdirAll(filepath.Dir(cachePath), 0o700); err != nil {
+			return err
+		}
+		if err := os.WriteFile(cachePath, cacheData, 0o600); err != nil {
+			return err
+		}
+	}
+	err = ctx.UpdateProfile(func(profile *config.Profile) error {
+		profile.CookieStore = ""
+		profile.CookiePath = cookiePath
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, key := range []string{cookieKey, cacheKey} {
+		if err := store.Delete(key); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	return emitMigrated(ctx, "file", len(jar), movedCache, cookiePath)
+}
+
+func emitMigrated(ctx *app.Context, to string, count int, cache bool, where string) error {
+	payload := map[string]any{"status": "ok", "to": to, "cookie_count": count, "cache": cache}
+	return emitOK(ctx, payload, fmt.Sprintf("Moved %d cookies to %s", count, where))
+}
@@ -0,0 +1,175 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/steipete/spogo/internal/config"
+	"github.com/steipete/spogo/internal/cookies"
+	"github.com/steipete/spogo/internal/output"
+	"github.com/steipete/spogo/internal/secrets"
+	"github.com/steipete/sweetcookie"
+)
+
+func TestAuthMigrateRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	ctx, out := profileContext(t, output.FormatPlain, dir)
+	store := &secrets.Memory{}
+	ctx.SetSecrets(store)
+	if err := (&AuthMigrateCmd{To: "keyring"}).Run(ctx); err == nil || !strings.Contains(err.Error(), "auth import") {
+		t.Fatalf("expected missing cookie file, got %v", err)
+	}
+	if err := (&AuthMigrateCmd{To: "file"}).Run(ctx); err == nil {
+		t.Fatalf("expected already in files")
+	}
+	if err := (&AuthMigrateCmd{To: "vault"}).Run(ctx); err == nil {
+		t.Fatalf("expected unknown destination")
+	}
+
+	cookiePath := ctx.ResolveCookiePath()
+	if err := cookies.Write(cookiePath, []*http.Cookie{{Name: "sp_dc", Value: "token"}}); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	writeProfileFile(t, ctx.ResolveCachePath())
+	if err := os.WriteFile(ctx.ResolveCachePath(), []byte("{\n  \"version\": 1\n}"), 0o600); err != nil {
+		t.Fatalf("write cache: %v", err)
+	}
+	if err := (&AuthMigrateCmd{To: "keyring"}).Run(ctx); err != nil {
+		t.Fatalf("to keyring: %v", err)
+	}
+	for _, path := range []string{cookiePath, ctx.ResolveCachePath()} {
+		if config.FileExists(path) {
+			t.Fatalf("%s should be removed", path)
+		}
+	}
+	if data, _ := store.Get(secrets.CacheKey("default")); string(data) != `{"version":1}` {
+		t.Fatalf("cache %q", data)
+	}
+	loaded, err := config.Load(ctx.ConfigPath)
+	if err != nil || loaded.Profile("default").CookieStore != "keyring" || loaded.Profile("default").CookiePath != "" {
+		t.Fatalf("config %#v %v", loaded, err)
+	}
+	if err := (&AuthMigrateCmd{To: "keyring"}).Run(ctx); err == nil {
+		t.Fatalf("expected already in keyring")
+	}
+
+	out.Reset()
+	if err := (&AuthStatusCmd{}).Run(ctx); err != nil || !strings.HasSuffix(strings.TrimSpace(out.String()), "\tkeyring") {
+		t.Fatalf("status %q %v", out.String(), err)
+	}
+
+	if err := (&AuthMigrateCmd{To: "file"}).Run(ctx); err != nil {
+		t.Fatalf("to file: %v", err)
+	}
+	jar, err := cookies.Read(cookiePath)
+	if err != nil || jar[0].Value != "token" || !config.FileExists(ctx.ResolveCachePath()) {
+		t.Fatalf("files %#v %v", jar, err)
+	}
+	if store.Has(secrets.CookieKey("default")) || store.Has(secrets.CacheKey("default")) {
+		t.Fatalf("keyring entries should be removed")
+	}
+	if ctx.UsesKeyring() || ctx.Profile.CookiePath != cookiePath {
+		t.Fatalf("profile %#v", ctx.Profile)
+	}
+	ctx.Profile.CookieStore = "keyring"
+	if err := (&AuthMigrateCmd{To: "file"}).Run(ctx); err == nil || !strings.Contains(err.Error(), "no cookies in the keyring") {
+		t.Fatalf("expected empty keyring, got %v", err)
+	}
+}
+
+func TestAuthKeyringImportStatusClear(t *testing.T) {
+	dir := t.TempDir()
+	ctx, out := profileContext(t, output.FormatPlain, dir)
+	store := &secrets.Memory{}
+	ctx.SetSecrets(store)
+	ctx.Profile.CookieStore = "keyring"
+	restore := cookies.SetReadCookies(func(context.Context, sweetcookie.Options) (sweetcookie.Result, error) {
+		return sweetcookie.Result{Cookies: []sweetcookie.Cookie{{Name: "sp_dc", Value: "token", Domain: ".spotify.com"}}}, nil
+	})
+	defer restore()
+
+	if err := (&AuthImportCmd{CookiePath: "/tmp/x.json"}).Run(ctx); err == nil || !strings.Contains(err.Error(), "--cookie-path") {
+		t.Fatalf("expected cookie-path error, got %v", err)
+	}
+	out.Reset()
+	if err := (&AuthImportCmd{}).Run(ctx); err != nil || out.String() != "1\tkeyring\n" {
+		t.Fatalf("import %q %v", out.String(), err)
+	}
+	if config.FileExists(ctx.ResolveCookiePath()) || !store.Has(secrets.CookieKey("default")) {
+		t.Fatalf("cookies should only be in the keyring")
+	}
+	if err := store.Set(secrets.CacheKey("default"), []byte("{}")); err != nil {
+		t.Fatalf("set: %v", err)
+	}
+	if err := (&AuthClearCmd{}).Run(ctx); err != nil {
+		t.Fatalf("clear: %v", err)
+	}
+	if store.Has(secrets.CookieKey("default")) || store.Has(secrets.CacheKey("default")) {
+		t.Fatalf("clear should remove keyring entries")
+	}
+	out.Reset()
+	if err := (&AuthStatusCmd{}).Run(ctx); err != nil || !strings.HasSuffix(strings.TrimSpace(out.String()), "\tbrowser") {
+		t.Fatalf("status should fall back to the browser: %q %v", out.String(), err)
+	}
+	ctx.SetSecrets(failingStore{})
+	if err := (&AuthStatusCmd{}).Run(ctx); err == nil {
+		t.Fatalf("expected store error")
+	}
+}
+
+func TestProfileCommandsMoveKeyringEntries(t *testing.T) {
+	dir := t.TempDir()
+	ctx, out := profileContext(t, output.FormatPlain, dir)
+	store := &secrets.Memory{}
+	ctx.SetSecrets(store)
+	ctx.Config.SetProfile("work", config.Profile{CookieStore: "keyring"})
+	for _, key := range []string{secrets.CookieKey("work"), secrets.CacheKey("work")} {
+		if err := store.Set(key, []byte("[]")); err != nil {
+			t.Fatalf("set: %v", err)
+		}
+	}
+	if err := (&ProfileCopyCmd{From: "work", To: "copy", Cookies: true}).Run(ctx); err != nil {
+		t.Fatalf("copy: %v", err)
+	}
+	if err := (&ProfileRenameCmd{From: "work", To: "office"}).Run(ctx); err != nil {
+		t.Fatalf("rename: %v", err)
+	}
+	if store.Has(secrets.CookieKey("work")) || !store.Has(secrets.CookieKey("office")) || !store.Has(secrets.CacheKey("office")) || !store.Has(secrets.CookieKey("copy")) {
+		t.Fatalf("entries not moved")
+	}
+	out.Reset()
+	if err := (&ProfileListCmd{}).Run(ctx); err != nil || !strings.Contains(out.String(), "office\tfalse\t\ttrue\n") {
+		t.Fatalf("list %q %v", out.String(), err)
+	}
+	out.Reset()
+	ctx.Output.Format = output.FormatJSON
+	if err := (&ProfileShowCmd{Name: "office"}).Run(ctx); err != nil || !strings.Contains(out.String(), `"cookie_key": "cookies/office"`) {
+		t.Fatalf("show %q %v", out.String(), err)
+	}
+	if err := (&ProfileDeleteCmd{Name: "office"}).Run(ctx); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if store.Has(secrets.CookieKey("office")) || store.Has(secrets.CacheKey("office")) {
+		t.Fatalf("delete should remove keyring entries")
+	}
+	if err := (&ProfileCopyCmd{From: "copy", To: "again", Cookies: true}).Run(ctx); err != nil {
+		t.Fatalf("copy again: %v", err)
+	}
+	if err := store.Delete(secrets.CookieKey("copy")); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if err := (&ProfileCopyCmd{From: "copy", To: "empty", Cookies: true}).Run(ctx); err == nil {
+		t.Fatalf("expected missing keyring cookies")
+	}
+}
+
+type failingStore struct{}
+
+func (failingStore) Get(string) ([]byte, error) { return nil, errors.New("locked") }
+func (failingStore) Set(string, []byte) error   { return errors.New("locked") }
+func (failingStore) Delete(string) error        { return errors.New("locked") }
+func (failingStore) Has(string) bool            { return false }
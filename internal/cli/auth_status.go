@@ -30,12 +30,7 @@ func (cmd *AuthStatusCmd) Run(ctx *app.Context) error {
 }
 
 func hasCookie(cookiesList []*http.Cookie, name string) bool {
-	for _, cookie := range cookiesList {
-		if cookie.Name == name {
-			return true
-		}
-	}
-	return false
+	return findCookie(cookiesList, name) != nil
 }
 
 func cookieStatusLine(label, name string, present bool, missingHint string) string {
@@ -10,9 +10,19 @@ import (
 
 	"github.com/steipete/spogo/internal/app"
 	"github.com/steipete/spogo/internal/cookies"
+	"github.com/steipete/spogo/internal/secrets"
 )
 
 func (cmd *AuthClearCmd) Run(ctx *app.Context) error {
+	if ctx.UsesKeyring() {
+		if err := ctx.Secrets().Delete(secrets.CookieKey(ctx.ProfileKey)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		if err := ctx.ClearCache(); err != nil {
+			return err
+		}
+		return ctx.Output.Emit(map[string]string{"status": "ok"}, []string{"ok"}, []string{"Removed cookies from the keyring"})
+	}
 	path := activeCookiePath(ctx)
 	if path == "" {
 		return fmt.Errorf("no cookie path configured")
@@ -36,8 +46,16 @@ func (cmd *AuthClearCmd) Run(ctx *app.Context) error {
 }
 
 func readCookies(ctx *app.Context) ([]*http.Cookie, string, error) {
-	path := activeCookiePath(ctx)
-	if path != "" {
+	if ctx.UsesKeyring() {
+		data, err := ctx.Secrets().Get(secrets.CookieKey(ctx.ProfileKey))
+		if err == nil {
+			storeCookies, err := cookies.Decode(data)
+			return storeCookies, "keyring", err
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			return nil, "", err
+		}
+	} else if path := activeCookiePath(ctx); path != "" {
 		fileCookies, err := cookies.Read(path)
 		if err == nil {
 			return fileCookies, "file", nil
@@ -0,0 +1,168 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/steipete/spogo/internal/app"
+	"github.com/steipete/spogo/internal/output"
+	"github.com/steipete/spogo/internal/spotify"
+)
+
+type BrowseCmd struct {
+	Home        BrowseHomeCmd        `kong:"cmd,help='Home feed sections.'"`
+	Categories  BrowseCategoriesCmd  `kong:"cmd,help='Genre and mood categories.'"`
+	Category    BrowseCategoryCmd    `kong:"cmd,help='Sections of one category page.'"`
+	NewReleases BrowseNewReleasesCmd `kong:"cmd,name='new-releases',help='New album releases.'"`
+}
+
+type BrowseHomeCmd struct {
+	Limit int `help:"Items per section." default:"10"`
+}
+
+type BrowseCategoriesCmd struct {
+	Limit  int `help:"Limit results." default:"50"`
+	Offset int `help:"Offset results." default:"0"`
+}
+
+type BrowseCategoryCmd struct {
+	Category string `arg:"" required:"" help:"Category ID, spotify:page: URI, or genre URL."`
+	Limit    int    `help:"Items per section." default:"10"`
+}
+
+type BrowseNewReleasesCmd struct {
+	Limit  int `help:"Limit results." default:"20"`
+	Offset int `help:"Offset results." default:"0"`
+}
+
+func (cmd *BrowseHomeCmd) Run(ctx *app.Context) error {
+	browser, cmdCtx, err := browseClient(ctx)
+	if err != nil {
+		return err
+	}
+	sections, err := browser.BrowseHome(cmdCtx, clampLimit(cmd.Limit))
+	if err != nil {
+		return err
+	}
+	return emitSections(ctx, sections)
+}
+
+func (cmd *BrowseCategoriesCmd) Run(ctx *app.Context) error {
+	if err := checkOffset(cmd.Offset); err != nil {
+		return err
+	}
+	browser, cmdCtx, err := browseClient(ctx)
+	if err != nil {
+		return err
+	}
+	categories, total, err := browser.BrowseCategories(cmdCtx, clampLimit(cmd.Limit), cmd.Offset)
+	if err != nil {
+		return err
+	}
+	plain := make([]string, 0, len(categories))
+	human := make([]string, 0, len(categories))
+	for _, category := range categories {
+		plain = append(plain, fmt.Sprintf("%s\t%s", category.ID, category.Name))
+		human = append(human, fmt.Sprintf("%s %s", category.Name, ctx.Output.Theme.Muted(category.ID)))
+	}
+	return ctx.Output.Emit(map[string]any{"total": total, "items": categories}, plain, human)
+}
+
+func (cmd *BrowseCategoryCmd) Run(ctx *app.Context) error {
+	id, err := categoryID(cmd.Category)
+	if err != nil {
+		return err
+	}
+	browser, cmdCtx, err := browseClient(ctx)
+	if err != nil {
+		return err
+	}
+	sections, err := browser.BrowseCategory(cmdCtx, id, clampLimit(cmd.Limit))
+	if err != nil {
+		return err
+	}
+	return emitSections(ctx, sections)
+}
+
+func (cmd *BrowseNewReleasesCmd) Run(ctx *app.Context) error {
+	if err := checkOffset(cmd.Offset); err != nil {
+		return err
+	}
+	browser, cmdCtx, err := browseClient(ctx)
+	if err != nil {
+		return err
+	}
+	items, total, err := browser.NewReleases(cmdCtx, clampLimit(cmd.Limit), cmd.Offset)
+	if err != nil {
+		return err
+	}
+	return emitItems(ctx, items, total, map[string]any{"offset": cmd.Offset})
+}
+
+func checkOffset(offset int) error {
+	if offset < 0 {
+		return fmt.Errorf("offset must not be negative (got %d)", offset)
+	}
+	return nil
+}
+
+// emitSections prints each section's items; plain lines are prefixed with
+// the section title so they stay greppable.
+func emitSections(ctx *app.Context, sections []spotify.BrowseSection) error {
+	plain := []string{}
+	human := []string{}
+	for i, section := range sections {
+		sectionPlain, sectionHuman := renderItems(ctx.Output, section.Items)
+		for _, line := range sectionPlain {
+			plain = append(plain, section.Title+"\t"+line)
+		}
+		if ctx.Output.Format == output.FormatHuman {
+			if i > 0 {
+				human = append(human, "")
+			}
+			human = append(human, ctx.Output.Theme.Bold(section.Title))
+			for _, line := range sectionHuman {
+				human = append(human, "  "+line)
+			}
+		}
+	}
+	return ctx.Output.Emit(map[string]any{"sections": sections}, plain, human)
+}
+
+// categoryID accepts raw IDs, spotify:page: URIs and open.spotify.com
+// genre/page URLs.
+func categoryID(input string) (string, error) {
+	input = strings.TrimSpace(input)
+	if id, ok := strings.CutPrefix(input, "spotify:page:"); ok {
+		input = id
+	} else if strings.Contains(input, "://") {
+		parsed, err := url.Parse(input)
+		if err != nil {
+			return "", err
+		}
+		parts := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+		if len(parts) != 2 || (parts[0] != "genre" && parts[0] != "page") {
+			return "", fmt.Errorf("unsupported category url %q", input)
+		}
+		input = parts[1]
+	}
+	if input == "" || strings.ContainsAny(input, ":/") {
+		return "", fmt.Errorf("invalid category %q", input)
+	}
+	return input, nil
+}
+
+func browseClient(ctx *app.Context) (spotify.Browser, context.Context, error) {
+	client, cmdCtx, err := spotifyClient(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	browser, ok := client.(spotify.Browser)
+	if !ok {
+		return nil, nil, errors.New("browse not supported by engine")
+	}
+	return browser, cmdCtx, nil
+}
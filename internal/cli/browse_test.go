@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/steipete/spogo/internal/output"
+	"github.com/steipete/spogo/internal/spotify"
+	"github.com/steipete/spogo/internal/testutil"
+)
+
+func browseMock(gotID *string) *testutil.SpotifyMock {
+	sections := []spotify.BrowseSection{
+		{Title: "Made For You", Items: []spotify.Item{{ID: "p1", URI: "spotify:playlist:p1", Name: "Daily Mix", Type: "playlist"}}},
+		{Title: "Jump back in", Items: []spotify.Item{{ID: "a1", URI: "spotify:album:a1", Name: "Album", Type: "album"}}},
+	}
+	return &testutil.SpotifyMock{
+		BrowseHomeFn: func(context.Context, int) ([]spotify.BrowseSection, error) {
+			return sections, nil
+		},
+		BrowseCategoriesFn: func(context.Context, int, int) ([]spotify.Category, int, error) {
+			return []spotify.Category{{ID: "pop", Name: "Pop", URI: "spotify:page:pop"}}, 30, nil
+		},
+		BrowseCategoryFn: func(_ context.Context, id string, _ int) ([]spotify.BrowseSection, error) {
+			*gotID = id
+			return sections[:1], nil
+		},
+		NewReleasesFn: func(context.Context, int, int) ([]spotify.Item, int, error) {
+			return []spotify.Item{{ID: "n1", URI: "spotify:album:n1", Name: "New", Type: "album"}}, 80, nil
+		},
+	}
+}
+
+func TestBrowseCmds(t *testing.T) {
+	var gotID string
+	mock := browseMock(&gotID)
+
+	ctx, out, _ := testutil.NewTestContext(t, output.FormatPlain)
+	ctx.SetSpotify(mock)
+	if err := (&BrowseHomeCmd{}).Run(ctx); err != nil {
+		t.Fatalf("home: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 2 || !strings.HasPrefix(lines[0], "Made For You\tplaylist\tp1") || !strings.HasPrefix(lines[1], "Jump back in\talbum\ta1") {
+		t.Fatalf("plain %q", lines)
+	}
+
+	ctx, out, _ = testutil.NewTestContext(t, output.FormatHuman)
+	ctx.SetSpotify(mock)
+	if err := (&BrowseHomeCmd{}).Run(ctx); err != nil || !strings.Contains(out.String(), "Made For You\n  ") {
+		t.Fatalf("human %q %v", out.String(), err)
+	}
+
+	ctx, out, _ = testutil.NewTestContext(t, output.FormatJSON)
+	ctx.SetSpotify(mock)
+	if err := (&BrowseCategoriesCmd{}).Run(ctx); err != nil {
+		t.Fatalf("categories: %v", err)
+	}
+	var payload struct {
+		Total int                `json:"total"`
+		Items []spotify.Category `json:"items"`
+	}
+	if err := json.Unmarshal(out.Bytes(), &payload); err != nil || payload.Total != 30 || payload.Items[0].Name != "Pop" {
+		t.Fatalf("payload %s %v", out.String(), err)
+	}
+
+	ctx, out, _ = testutil.NewTestContext(t, output.FormatPlain)
+	ctx.SetSpotify(mock)
+	if err := (&BrowseCategoriesCmd{}).Run(ctx); err != nil || strings.TrimSpace(out.String()) != "pop\tPop" {
+		t.Fatalf("categories plain %q %v", out.String(), err)
+	}
+	if err := (&BrowseCategoryCmd{Category: "https://open.spotify.com/genre/0JQ5DAqbMKFEC4WFtoNRpw"}).Run(ctx); err != nil || gotID != "0JQ5DAqbMKFEC4WFtoNRpw" {
+		t.Fatalf("category: %v %q", err, gotID)
+	}
+	if err := (&BrowseNewReleasesCmd{}).Run(ctx); err != nil || !strings.Contains(out.String(), "album\tn1") {
+		t.Fatalf("new releases %q %v", out.String(), err)
+	}
+	if err := (&BrowseCategoriesCmd{Offset: -1}).Run(ctx); err == nil || !strings.Contains(err.Error(), "negative") {
+		t.Fatalf("expected negative offset error, got %v", err)
+	}
+	if err := (&BrowseNewReleasesCmd{Offset: -1}).Run(ctx); err == nil {
+		t.Fatalf("expected negative offset error")
+	}
+
+	ctx.SetSpotify(struct{ spotify.API }{mock})
+	if err := (&BrowseHomeCmd{}).Run(ctx); err == nil || !strings.Contains(err.Error(), "not supported") {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+}
+
+func TestCategoryID(t *testing.T) {
+	tests := map[string]string{
+		"pop":                                   "pop",
+		"spotify:page:0JQ5DAqbMKFEC4WFtoNRpw":   "0JQ5DAqbMKFEC4WFtoNRpw",
+		"https://open.spotify.com/genre/abc?x=": "abc",
+		"https://open.spotify.com/page/def":     "def",
+	}
+	for input, want := range tests {
+		if got, err := categoryID(input); err != nil || got != want {
+			t.Fatalf("%s: %q %v", input, got, err)
+		}
+	}
+	for _, input := range []string{"", "spotify:track:t1", "https://open.spotify.com/track/t1", "https://open.spotify.com/genre"} {
+		if _, err := categoryID(input); err == nil {
+			t.Fatalf("%q: expected error", input)
+		}
+	}
+}
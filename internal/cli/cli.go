@@ -3,6 +3,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/alecthomas/kong"
@@ -19,46 +20,68 @@ func New() *CLI {
 type CLI struct {
 	Globals Globals `kong:"embed"`
 
-	Auth     AuthCmd     `kong:"cmd,help='Authentication and cookies.'"`
-	Search   SearchCmd   `kong:"cmd,help='Search Spotify.'"`
-	Track    TrackCmd    `kong:"cmd,help='Track operations.'"`
-	Album    AlbumCmd    `kong:"cmd,help='Album operations.'"`
-	Artist   ArtistCmd   `kong:"cmd,help='Artist operations.'"`
-	Playlist PlaylistCmd `kong:"cmd,help='Playlist operations.'"`
-	Show     ShowCmd     `kong:"cmd,help='Show operations.'"`
-	Episode  EpisodeCmd  `kong:"cmd,help='Episode operations.'"`
-
-	Play    PlayCmd    `kong:"cmd,help='Start playback.'"`
-	Pause   PauseCmd   `kong:"cmd,help='Pause playback.'"`
-	Next    NextCmd    `kong:"cmd,help='Skip to next.'"`
-	Prev    PrevCmd    `kong:"cmd,help='Skip to previous.'"`
-	Seek    SeekCmd    `kong:"cmd,help='Seek within track.'"`
-	Volume  VolumeCmd  `kong:"cmd,help='Set volume.'"`
-	Shuffle ShuffleCmd `kong:"cmd,help='Toggle shuffle.'"`
-	Repeat  RepeatCmd  `kong:"cmd,help='Set repeat mode.'"`
-	Status  StatusCmd  `kong:"cmd,help='Playback status.'"`
+	Auth      AuthCmd      `kong:"cmd,help='Authentication and cookies.'"`
+	Profile   ProfileCmd   `kong:"cmd,help='Manage profiles.'"`
+	Config    ConfigCmd    `kong:"cmd,help='Read and write profile settings.'"`
+	Search    SearchCmd    `kong:"cmd,help='Search Spotify.'"`
+	Track     TrackCmd     `kong:"cmd,help='Track operations.'"`
+	Album     AlbumCmd     `kong:"cmd,help='Album operations.'"`
+	Artist    ArtistCmd    `kong:"cmd,help='Artist operations.'"`
+	Playlist  PlaylistCmd  `kong:"cmd,help='Playlist operations.'"`
+	Show      ShowCmd      `kong:"cmd,help='Show operations.'"`
+	Episode   EpisodeCmd   `kong:"cmd,help='Episode operations.'"`
+	Audiobook AudiobookCmd `kong:"cmd,help='Audiobook operations.'"`
+	Chapter   ChapterCmd   `kong:"cmd,help='Audiobook chapter operations.'"`
+
+	Play     PlayCmd     `kong:"cmd,help='Start playback.'"`
+	Pause    PauseCmd    `kong:"cmd,help='Pause playback.'"`
+	Next     NextCmd     `kong:"cmd,help='Skip to next.'"`
+	Prev     PrevCmd     `kong:"cmd,help='Skip to previous.'"`
+	Seek     SeekCmd     `kong:"cmd,help='Seek within track.'"`
+	Volume   VolumeCmd   `kong:"cmd,help='Set, step, or fade volume.'"`
+	Shuffle  ShuffleCmd  `kong:"cmd,help='Toggle shuffle.'"`
+	Repeat   RepeatCmd   `kong:"cmd,help='Set repeat mode.'"`
+	Status   StatusCmd   `kong:"cmd,help='Playback status.'"`
+	Like     LikeCmd     `kong:"cmd,help='Save the playing track or episode.'"`
+	Unlike   UnlikeCmd   `kong:"cmd,help='Remove the playing track or episode from your library.'"`
+	Sleep    SleepCmd    `kong:"cmd,help='Pause playback after a delay.'"`
+	TUI      TUICmd      `kong:"cmd,name='tui',help='Full-screen player.'"`
+	Snapshot SnapshotCmd `kong:"cmd,help='Save and restore playback.'"`
+	Lyrics   LyricsCmd   `kong:"cmd,help='Show lyrics for a track.'"`
+
+	Me     MeCmd     `kong:"cmd,help='Your account profile.'"`
+	Top    TopCmd    `kong:"cmd,help='Your top tracks and artists.'"`
+	Recent RecentCmd `kong:"cmd,help='Recently played tracks.'"`
+
+	Radio     RadioCmd     `kong:"cmd,help='Start radio from a track, artist, album or playlist.'"`
+	Recommend RecommendCmd `kong:"cmd,help='Recommend tracks from seeds.'"`
+	Browse    BrowseCmd    `kong:"cmd,help='Browse home, categories and new releases.'"`
 
 	Queue   QueueCmd   `kong:"cmd,help='Queue operations.'"`
 	Library LibraryCmd `kong:"cmd,help='Library operations.'"`
 	Device  DeviceCmd  `kong:"cmd,help='Playback devices.'"`
+	Alarm   AlarmCmd   `kong:"cmd,help='Scheduled playback.'"`
 }
 
 type Globals struct {
-	Config   string           `help:"Config file path." env:"SPOGO_CONFIG"`
-	Profile  string           `help:"Profile name." env:"SPOGO_PROFILE"`
-	Timeout  time.Duration    `help:"HTTP timeout." env:"SPOGO_TIMEOUT" default:"10s"`
-	Market   string           `help:"Market country code." env:"SPOGO_MARKET"`
-	Language string           `help:"Language/locale." env:"SPOGO_LANGUAGE"`
-	Device   string           `help:"Device name or id." env:"SPOGO_DEVICE"`
-	Engine   string           `help:"Engine (auto|web|connect|applescript)." env:"SPOGO_ENGINE"`
-	JSON     bool             `help:"JSON output." env:"SPOGO_JSON"`
-	Plain    bool             `help:"Plain output." env:"SPOGO_PLAIN"`
-	NoColor  bool             `help:"Disable color output." env:"SPOGO_NO_COLOR"`
-	Quiet    bool             `short:"q" help:"Quiet output." env:"SPOGO_QUIET"`
-	Verbose  bool             `short:"v" help:"Verbose output." env:"SPOGO_VERBOSE"`
-	Debug    bool             `short:"d" help:"Debug output." env:"SPOGO_DEBUG"`
-	NoInput  bool             `help:"Disable prompts." env:"SPOGO_NO_INPUT"`
-	Version  kong.VersionFlag `help:"Print version."`
+	Config      string           `help:"Config file path." env:"SPOGO_CONFIG"`
+	Profile     string           `help:"Profile name." env:"SPOGO_PROFILE"`
+	Profiles    []string         `help:"Run against several profiles at once (comma-separated)." env:"SPOGO_PROFILES"`
+	AllProfiles bool             `name:"all-profiles" help:"Run against every configured profile."`
+	Timeout     time.Duration    `help:"HTTP timeout." env:"SPOGO_TIMEOUT" default:"10s"`
+	Market      string           `help:"Market country code." env:"SPOGO_MARKET"`
+	Language    string           `help:"Language/locale." env:"SPOGO_LANGUAGE"`
+	Device      string           `help:"Device name or id." env:"SPOGO_DEVICE"`
+	Engine      string           `help:"Engine (auto|web|connect|applescript)." env:"SPOGO_ENGINE"`
+	WaitDevice  time.Duration    `name:"wait-device" help:"Wait up to this long for the preferred device to appear before playing." env:"SPOGO_WAIT_DEVICE"`
+	JSON        bool             `help:"JSON output." env:"SPOGO_JSON"`
+	Plain       bool             `help:"Plain output." env:"SPOGO_PLAIN"`
+	NoColor     bool             `help:"Disable color output." env:"SPOGO_NO_COLOR"`
+	Quiet       bool             `short:"q" help:"Quiet output." env:"SPOGO_QUIET"`
+	Verbose     bool             `short:"v" help:"Verbose output." env:"SPOGO_VERBOSE"`
+	Debug       bool             `short:"d" help:"Debug output." env:"SPOGO_DEBUG"`
+	NoInput     bool             `help:"Disable prompts." env:"SPOGO_NO_INPUT"`
+	Version     kong.VersionFlag `help:"Print version."`
 }
 
 func (g Globals) Settings() (app.Settings, error) {
@@ -66,20 +89,26 @@ func (g Globals) Settings() (app.Settings, error) {
 	if err != nil {
 		return app.Settings{}, err
 	}
+	if err := profileSelection(g.Profile, g.Profiles, g.AllProfiles); err != nil {
+		return app.Settings{}, err
+	}
 	return app.Settings{
-		ConfigPath: g.Config,
-		Profile:    g.Profile,
-		Timeout:    g.Timeout,
-		Market:     g.Market,
-		Language:   g.Language,
-		Device:     g.Device,
-		Engine:     g.Engine,
-		Format:     format,
-		NoColor:    g.NoColor,
-		Quiet:      g.Quiet,
-		Verbose:    g.Verbose,
-		Debug:      g.Debug,
-		NoInput:    g.NoInput,
+		ConfigPath:  g.Config,
+		Profile:     g.Profile,
+		Profiles:    g.Profiles,
+		AllProfiles: g.AllProfiles,
+		Timeout:     g.Timeout,
+		Market:      g.Market,
+		Language:    g.Language,
+		Device:      g.Device,
+		Engine:      g.Engine,
+		WaitDevice:  g.WaitDevice,
+		Format:      format,
+		NoColor:     g.NoColor,
+		Quiet:       g.Quiet,
+		Verbose:     g.Verbose,
+		Debug:       g.Debug,
+		NoInput:     g.NoInput,
 	}, nil
 }
 
@@ -96,6 +125,28 @@ func outputFormat(jsonFlag, plainFlag bool) (output.Format, error) {
 	return output.FormatHuman, nil
 }
 
+func profileSelection(profile string, profiles []string, all bool) error {
+	if all && len(profiles) > 0 {
+		return errors.New("--profiles and --all-profiles are mutually exclusive")
+	}
+	if profile != "" && (all || len(profiles) > 0) {
+		return errors.New("--profile cannot be combined with --profiles or --all-profiles")
+	}
+	return nil
+}
+
+// FanOutRefusal explains why a command cannot run against several profiles
+// at once: it is interactive, long-running, or writes the shared config.
+// It returns "" for commands that can.
+func FanOutRefusal(command string) string {
+	for _, prefix := range []string{"auth import", "auth paste", "auth clear", "auth migrate", "profile", "config", "alarm add", "alarm remove", "alarm run", "tui"} {
+		if command == prefix || strings.HasPrefix(command, prefix+" ") {
+			return fmt.Sprintf("%s cannot run with --profiles or --all-profiles; run it once per profile", prefix)
+		}
+	}
+	return ""
+}
+
 func VersionVars() map[string]string {
 	return map[string]string{
 		"version": Version,
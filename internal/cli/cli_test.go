@@ -16,3 +16,31 @@ func TestOutputFormatVariants(t *testing.T) {
 		t.Fatalf("expected human")
 	}
 }
+
+func TestProfileSelection(t *testing.T) {
+	if err := profileSelection("", []string{"a"}, true); err == nil {
+		t.Fatalf("expected --profiles/--all-profiles error")
+	}
+	if err := profileSelection("a", nil, true); err == nil {
+		t.Fatalf("expected --profile error")
+	}
+	if err := profileSelection("", []string{"a", "b"}, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := (Globals{Profile: "a", Profiles: []string{"b"}}).Settings(); err == nil {
+		t.Fatalf("expected settings error")
+	}
+}
+
+func TestFanOutRefusal(t *testing.T) {
+	for _, command := range []string{"profile list", "config set <key> <value>", "auth import", "alarm run", "tui"} {
+		if FanOutRefusal(command) == "" {
+			t.Fatalf("%s: expected refusal", command)
+		}
+	}
+	for _, command := range []string{"status", "device list", "auth status", "alarm list", "tuition"} {
+		if refusal := FanOutRefusal(command); refusal != "" {
+			t.Fatalf("%s: %s", command, refusal)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/steipete/spogo/internal/app"
+	"github.com/steipete/spogo/internal/config"
+)
+
+type ConfigCmd struct {
+	Get   ConfigGetCmd   `kong:"cmd,help='Show profile settings.'"`
+	Set   ConfigSetCmd   `kong:"cmd,help='Set a profile setting.'"`
+	Unset ConfigUnsetCmd `kong:"cmd,help='Clear a profile setting.'"`
+	Edit  ConfigEditCmd  `kong:"cmd,help='Edit config.toml in $EDITOR.'"`
+}
+
+type ConfigGetCmd struct {
+	Key string `arg:"" optional:"" help:"Setting name (default: all)."`
+}
+
+type ConfigSetCmd struct {
+	Key   string `arg:"" required:"" help:"Setting name."`
+	Value string `arg:"" required:"" help:"Value."`
+}
+
+type ConfigUnsetCmd struct {
+	Key string `arg:"" required:"" help:"Setting name."`
+}
+
+type ConfigEditCmd struct{}
+
+func (cmd *ConfigGetCmd) Run(ctx *app.Context) error {
+	profile := ctx.Config.Profile(ctx.ProfileKey)
+	if cmd.Key == "" {
+		settings := map[string]string{}
+		plain := []string{}
+		human := []string{}
+		for _, key := range config.SettingKeys {
+			value, _ := profile.Setting(key)
+			settings[key] = value
+			plain = append(plain, key+"\t"+value)
+			if value == "" {
+				value = ctx.Output.Theme.Muted("(unset)")
+			}
+			human = append(human, fmt.Sprintf("%s = %s", key, value))
+		}
+		return ctx.Output.Emit(map[string]any{"profile": ctx.ProfileKey, "settings": settings}, plain, human)
+	}
+	key := settingKey(cmd.Key)
+	value, err := profile.Setting(key)
+	if err != nil {
+		return err
+	}
+	return ctx.Output.Emit(map[string]any{"profile": ctx.ProfileKey, "key": key, "value": value}, []string{value}, []string{value})
+}
+
+func (cmd *ConfigSetCmd) Run(ctx *app.Context) error {
+	key := settingKey(cmd.Key)
+	var value string
+	err := ctx.UpdateProfile(func(profile *config.Profile) error {
+		if err := profile.SetSetting(key, cmd.Value); err != nil {
+			return err
+		}
+		value, _ = profile.Setting(key)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return emitOK(ctx, map[string]any{"status": "ok", "profile": ctx.ProfileKey, "key": key, "value": value},
+		fmt.Sprintf("%s = %s (profile %s)", key, value, ctx.ProfileKey))
+}
+
+func (cmd *ConfigUnsetCmd) Run(ctx *app.Context) error {
+	key := settingKey(cmd.Key)
+	err := ctx.UpdateProfile(func(profile *config.Profile) error {
+		return profile.SetSetting(key, "")
+	})
+	if err != nil {
+		return err
+	}
+	return emitOK(ctx, map[string]any{"status": "ok", "profile": ctx.ProfileKey, "key": key},
+		fmt.Sprintf("Unset %s (profile %s)", key, ctx.ProfileKey))
+}
+
+// Run edits a copy of the config and only replaces config.toml once the
+// copy parses and validates; a rejected edit is left on disk so it is not
+// lost.
+func (cmd *ConfigEditCmd) Run(ctx *app.Context) error {
+	editor := strings.Fields(firstNonEmpty(os.Getenv("VISUAL"), os.Getenv("EDITOR"), "vi"))
+	data, err := os.ReadFile(ctx.ConfigPath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(ctx.ConfigPath), 0o755); err != nil {
+		return err
+	}
+	draft, err := os.CreateTemp(filepath.Dir(ctx.ConfigPath), "config-*.toml")
+	if err != nil {
+		return err
+	}
+	draftPath := draft.Name()
+	_, err = draft.Write(data)
+	if closeErr := draft.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(draftPath)
+		return err
+	}
+	editCmd := exec.Command(editor[0], append(editor[1:], draftPath)...)
+	editCmd.Stdin = os.Stdin
+	editCmd.Stdout = os.Stdout
+	editCmd.Stderr = os.Stderr
+	if err := editCmd.Run(); err != nil {
+		_ = os.Remove(draftPath)
+		return fmt.Errorf("editor failed: %w", err)
+	}
+	cfg, err := config.Load(draftPath)
+	if err == nil {
+		err = cfg.Validate()
+	}
+	if err != nil {
+		return fmt.Errorf("config not saved: %w (your edits are in %s)", err, draftPath)
+	}
+	if err := os.Chmod(draftPath, 0o644); err != nil {
+		return err
+	}
+	if err := os.Rename(draftPath, ctx.ConfigPath); err != nil {
+		return err
+	}
+	ctx.Config = cfg
+	return emitOK(ctx, map[string]any{"status": "ok", "path": ctx.ConfigPath}, "Saved "+ctx.ConfigPath)
+}
+
+// settingKey accepts flag-style spellings like wait-device.
+func settingKey(key string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(key)), "-", "_")
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if strings.TrimSpace(value) != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,124 @@
+package cli
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steipete/spogo/internal/config"
+	"github.com/steipete/spogo/internal/output"
+)
+
+func TestConfigGetSetUnset(t *testing.T) {
+	dir := t.TempDir()
+	ctx, out := profileContext(t, output.FormatPlain, dir)
+	if err := (&ConfigSetCmd{Key: "market", Value: "usa"}).Run(ctx); err == nil {
+		t.Fatalf("expected market error")
+	}
+	if err := (&ConfigSetCmd{Key: "engine", Value: "conect"}).Run(ctx); err == nil || !strings.Contains(err.Error(), "unknown engine") {
+		t.Fatalf("expected engine error, got %v", err)
+	}
+	if err := (&ConfigSetCmd{Key: "cookie-store", Value: "keyring"}).Run(ctx); err == nil || !strings.Contains(err.Error(), "auth migrate --to keyring|file") {
+		t.Fatalf("expected cookie_store to point at auth migrate, got %v", err)
+	}
+	if err := (&ConfigUnsetCmd{Key: "cookie_store"}).Run(ctx); err == nil {
+		t.Fatalf("expected cookie_store unset to be refused")
+	}
+	if _, err := os.Stat(ctx.ConfigPath); err == nil {
+		t.Fatalf("rejected values should not write the config")
+	}
+	if err := (&ConfigSetCmd{Key: "market", Value: "se"}).Run(ctx); err != nil {
+		t.Fatalf("set: %v", err)
+	}
+	if err := (&ConfigSetCmd{Key: "wait-device", Value: "15s"}).Run(ctx); err != nil {
+		t.Fatalf("set wait: %v", err)
+	}
+	loaded, err := config.Load(ctx.ConfigPath)
+	if err != nil || loaded.Profile("default").Market != "SE" || loaded.Profile("default").WaitDevice != "15s" {
+		t.Fatalf("loaded %#v %v", loaded, err)
+	}
+
+	out.Reset()
+	if err := (&ConfigGetCmd{Key: "market"}).Run(ctx); err != nil || out.String() != "SE\n" {
+		t.Fatalf("get %q %v", out.String(), err)
+	}
+	if err := (&ConfigGetCmd{Key: "nope"}).Run(ctx); err == nil {
+		t.Fatalf("expected unknown setting")
+	}
+	if err := (&ConfigUnsetCmd{Key: "market"}).Run(ctx); err != nil {
+		t.Fatalf("unset: %v", err)
+	}
+	if err := (&ConfigUnsetCmd{Key: "nope"}).Run(ctx); err == nil {
+		t.Fatalf("expected unknown setting")
+	}
+
+	ctx, out = profileContext(t, output.FormatJSON, dir)
+	if err := (&ConfigGetCmd{}).Run(ctx); err != nil {
+		t.Fatalf("get all: %v", err)
+	}
+	var payload struct {
+		Profile  string            `json:"profile"`
+		Settings map[string]string `json:"settings"`
+	}
+	if err := json.Unmarshal(out.Bytes(), &payload); err != nil {
+		t.Fatalf("json: %v", err)
+	}
+	if payload.Profile != "default" || payload.Settings["market"] != "" || payload.Settings["wait_device"] != "15s" || len(payload.Settings) != len(config.SettingKeys) {
+		t.Fatalf("payload %#v", payload)
+	}
+
+	ctx, out = profileContext(t, output.FormatHuman, dir)
+	if err := (&ConfigGetCmd{}).Run(ctx); err != nil || !strings.Contains(out.String(), "market = (unset)\n") {
+		t.Fatalf("human %q %v", out.String(), err)
+	}
+}
+
+func TestConfigEdit(t *testing.T) {
+	dir := t.TempDir()
+	ctx, _ := profileContext(t, output.FormatPlain, dir)
+	editor := filepath.Join(dir, "editor")
+	writeEditor := func(body string) {
+		t.Helper()
+		script := "#!/bin/sh\ncat > \"$1\" <<'EOF'\n" + body + "EOF\n"
+		if err := os.WriteFile(editor, []byte(script), 0o755); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+	t.Setenv("VISUAL", "")
+	t.Setenv("EDITOR", editor)
+
+	writeEditor("[profile.default]\nengine = 'webb'\n")
+	err := (&ConfigEditCmd{}).Run(ctx)
+	if err == nil || !strings.Contains(err.Error(), "unknown engine") {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+	if _, statErr := os.Stat(ctx.ConfigPath); statErr == nil {
+		t.Fatalf("invalid edit should not replace the config")
+	}
+	drafts, _ := filepath.Glob(filepath.Join(dir, "config-*.toml"))
+	if len(drafts) != 1 || !strings.Contains(err.Error(), drafts[0]) {
+		t.Fatalf("draft %q %v", drafts, err)
+	}
+
+	writeEditor("[profile.default]\nengine = 'web'\n")
+	if err := (&ConfigEditCmd{}).Run(ctx); err != nil {
+		t.Fatalf("edit: %v", err)
+	}
+	if ctx.Config.Profile("default").Engine != "web" {
+		t.Fatalf("config %#v", ctx.Config)
+	}
+	loaded, err := config.Load(ctx.ConfigPath)
+	if err != nil || loaded.Profile("default").Engine != "web" {
+		t.Fatalf("loaded %#v %v", loaded, err)
+	}
+
+	t.Setenv("EDITOR", filepath.Join(dir, "missing-editor"))
+	if err := (&ConfigEditCmd{}).Run(ctx); err == nil || !strings.Contains(err.Error(), "editor failed") {
+		t.Fatalf("expected editor error, got %v", err)
+	}
+	if drafts, _ := filepath.Glob(filepath.Join(dir, "config-*.toml")); len(drafts) != 1 {
+		t.Fatalf("failed editor should clean up its draft: %q", drafts)
+	}
+}
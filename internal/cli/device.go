@@ -1,21 +1,40 @@
 package cli
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/steipete/spogo/internal/app"
+	"github.com/steipete/spogo/internal/spotify"
 )
 
+// devicePoll is how often `device set --wait` re-reads the device list.
+const devicePoll = time.Second
+
 type DeviceCmd struct {
-	List DeviceListCmd `kong:"cmd,help='List devices.'"`
-	Set  DeviceSetCmd  `kong:"cmd,help='Set active device.'"`
+	List   DeviceListCmd   `kong:"cmd,help='List devices.'"`
+	Set    DeviceSetCmd    `kong:"cmd,help='Set active device.'"`
+	Volume DeviceVolumeCmd `kong:"cmd,help='Set a device volume without transferring.'"`
 }
 
 type DeviceListCmd struct{}
 
 type DeviceSetCmd struct {
-	Device string `arg:"" required:"" help:"Device name or id."`
+	Device      string        `arg:"" required:"" help:"Device name, id, alias or group."`
+	Wait        bool          `help:"Wait until the device reports itself active."`
+	WaitTimeout time.Duration `name:"wait-timeout" help:"How long --wait polls before giving up." default:"15s"`
+}
+
+type DeviceVolumeCmd struct {
+	Device string `arg:"" required:"" help:"Device name, id, alias or group."`
+	Level  int    `arg:"" required:"" help:"Volume 0-100."`
+}
+
+type deviceVolumeSetter interface {
+	DeviceVolume(ctx context.Context, deviceID string, volume int) error
 }
 
 func (cmd *DeviceListCmd) Run(ctx *app.Context) error {
@@ -49,17 +68,127 @@ func (cmd *DeviceSetCmd) Run(ctx *app.Context) error {
 	if err != nil {
 		return err
 	}
-	id := cmd.Device
-	for _, device := range devices {
-		if strings.EqualFold(device.ID, cmd.Device) || strings.EqualFold(device.Name, cmd.Device) {
-			id = device.ID
-			break
+	device, err := resolveDevice(ctx, devices, cmd.Device)
+	if err != nil {
+		return err
+	}
+	if err := client.Transfer(cmdCtx, device.ID); err != nil {
+		return err
+	}
+	if cmd.Wait {
+		if err := waitForActiveDevice(cmdCtx, client, device, cmd.WaitTimeout); err != nil {
+			return err
 		}
 	}
-	if err := client.Transfer(cmdCtx, id); err != nil {
+	payload := map[string]any{"status": "ok", "device": device.ID, "name": device.Name}
+	return emitOK(ctx, payload, fmt.Sprintf("Switched to %s", orDefault(device.Name, device.ID)))
+}
+
+func (cmd *DeviceVolumeCmd) Run(ctx *app.Context) error {
+	if cmd.Level < 0 || cmd.Level > 100 {
+		return app.WrapExit(2, errors.New("volume must be 0-100"))
+	}
+	client, cmdCtx, err := spotifyClient(ctx)
+	if err != nil {
+		return err
+	}
+	setter, ok := client.(deviceVolumeSetter)
+	if !ok {
+		return errors.New("per-device volume not supported by engine")
+	}
+	devices, err := client.Devices(cmdCtx)
+	if err != nil {
+		return err
+	}
+	targets, err := matchDevices(ctx, devices, cmd.Device)
+	if err != nil {
 		return err
 	}
-	return emitOK(ctx, map[string]any{"status": "ok", "device": id}, fmt.Sprintf("Switched to %s", id))
+	names := make([]string, 0, len(targets))
+	for _, device := range targets {
+		if err := setter.DeviceVolume(cmdCtx, device.ID, cmd.Level); err != nil {
+			return fmt.Errorf("%s: %w", orDefault(device.Name, device.ID), err)
+		}
+		names = append(names, orDefault(device.Name, device.ID))
+	}
+	payload := map[string]any{"status": "ok", "volume": cmd.Level, "devices": names}
+	return emitOK(ctx, payload, fmt.Sprintf("Volume %d on %s", cmd.Level, strings.Join(names, ", ")))
+}
+
+// waitForActiveDevice polls until device reports itself active, which can
+// lag the transfer by a few seconds on speakers that have to wake up.
+func waitForActiveDevice(ctx context.Context, client spotify.API, device spotify.Device, timeout time.Duration) error {
+	attempts := max(int(timeout/devicePoll), 1)
+	for i := 0; ; i++ {
+		devices, err := client.Devices(ctx)
+		if err != nil {
+			return err
+		}
+		for _, candidate := range devices {
+			if candidate.ID == device.ID && candidate.Active {
+				return nil
+			}
+		}
+		if i >= attempts {
+			return fmt.Errorf("%s did not become active within %s", orDefault(device.Name, device.ID), timeout)
+		}
+		if err := waitFor(ctx, devicePoll); err != nil {
+			return err
+		}
+	}
+}
+
+// resolveDevice picks the single device a selector means. For a group that
+// is the first member currently visible.
+func resolveDevice(ctx *app.Context, devices []spotify.Device, selector string) (spotify.Device, error) {
+	matched, err := matchDevices(ctx, devices, selector)
+	if err != nil {
+		return spotify.Device{}, err
+	}
+	return matched[0], nil
+}
+
+// matchDevices expands profile aliases and groups, then matches each target
+// with spotify.MatchDevice. Group members that are not visible are skipped;
+// a lone target that looks like a raw device id is passed through so devices
+// Spotify has not listed yet can still be addressed.
+func matchDevices(ctx *app.Context, devices []spotify.Device, selector string) ([]spotify.Device, error) {
+	targets := ctx.Profile.DeviceTargets(selector)
+	matched := make([]spotify.Device, 0, len(targets))
+	var notFound error
+	for _, target := range targets {
+		device, err := spotify.MatchDevice(devices, target)
+		switch {
+		case err == nil:
+			matched = append(matched, device)
+		case errors.Is(err, spotify.ErrDeviceNotFound):
+			notFound = err
+		default:
+			return nil, err
+		}
+	}
+	if len(matched) > 0 {
+		return matched, nil
+	}
+	if len(targets) > 1 {
+		return nil, fmt.Errorf("%w: no device in group %q is available", spotify.ErrDeviceNotFound, selector)
+	}
+	if looksLikeDeviceID(targets[0]) {
+		return []spotify.Device{{ID: targets[0]}}, nil
+	}
+	return nil, notFound
+}
+
+func looksLikeDeviceID(input string) bool {
+	if len(input) < 32 {
+		return false
+	}
+	for _, r := range input {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", r) {
+			return false
+		}
+	}
+	return true
 }
 
 func activeMarker(active bool) string {
@@ -3,8 +3,11 @@ package cli
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/steipete/spogo/internal/config"
 	"github.com/steipete/spogo/internal/output"
 	"github.com/steipete/spogo/internal/spotify"
 	"github.com/steipete/spogo/internal/testutil"
@@ -91,3 +94,144 @@ func TestActiveMarker(t *testing.T) {
 		t.Fatalf("expected marker")
 	}
 }
+
+var matchTestDevices = []spotify.Device{
+	{ID: "k1", Name: "Echo Kitchen"},
+	{ID: "o1", Name: "Office Speaker 1"},
+	{ID: "o2", Name: "Office Speaker 2"},
+	{ID: "l1", Name: "Living Room TV"},
+}
+
+func deviceProfile() config.Profile {
+	return config.Profile{
+		Devices:      map[string]string{"kitchen": "Echo Kitchen", "tv": "Living Room"},
+		DeviceGroups: map[string][]string{"downstairs": {"kitchen", "tv", "Garage"}},
+	}
+}
+
+func TestDeviceSetCmdMatching(t *testing.T) {
+	cases := map[string]string{"kitchen": "k1", "living": "l1", "Downstairs": "k1", "speaker 2": "o2"}
+	for selector, want := range cases {
+		ctx, _, _ := testutil.NewTestContext(t, output.FormatPlain)
+		ctx.Profile = deviceProfile()
+		var got string
+		ctx.SetSpotify(&testutil.SpotifyMock{
+			DevicesFn: func(context.Context) ([]spotify.Device, error) { return matchTestDevices, nil },
+			TransferFn: func(_ context.Context, deviceID string) error {
+				got = deviceID
+				return nil
+			},
+		})
+		if err := (&DeviceSetCmd{Device: selector}).Run(ctx); err != nil || got != want {
+			t.Fatalf("%q: %v got %q want %q", selector, err, got, want)
+		}
+	}
+}
+
+func TestDeviceSetCmdMatchErrors(t *testing.T) {
+	ctx, _, _ := testutil.NewTestContext(t, output.FormatPlain)
+	ctx.Profile = config.Profile{DeviceGroups: map[string][]string{"attic": {"Loft", "Roof"}}}
+	ctx.SetSpotify(&testutil.SpotifyMock{
+		DevicesFn:  func(context.Context) ([]spotify.Device, error) { return matchTestDevices, nil },
+		TransferFn: func(context.Context, string) error { t.Fatalf("unexpected transfer"); return nil },
+	})
+	var ambiguous spotify.AmbiguousDeviceError
+	if err := (&DeviceSetCmd{Device: "office"}).Run(ctx); !errors.As(err, &ambiguous) {
+		t.Fatalf("expected ambiguity, got %v", err)
+	}
+	if err := (&DeviceSetCmd{Device: "garage"}).Run(ctx); !errors.Is(err, spotify.ErrDeviceNotFound) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+	if err := (&DeviceSetCmd{Device: "attic"}).Run(ctx); !errors.Is(err, spotify.ErrDeviceNotFound) || !strings.Contains(err.Error(), "group") {
+		t.Fatalf("expected group not found, got %v", err)
+	}
+}
+
+func TestDeviceSetCmdRawID(t *testing.T) {
+	ctx, _, _ := testutil.NewTestContext(t, output.FormatPlain)
+	id := strings.Repeat("ab", 20)
+	var got string
+	ctx.SetSpotify(&testutil.SpotifyMock{
+		DevicesFn: func(context.Context) ([]spotify.Device, error) { return matchTestDevices, nil },
+		TransferFn: func(_ context.Context, deviceID string) error {
+			got = deviceID
+			return nil
+		},
+	})
+	if err := (&DeviceSetCmd{Device: id}).Run(ctx); err != nil || got != id {
+		t.Fatalf("raw id: %v %q", err, got)
+	}
+}
+
+func TestDeviceSetCmdWait(t *testing.T) {
+	ctx, out, _ := testutil.NewTestContext(t, output.FormatJSON)
+	waits := stubWait(t, 5)
+	polls := 0
+	ctx.SetSpotify(&testutil.SpotifyMock{
+		DevicesFn: func(context.Context) ([]spotify.Device, error) {
+			polls++
+			return []spotify.Device{{ID: "k1", Name: "Echo Kitchen", Active: polls > 2}}, nil
+		},
+		TransferFn: func(context.Context, string) error { return nil },
+	})
+	if err := (&DeviceSetCmd{Device: "kitchen", Wait: true, WaitTimeout: 10 * time.Second}).Run(ctx); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if len(*waits) != 1 || !strings.Contains(out.String(), `"device": "k1"`) {
+		t.Fatalf("waits %v out %s", *waits, out.String())
+	}
+}
+
+func TestDeviceSetCmdWaitTimeout(t *testing.T) {
+	ctx, _, _ := testutil.NewTestContext(t, output.FormatPlain)
+	waits := stubWait(t, 10)
+	ctx.SetSpotify(&testutil.SpotifyMock{
+		DevicesFn:  func(context.Context) ([]spotify.Device, error) { return matchTestDevices, nil },
+		TransferFn: func(context.Context, string) error { return nil },
+	})
+	err := (&DeviceSetCmd{Device: "kitchen", Wait: true, WaitTimeout: 3 * time.Second}).Run(ctx)
+	if err == nil || !strings.Contains(err.Error(), "did not become active") || len(*waits) != 3 {
+		t.Fatalf("expected timeout, got %v after %d waits", err, len(*waits))
+	}
+}
+
+func TestDeviceVolumeCmd(t *testing.T) {
+	ctx, out, _ := testutil.NewTestContext(t, output.FormatPlain)
+	ctx.Profile = deviceProfile()
+	var got []string
+	ctx.SetSpotify(&testutil.SpotifyMock{
+		DevicesFn: func(context.Context) ([]spotify.Device, error) { return matchTestDevices, nil },
+		DeviceVolumeFn: func(_ context.Context, deviceID string, volume int) error {
+			got = append(got, deviceID)
+			if volume != 30 {
+				t.Fatalf("volume %d", volume)
+			}
+			return nil
+		},
+		TransferFn: func(context.Context, string) error { t.Fatalf("unexpected transfer"); return nil },
+	})
+	if err := (&DeviceVolumeCmd{Device: "downstairs", Level: 30}).Run(ctx); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if strings.Join(got, ",") != "k1,l1" || strings.TrimSpace(out.String()) != "ok" {
+		t.Fatalf("got %v out %q", got, out.String())
+	}
+}
+
+func TestDeviceVolumeCmdErrors(t *testing.T) {
+	ctx, _, _ := testutil.NewTestContext(t, output.FormatPlain)
+	if err := (&DeviceVolumeCmd{Device: "kitchen", Level: 101}).Run(ctx); err == nil {
+		t.Fatalf("expected range error")
+	}
+	ctx.SetSpotify(struct{ spotify.API }{&testutil.SpotifyMock{}})
+	if err := (&DeviceVolumeCmd{Device: "kitchen", Level: 10}).Run(ctx); err == nil || !strings.Contains(err.Error(), "not supported") {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+	ctx.SetSpotify(&testutil.SpotifyMock{
+		DevicesFn:      func(context.Context) ([]spotify.Device, error) { return matchTestDevices, nil },
+		DeviceVolumeFn: func(context.Context, string, int) error { return errors.New("boom") },
+	})
+	if err := (&DeviceVolumeCmd{Device: "Echo", Level: 10}).Run(ctx); err == nil || !strings.Contains(err.Error(), "Echo Kitchen: boom") {
+		t.Fatalf("expected device error, got %v", err)
+	}
+}
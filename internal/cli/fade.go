@@ -0,0 +1,167 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/steipete/spogo/internal/app"
+	"github.com/steipete/spogo/internal/spotify"
+)
+
+// fadeMinStep bounds how often a fade touches the device volume, so long
+// fades do not turn into a request flood.
+const fadeMinStep = 500 * time.Millisecond
+
+// waitFor blocks for d or until ctx is done. Tests swap it out to run fades
+// and timers instantly.
+var waitFor = func(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+type fadeCurve func(float64) float64
+
+var fadeCurves = map[string]fadeCurve{
+	"linear": func(t float64) float64 { return t },
+	"ease":   func(t float64) float64 { return t * t * (3 - 2*t) },
+}
+
+type VolumeFadeCmd struct {
+	Target string        `arg:"" required:"" help:"Target volume 0-100, or +N/-N from the current level."`
+	Over   time.Duration `help:"Fade duration." default:"30s"`
+	Curve  string        `help:"Fade curve (linear|ease)." default:"ease"`
+}
+
+type SleepCmd struct {
+	Delay time.Duration `arg:"" required:"" help:"Time until playback pauses (e.g. 45m)."`
+	Fade  time.Duration `help:"Fade out over the last part of the delay (e.g. 2m)."`
+}
+
+func (cmd *VolumeFadeCmd) Run(ctx *app.Context) error {
+	target, relative, err := parseVolumeLevel(cmd.Target)
+	if err != nil {
+		return err
+	}
+	if cmd.Over <= 0 {
+		return errors.New("--over must be positive")
+	}
+	curve, ok := fadeCurves[strings.ToLower(strings.TrimSpace(cmd.Curve))]
+	if !ok {
+		return fmt.Errorf("curve must be linear|ease")
+	}
+	client, cmdCtx, err := spotifyClient(ctx)
+	if err != nil {
+		return err
+	}
+	status, err := client.Playback(cmdCtx)
+	if err != nil {
+		return err
+	}
+	start := status.Device.Volume
+	if relative {
+		target = clampVolume(start + target)
+	}
+	if err := fadeVolume(cmdCtx, client, start, target, cmd.Over, curve); err != nil {
+		return err
+	}
+	payload := map[string]any{"status": "ok", "volume": target, "from": start, "over_ms": cmd.Over.Milliseconds()}
+	return emitOK(ctx, payload, fmt.Sprintf("Volume %d → %d over %s", start, target, cmd.Over))
+}
+
+// fadeVolume steps the device volume from one level to another along curve,
+// landing on the target when over has elapsed.
+func fadeVolume(ctx context.Context, client spotify.API, from, to int, over time.Duration, curve fadeCurve) error {
+	delta := to - from
+	if delta == 0 {
+		return nil
+	}
+	steps := min(abs(delta), max(int(over/fadeMinStep), 1))
+	interval := over / time.Duration(steps)
+	last := from
+	for i := 1; i <= steps; i++ {
+		if err := waitFor(ctx, interval); err != nil {
+			return err
+		}
+		level := from + int(math.Round(float64(delta)*curve(float64(i)/float64(steps))))
+		if level == last {
+			continue
+		}
+		if err := client.Volume(ctx, level); err != nil {
+			return err
+		}
+		last = level
+	}
+	return nil
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// Run waits in the foreground, then fades out (optionally), pauses and puts
+// the volume back so the next `play` is not silent. Interrupting a fade also
+// restores the volume.
+func (cmd *SleepCmd) Run(ctx *app.Context) error {
+	if cmd.Delay <= 0 {
+		return errors.New("delay must be positive")
+	}
+	if cmd.Fade < 0 || cmd.Fade > cmd.Delay {
+		return errors.New("--fade must be between 0 and the delay")
+	}
+	client, cmdCtx, err := spotifyClient(ctx)
+	if err != nil {
+		return err
+	}
+	sleepCtx, stop := signal.NotifyContext(cmdCtx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	if !ctx.Output.Quiet {
+		at := time.Now().Add(cmd.Delay).Format("15:04")
+		_, _ = fmt.Fprintf(ctx.Output.Err, "Pausing at %s (in %s); Ctrl-C to cancel\n", at, cmd.Delay)
+	}
+	if err := waitFor(sleepCtx, cmd.Delay-cmd.Fade); err != nil {
+		return err
+	}
+	restore := -1
+	if cmd.Fade > 0 {
+		status, err := client.Playback(cmdCtx)
+		if err != nil {
+			return err
+		}
+		restore = status.Device.Volume
+		if err := fadeVolume(sleepCtx, client, restore, 0, cmd.Fade, fadeCurves["ease"]); err != nil {
+			if restoreErr := client.Volume(cmdCtx, restore); restoreErr != nil {
+				return errors.Join(err, restoreErr)
+			}
+			return err
+		}
+	}
+	if err := client.Pause(cmdCtx); err != nil {
+		return err
+	}
+	if restore >= 0 {
+		if err := client.Volume(cmdCtx, restore); err != nil {
+			ctx.Output.Errorf("paused, but could not restore volume %d: %v", restore, err)
+		}
+	}
+	payload := map[string]any{"status": "ok", "paused": true, "delay_ms": cmd.Delay.Milliseconds(), "fade_ms": cmd.Fade.Milliseconds()}
+	return emitOK(ctx, payload, fmt.Sprintf("Paused playback after %s", cmd.Delay))
+}
@@ -0,0 +1,219 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steipete/spogo/internal/output"
+	"github.com/steipete/spogo/internal/spotify"
+	"github.com/steipete/spogo/internal/testutil"
+)
+
+// stubWait records requested waits and fails once the budget of successful
+// waits is spent (budget < 0 means unlimited).
+func stubWait(t *testing.T, budget int) *[]time.Duration {
+	t.Helper()
+	orig := waitFor
+	t.Cleanup(func() { waitFor = orig })
+	var waits []time.Duration
+	waitFor = func(ctx context.Context, d time.Duration) error {
+		if budget == 0 {
+			return context.Canceled
+		}
+		budget--
+		waits = append(waits, d)
+		return nil
+	}
+	return &waits
+}
+
+func fadeMock(start int, levels *[]int, calls *[]string) *testutil.SpotifyMock {
+	return &testutil.SpotifyMock{
+		PlaybackFn: func(context.Context) (spotify.PlaybackStatus, error) {
+			return spotify.PlaybackStatus{Device: spotify.Device{Volume: start}}, nil
+		},
+		VolumeFn: func(_ context.Context, level int) error {
+			*levels = append(*levels, level)
+			*calls = append(*calls, "volume")
+			return nil
+		},
+		PauseFn: func(context.Context) error {
+			*calls = append(*calls, "pause")
+			return nil
+		},
+	}
+}
+
+func TestFadeVolumeCurves(t *testing.T) {
+	waits := stubWait(t, -1)
+	var levels []int
+	var calls []string
+	client := fadeMock(0, &levels, &calls)
+	if err := fadeVolume(context.Background(), client, 0, 10, 10*time.Second, fadeCurves["linear"]); err != nil {
+		t.Fatalf("fade: %v", err)
+	}
+	if len(levels) != 10 || levels[0] != 1 || levels[9] != 10 || (*waits)[0] != time.Second {
+		t.Fatalf("levels %v waits %v", levels, *waits)
+	}
+	levels = nil
+	if err := fadeVolume(context.Background(), client, 80, 0, 2*time.Second, fadeCurves["ease"]); err != nil {
+		t.Fatalf("fade: %v", err)
+	}
+	if len(levels) != 4 || levels[len(levels)-1] != 0 || levels[0] <= levels[1] {
+		t.Fatalf("levels %v", levels)
+	}
+	levels = nil
+	if err := fadeVolume(context.Background(), client, 5, 5, time.Second, fadeCurves["ease"]); err != nil || levels != nil {
+		t.Fatalf("no-op fade: %v %v", levels, err)
+	}
+}
+
+func TestVolumeFadeCmd(t *testing.T) {
+	stubWait(t, -1)
+	ctx, out, _ := testutil.NewTestContext(t, output.FormatHuman)
+	var levels []int
+	var calls []string
+	ctx.SetSpotify(fadeMock(50, &levels, &calls))
+	if err := (&VolumeFadeCmd{Target: "-20", Over: 5 * time.Second, Curve: "Linear"}).Run(ctx); err != nil {
+		t.Fatalf("fade: %v", err)
+	}
+	if levels[len(levels)-1] != 30 || !strings.Contains(out.String(), "Volume 50 → 30 over 5s") {
+		t.Fatalf("levels %v out %q", levels, out.String())
+	}
+	for _, cmd := range []VolumeFadeCmd{
+		{Target: "x", Over: time.Second, Curve: "ease"},
+		{Target: "10", Over: 0, Curve: "ease"},
+		{Target: "10", Over: time.Second, Curve: "cubic"},
+	} {
+		if err := cmd.Run(ctx); err == nil {
+			t.Fatalf("expected error for %#v", cmd)
+		}
+	}
+}
+
+func TestVolumeFadeCmdErrors(t *testing.T) {
+	stubWait(t, -1)
+	ctx, _, _ := testutil.NewTestContext(t, output.FormatPlain)
+	ctx.SetSpotify(&testutil.SpotifyMock{
+		PlaybackFn: func(context.Context) (spotify.PlaybackStatus, error) {
+			return spotify.PlaybackStatus{}, errors.New("boom")
+		},
+	})
+	if err := (&VolumeFadeCmd{Target: "10", Over: time.Second, Curve: "ease"}).Run(ctx); err == nil || err.Error() != "boom" {
+		t.Fatalf("expected boom, got %v", err)
+	}
+	ctx.SetSpotify(&testutil.SpotifyMock{
+		PlaybackFn: func(context.Context) (spotify.PlaybackStatus, error) {
+			return spotify.PlaybackStatus{Device: spotify.Device{Volume: 0}}, nil
+		},
+		VolumeFn: func(context.Context, int) error { return errors.New("offline") },
+	})
+	if err := (&VolumeFadeCmd{Target: "10", Over: time.Second, Curve: "ease"}).Run(ctx); err == nil || err.Error() != "offline" {
+		t.Fatalf("expected offline, got %v", err)
+	}
+}
+
+func TestSleepCmdFadesPausesAndRestores(t *testing.T) {
+	waits := stubWait(t, -1)
+	ctx, out, errOut := testutil.NewTestContext(t, output.FormatPlain)
+	var levels []int
+	var calls []string
+	ctx.SetSpotify(fadeMock(40, &levels, &calls))
+	if err := (&SleepCmd{Delay: 45 * time.Minute, Fade: 2 * time.Second}).Run(ctx); err != nil {
+		t.Fatalf("sleep: %v", err)
+	}
+	if (*waits)[0] != 45*time.Minute-2*time.Second {
+		t.Fatalf("first wait %v", (*waits)[0])
+	}
+	if calls[len(calls)-2] != "pause" || levels[len(levels)-2] != 0 || levels[len(levels)-1] != 40 {
+		t.Fatalf("calls %v levels %v", calls, levels)
+	}
+	if out.String() != "ok\n" || !strings.Contains(errOut.String(), "Pausing at") {
+		t.Fatalf("out %q err %q", out.String(), errOut.String())
+	}
+}
+
+func TestSleepCmdWithoutFade(t *testing.T) {
+	stubWait(t, -1)
+	ctx, _, _ := testutil.NewTestContext(t, output.FormatPlain)
+	ctx.Output.Quiet = true
+	var levels []int
+	var calls []string
+	ctx.SetSpotify(fadeMock(40, &levels, &calls))
+	if err := (&SleepCmd{Delay: time.Minute}).Run(ctx); err != nil {
+		t.Fatalf("sleep: %v", err)
+	}
+	if strings.Join(calls, ",") != "pause" {
+		t.Fatalf("calls %v", calls)
+	}
+}
+
+func TestSleepCmdInterruptedFadeRestoresVolume(t *testing.T) {
+	stubWait(t, 3)
+	ctx, _, _ := testutil.NewTestContext(t, output.FormatPlain)
+	var levels []int
+	var calls []string
+	ctx.SetSpotify(fadeMock(40, &levels, &calls))
+	err := (&SleepCmd{Delay: time.Minute, Fade: 10 * time.Second}).Run(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected canceled, got %v", err)
+	}
+	if levels[len(levels)-1] != 40 || strings.Contains(strings.Join(calls, ","), "pause") {
+		t.Fatalf("calls %v levels %v", calls, levels)
+	}
+}
+
+func TestSleepCmdErrors(t *testing.T) {
+	stubWait(t, -1)
+	ctx, _, errOut := testutil.NewTestContext(t, output.FormatPlain)
+	for _, cmd := range []SleepCmd{{Delay: 0}, {Delay: time.Minute, Fade: 2 * time.Minute}, {Delay: time.Minute, Fade: -time.Second}} {
+		if err := cmd.Run(ctx); err == nil {
+			t.Fatalf("expected error for %#v", cmd)
+		}
+	}
+	ctx.SetSpotify(&testutil.SpotifyMock{
+		PlaybackFn: func(context.Context) (spotify.PlaybackStatus, error) {
+			return spotify.PlaybackStatus{}, errors.New("boom")
+		},
+		PauseFn: func(context.Context) error { return errors.New("pause failed") },
+	})
+	if err := (&SleepCmd{Delay: time.Minute, Fade: time.Second}).Run(ctx); err == nil || err.Error() != "boom" {
+		t.Fatalf("expected boom, got %v", err)
+	}
+	if err := (&SleepCmd{Delay: time.Minute}).Run(ctx); err == nil || err.Error() != "pause failed" {
+		t.Fatalf("expected pause failed, got %v", err)
+	}
+	var levels []int
+	var calls []string
+	mock := fadeMock(30, &levels, &calls)
+	mock.VolumeFn = func(_ context.Context, level int) error {
+		if level == 30 {
+			return errors.New("device asleep")
+		}
+		return nil
+	}
+	ctx.SetSpotify(mock)
+	if err := (&SleepCmd{Delay: time.Minute, Fade: time.Second}).Run(ctx); err != nil {
+		t.Fatalf("sleep: %v", err)
+	}
+	if !strings.Contains(errOut.String(), "could not restore volume 30") {
+		t.Fatalf("stderr %q", errOut.String())
+	}
+}
+
+func TestWaitFor(t *testing.T) {
+	if err := waitFor(context.Background(), time.Millisecond); err != nil {
+		t.Fatalf("wait: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := waitFor(ctx, time.Hour); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected canceled, got %v", err)
+	}
+	if err := waitFor(ctx, 0); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected canceled, got %v", err)
+	}
+}
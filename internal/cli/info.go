@@ -12,11 +12,17 @@ type TrackCmd struct {
 }
 
 type AlbumCmd struct {
-	Info InfoAlbumCmd `kong:"cmd,help='Album info.'"`
+	Info   InfoAlbumCmd   `kong:"cmd,help='Album info.'"`
+	Tracks AlbumTracksCmd `kong:"cmd,help='List album tracks.'"`
 }
 
 type ArtistCmd struct {
-	Info InfoArtistCmd `kong:"cmd,help='Artist info.'"`
+	Info      InfoArtistCmd      `kong:"cmd,help='Artist info.'"`
+	Top       ArtistTopCmd       `kong:"cmd,help='Artist top tracks.'"`
+	Albums    ArtistAlbumsCmd    `kong:"cmd,help='Artist albums.'"`
+	Singles   ArtistSinglesCmd   `kong:"cmd,help='Artist singles and EPs.'"`
+	AppearsOn ArtistAppearsOnCmd `kong:"cmd,name='appears-on',help='Releases the artist appears on.'"`
+	Related   ArtistRelatedCmd   `kong:"cmd,help='Related artists.'"`
 }
 
 type PlaylistCmd struct {
@@ -28,11 +34,13 @@ type PlaylistCmd struct {
 }
 
 type ShowCmd struct {
-	Info InfoShowCmd `kong:"cmd,help='Show info.'"`
+	Info     InfoShowCmd     `kong:"cmd,help='Show info.'"`
+	Episodes ShowEpisodesCmd `kong:"cmd,help='List show episodes with resume points.'"`
 }
 
 type EpisodeCmd struct {
-	Info InfoEpisodeCmd `kong:"cmd,help='Episode info.'"`
+	Info   InfoEpisodeCmd   `kong:"cmd,help='Episode info.'"`
+	Resume EpisodeResumeCmd `kong:"cmd,help='Play an episode from its resume point.'"`
 }
 
 type InfoArgs struct {
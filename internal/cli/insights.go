@@ -0,0 +1,156 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/steipete/spogo/internal/app"
+	"github.com/steipete/spogo/internal/spotify"
+)
+
+type MeCmd struct{}
+
+type TopCmd struct {
+	Tracks  TopTracksCmd  `kong:"cmd,help='Your most played tracks.'"`
+	Artists TopArtistsCmd `kong:"cmd,help='Your most played artists.'"`
+}
+
+type TopArgs struct {
+	Range  string `help:"Time range (short|medium|long)." default:"medium"`
+	Limit  int    `help:"Limit results." default:"20"`
+	Offset int    `help:"Offset results." default:"0"`
+}
+
+type TopTracksCmd struct{ TopArgs }
+
+type TopArtistsCmd struct{ TopArgs }
+
+type RecentCmd struct {
+	Limit int    `help:"Limit results." default:"20"`
+	After string `help:"Only plays after this time (RFC 3339, unix seconds/ms, or a duration ago like 24h)."`
+}
+
+// topRanges maps --range values to Spotify's affinity windows: roughly the
+// last 4 weeks, 6 months and all time.
+var topRanges = map[string]spotify.TimeRange{
+	"short":  spotify.TimeRangeShort,
+	"medium": spotify.TimeRangeMedium,
+	"long":   spotify.TimeRangeLong,
+}
+
+var insightsNow = time.Now
+
+func (cmd *MeCmd) Run(ctx *app.Context) error {
+	insights, cmdCtx, err := accountInsights(ctx)
+	if err != nil {
+		return err
+	}
+	profile, err := insights.Me(cmdCtx)
+	if err != nil {
+		return err
+	}
+	plain := fmt.Sprintf("%s\t%s\t%s\t%s\t%d", profile.ID, profile.DisplayName, profile.Country, profile.Product, profile.Followers)
+	name := profile.DisplayName
+	if name == "" {
+		name = profile.ID
+	}
+	parts := []string{fmt.Sprintf("%s %s", ctx.Output.Theme.Bold(name), ctx.Output.Theme.Muted("("+profile.ID+")"))}
+	if profile.Country != "" {
+		parts = append(parts, profile.Country)
+	}
+	if profile.Product != "" {
+		parts = append(parts, profile.Product)
+	}
+	parts = append(parts, fmt.Sprintf("%d followers", profile.Followers))
+	return ctx.Output.Emit(profile, []string{plain}, []string{strings.Join(parts, " · ")})
+}
+
+func (cmd *TopTracksCmd) Run(ctx *app.Context) error {
+	return cmd.run(ctx, "track")
+}
+
+func (cmd *TopArtistsCmd) Run(ctx *app.Context) error {
+	return cmd.run(ctx, "artist")
+}
+
+func (args TopArgs) run(ctx *app.Context, kind string) error {
+	timeRange, ok := topRanges[strings.ToLower(args.Range)]
+	if !ok {
+		return fmt.Errorf("invalid range %q (short|medium|long)", args.Range)
+	}
+	insights, cmdCtx, err := accountInsights(ctx)
+	if err != nil {
+		return err
+	}
+	items, total, err := insights.TopItems(cmdCtx, kind, timeRange, clampLimit(args.Limit), args.Offset)
+	if err != nil {
+		return err
+	}
+	return emitItems(ctx, items, total, map[string]any{"range": timeRange, "offset": args.Offset})
+}
+
+func (cmd *RecentCmd) Run(ctx *app.Context) error {
+	var after time.Time
+	if cmd.After != "" {
+		parsed, err := parseAfter(cmd.After, insightsNow())
+		if err != nil {
+			return err
+		}
+		after = parsed
+	}
+	insights, cmdCtx, err := accountInsights(ctx)
+	if err != nil {
+		return err
+	}
+	items, err := insights.RecentlyPlayed(cmdCtx, clampLimit(cmd.Limit), after)
+	if err != nil {
+		return err
+	}
+	plain, human := renderItems(ctx.Output, items)
+	for i, item := range items {
+		plain[i] = item.PlayedAt + "\t" + plain[i]
+		if playedAt, err := time.Parse(time.RFC3339, item.PlayedAt); err == nil {
+			human[i] = ctx.Output.Theme.Muted(playedAt.Local().Format("Jan 2 15:04")) + "  " + human[i]
+		}
+	}
+	payload := map[string]any{"total": len(items), "items": items}
+	if !after.IsZero() {
+		payload["after"] = after.UTC().Format(time.RFC3339)
+	}
+	return ctx.Output.Emit(payload, plain, human)
+}
+
+// parseAfter accepts an RFC 3339 timestamp, unix seconds or milliseconds, or
+// a duration measured back from now.
+func parseAfter(input string, now time.Time) (time.Time, error) {
+	input = strings.TrimSpace(input)
+	if ts, err := time.Parse(time.RFC3339, input); err == nil {
+		return ts, nil
+	}
+	if n, err := strconv.ParseInt(input, 10, 64); err == nil && n > 0 {
+		if n >= 1e12 {
+			return time.UnixMilli(n), nil
+		}
+		return time.Unix(n, 0), nil
+	}
+	if d, err := time.ParseDuration(input); err == nil && d > 0 {
+		return now.Add(-d), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid --after %q (RFC 3339, unix time, or duration like 24h)", input)
+}
+
+func accountInsights(ctx *app.Context) (spotify.AccountInsights, context.Context, error) {
+	client, cmdCtx, err := spotifyClient(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	insights, ok := client.(spotify.AccountInsights)
+	if !ok {
+		return nil, nil, errors.New("account insights not supported by engine")
+	}
+	return insights, cmdCtx, nil
+}
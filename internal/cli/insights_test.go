@@ -0,0 +1,127 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steipete/spogo/internal/output"
+	"github.com/steipete/spogo/internal/spotify"
+	"github.com/steipete/spogo/internal/testutil"
+)
+
+func TestMeCmd(t *testing.T) {
+	mock := &testutil.SpotifyMock{
+		MeFn: func(context.Context) (spotify.UserProfile, error) {
+			return spotify.UserProfile{ID: "u1", DisplayName: "Ada", Country: "AT", Product: "premium", Followers: 42}, nil
+		},
+	}
+	ctx, out, _ := testutil.NewTestContext(t, output.FormatPlain)
+	ctx.SetSpotify(mock)
+	if err := (&MeCmd{}).Run(ctx); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if got := strings.TrimSpace(out.String()); got != "u1\tAda\tAT\tpremium\t42" {
+		t.Fatalf("plain %q", got)
+	}
+
+	ctx, out, _ = testutil.NewTestContext(t, output.FormatHuman)
+	ctx.SetSpotify(mock)
+	if err := (&MeCmd{}).Run(ctx); err != nil || !strings.Contains(out.String(), "premium · 42 followers") {
+		t.Fatalf("human %q %v", out.String(), err)
+	}
+
+	ctx.SetSpotify(struct{ spotify.API }{mock})
+	if err := (&MeCmd{}).Run(ctx); err == nil || !strings.Contains(err.Error(), "not supported") {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+}
+
+func TestTopCmds(t *testing.T) {
+	var gotKind string
+	var gotRange spotify.TimeRange
+	mock := &testutil.SpotifyMock{
+		TopItemsFn: func(_ context.Context, kind string, timeRange spotify.TimeRange, limit, offset int) ([]spotify.Item, int, error) {
+			gotKind, gotRange = kind, timeRange
+			return []spotify.Item{{ID: "x1", URI: "spotify:" + kind + ":x1", Name: "X", Type: kind}}, 9, nil
+		},
+	}
+	ctx, out, _ := testutil.NewTestContext(t, output.FormatJSON)
+	ctx.SetSpotify(mock)
+	if err := (&TopTracksCmd{TopArgs{Range: "short", Limit: 5}}).Run(ctx); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	var payload struct {
+		Total int    `json:"total"`
+		Range string `json:"range"`
+	}
+	if err := json.Unmarshal(out.Bytes(), &payload); err != nil {
+		t.Fatalf("json: %v", err)
+	}
+	if gotKind != "track" || gotRange != spotify.TimeRangeShort || payload.Total != 9 || payload.Range != "short_term" {
+		t.Fatalf("kind %q range %q payload %s", gotKind, gotRange, out.String())
+	}
+	if err := (&TopArtistsCmd{TopArgs{Range: "LONG"}}).Run(ctx); err != nil || gotKind != "artist" || gotRange != spotify.TimeRangeLong {
+		t.Fatalf("artists: %v %q %q", err, gotKind, gotRange)
+	}
+	if err := (&TopTracksCmd{TopArgs{Range: "year"}}).Run(ctx); err == nil || !strings.Contains(err.Error(), "invalid range") {
+		t.Fatalf("expected invalid range, got %v", err)
+	}
+}
+
+func TestRecentCmd(t *testing.T) {
+	now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+	orig := insightsNow
+	insightsNow = func() time.Time { return now }
+	t.Cleanup(func() { insightsNow = orig })
+	var gotAfter time.Time
+	mock := &testutil.SpotifyMock{
+		RecentlyPlayedFn: func(_ context.Context, limit int, after time.Time) ([]spotify.Item, error) {
+			gotAfter = after
+			return []spotify.Item{{ID: "t1", URI: "spotify:track:t1", Name: "One", Type: "track", PlayedAt: "2026-01-02T11:00:00Z"}}, nil
+		},
+	}
+	ctx, out, _ := testutil.NewTestContext(t, output.FormatPlain)
+	ctx.SetSpotify(mock)
+	if err := (&RecentCmd{After: "2h"}).Run(ctx); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if !gotAfter.Equal(now.Add(-2 * time.Hour)) {
+		t.Fatalf("after %v", gotAfter)
+	}
+	if got := strings.TrimSpace(out.String()); !strings.HasPrefix(got, "2026-01-02T11:00:00Z\t") || !strings.Contains(got, "spotify:track:t1") {
+		t.Fatalf("plain %q", got)
+	}
+
+	ctx, out, _ = testutil.NewTestContext(t, output.FormatJSON)
+	ctx.SetSpotify(mock)
+	if err := (&RecentCmd{}).Run(ctx); err != nil || !gotAfter.IsZero() || strings.Contains(out.String(), `"after"`) {
+		t.Fatalf("json %q %v %v", out.String(), gotAfter, err)
+	}
+	if err := (&RecentCmd{After: "soon"}).Run(ctx); err == nil || !strings.Contains(err.Error(), "invalid --after") {
+		t.Fatalf("expected invalid after, got %v", err)
+	}
+}
+
+func TestParseAfter(t *testing.T) {
+	now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+	tests := map[string]time.Time{
+		"2026-01-01T00:00:00Z": time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		"1700000000":           time.Unix(1700000000, 0),
+		"1700000000000":        time.UnixMilli(1700000000000),
+		"30m":                  now.Add(-30 * time.Minute),
+	}
+	for input, want := range tests {
+		got, err := parseAfter(input, now)
+		if err != nil || !got.Equal(want) {
+			t.Fatalf("%s: %v %v", input, got, err)
+		}
+	}
+	for _, input := range []string{"", "-5", "-1h", "yesterday"} {
+		if _, err := parseAfter(input, now); err == nil {
+			t.Fatalf("%q: expected error", input)
+		}
+	}
+}
@@ -2,17 +2,19 @@ package cli
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/steipete/spogo/internal/app"
-	"github.com/steipete/spogo/internal/spotify"
 )
 
 type LibraryCmd struct {
-	Tracks    LibraryTracksCmd    `kong:"cmd,help='Track library.'"`
-	Albums    LibraryAlbumsCmd    `kong:"cmd,help='Album library.'"`
-	Artists   LibraryArtistsCmd   `kong:"cmd,help='Artist library.'"`
-	Playlists LibraryPlaylistsCmd `kong:"cmd,help='Playlist library.'"`
+	Tracks     LibraryTracksCmd     `kong:"cmd,help='Track library.'"`
+	Albums     LibraryAlbumsCmd     `kong:"cmd,help='Album library.'"`
+	Artists    LibraryArtistsCmd    `kong:"cmd,help='Artist library.'"`
+	Playlists  LibraryPlaylistsCmd  `kong:"cmd,help='Playlist library.'"`
+	Shows      LibraryShowsCmd      `kong:"cmd,help='Saved podcasts.'"`
+	Episodes   LibraryEpisodesCmd   `kong:"cmd,help='Your Episodes.'"`
+	Audiobooks LibraryAudiobooksCmd `kong:"cmd,help='Saved audiobooks.'"`
+	Contains   LibraryContainsCmd   `kong:"cmd,help='Check whether items are saved or followed.'"`
 }
 
 type LibraryTracksCmd struct {
@@ -37,6 +39,18 @@ type LibraryPlaylistsCmd struct {
 	List LibraryPlaylistsListCmd `kong:"cmd,help='List playlists.'"`
 }
 
+type LibraryShowsCmd struct {
+	List   LibraryShowsListCmd   `kong:"cmd,help='List saved shows.'"`
+	Add    LibraryShowsAddCmd    `kong:"cmd,help='Save shows.'"`
+	Remove LibraryShowsRemoveCmd `kong:"cmd,help='Remove saved shows.'"`
+}
+
+type LibraryEpisodesCmd struct {
+	List   LibraryEpisodesListCmd   `kong:"cmd,help='List Your Episodes.'"`
+	Add    LibraryEpisodesAddCmd    `kong:"cmd,help='Add episodes to Your Episodes.'"`
+	Remove LibraryEpisodesRemoveCmd `kong:"cmd,help='Remove episodes from Your Episodes.'"`
+}
+
 type LibraryTracksListCmd struct {
 	Limit  int `help:"Limit results." default:"50"`
 	Offset int `help:"Offset results." default:"0"`
@@ -82,6 +96,32 @@ type LibraryPlaylistsListCmd struct {
 	Offset int `help:"Offset results." default:"0"`
 }
 
+type LibraryShowsListCmd struct {
+	Limit  int `help:"Limit results." default:"50"`
+	Offset int `help:"Offset results." default:"0"`
+}
+
+type LibraryShowsAddCmd struct {
+	IDs []string `arg:"" required:"" help:"Show IDs/URLs/URIs."`
+}
+
+type LibraryShowsRemoveCmd struct {
+	IDs []string `arg:"" required:"" help:"Show IDs/URLs/URIs."`
+}
+
+type LibraryEpisodesListCmd struct {
+	Limit  int `help:"Limit results." default:"50"`
+	Offset int `help:"Offset results." default:"0"`
+}
+
+type LibraryEpisodesAddCmd struct {
+	IDs []string `arg:"" required:"" help:"Episode IDs/URLs/URIs."`
+}
+
+type LibraryEpisodesRemoveCmd struct {
+	IDs []string `arg:"" required:"" help:"Episode IDs/URLs/URIs."`
+}
+
 func (cmd *LibraryTracksListCmd) Run(ctx *app.Context) error {
 	client, cmdCtx, err := spotifyClient(ctx)
 	if err != nil {
@@ -96,33 +136,11 @@ func (cmd *LibraryTracksListCmd) Run(ctx *app.Context) error {
 }
 
 func (cmd *LibraryTracksAddCmd) Run(ctx *app.Context) error {
-	ids, err := parseIDs(cmd.IDs, "track")
-	if err != nil {
-		return err
-	}
-	client, cmdCtx, err := spotifyClient(ctx)
-	if err != nil {
-		return err
-	}
-	if err := client.LibraryModify(cmdCtx, "/me/tracks", ids, "PUT"); err != nil {
-		return err
-	}
-	return emitCountStatus(ctx, len(ids), "Updated")
+	return libraryModify(ctx, cmd.IDs, "track", "/me/tracks", "PUT")
 }
 
 func (cmd *LibraryTracksRemoveCmd) Run(ctx *app.Context) error {
-	ids, err := parseIDs(cmd.IDs, "track")
-	if err != nil {
-		return err
-	}
-	client, cmdCtx, err := spotifyClient(ctx)
-	if err != nil {
-		return err
-	}
-	if err := client.LibraryModify(cmdCtx, "/me/tracks", ids, "DELETE"); err != nil {
-		return err
-	}
-	return emitCountStatus(ctx, len(ids), "Updated")
+	return libraryModify(ctx, cmd.IDs, "track", "/me/tracks", "DELETE")
 }
 
 func (cmd *LibraryAlbumsListCmd) Run(ctx *app.Context) error {
@@ -139,33 +157,11 @@ func (cmd *LibraryAlbumsListCmd) Run(ctx *app.Context) error {
 }
 
 func (cmd *LibraryAlbumsAddCmd) Run(ctx *app.Context) error {
-	ids, err := parseIDs(cmd.IDs, "album")
-	if err != nil {
-		return err
-	}
-	client, cmdCtx, err := spotifyClient(ctx)
-	if err != nil {
-		return err
-	}
-	if err := client.LibraryModify(cmdCtx, "/me/albums", ids, "PUT"); err != nil {
-		return err
-	}
-	return emitCountStatus(ctx, len(ids), "Updated")
+	return libraryModify(ctx, cmd.IDs, "album", "/me/albums", "PUT")
 }
 
 func (cmd *LibraryAlbumsRemoveCmd) Run(ctx *app.Context) error {
-	ids, err := parseIDs(cmd.IDs, "album")
-	if err != nil {
-		return err
-	}
-	client, cmdCtx, err := spotifyClient(ctx)
-	if err != nil {
-		return err
-	}
-	if err := client.LibraryModify(cmdCtx, "/me/albums", ids, "DELETE"); err != nil {
-		return err
-	}
-	return emitCountStatus(ctx, len(ids), "Updated")
+	return libraryModify(ctx, cmd.IDs, "album", "/me/albums", "DELETE")
 }
 
 func (cmd *LibraryArtistsListCmd) Run(ctx *app.Context) error {
@@ -229,10 +225,65 @@ func (cmd *LibraryPlaylistsListCmd) Run(ctx *app.Context) error {
 	return emitItems(ctx, items, total, nil)
 }
 
+func (cmd *LibraryShowsListCmd) Run(ctx *app.Context) error {
+	client, cmdCtx, err := spotifyClient(ctx)
+	if err != nil {
+		return err
+	}
+	items, total, err := client.LibraryShows(cmdCtx, clampLimit(cmd.Limit), cmd.Offset)
+	if err != nil {
+		return err
+	}
+	return emitItems(ctx, items, total, nil)
+}
+
+func (cmd *LibraryShowsAddCmd) Run(ctx *app.Context) error {
+	return libraryModify(ctx, cmd.IDs, "show", "/me/shows", "PUT")
+}
+
+func (cmd *LibraryShowsRemoveCmd) Run(ctx *app.Context) error {
+	return libraryModify(ctx, cmd.IDs, "show", "/me/shows", "DELETE")
+}
+
+func (cmd *LibraryEpisodesListCmd) Run(ctx *app.Context) error {
+	client, cmdCtx, err := spotifyClient(ctx)
+	if err != nil {
+		return err
+	}
+	items, total, err := client.LibraryEpisodes(cmdCtx, clampLimit(cmd.Limit), cmd.Offset)
+	if err != nil {
+		return err
+	}
+	return emitItems(ctx, items, total, nil)
+}
+
+func (cmd *LibraryEpisodesAddCmd) Run(ctx *app.Context) error {
+	return libraryModify(ctx, cmd.IDs, "episode", "/me/episodes", "PUT")
+}
+
+func (cmd *LibraryEpisodesRemoveCmd) Run(ctx *app.Context) error {
+	return libraryModify(ctx, cmd.IDs, "episode", "/me/episodes", "DELETE")
+}
+
+func libraryModify(ctx *app.Context, inputs []string, kind, path, method string) error {
+	ids, err := parseIDs(inputs, kind)
+	if err != nil {
+		return err
+	}
+	client, cmdCtx, err := spotifyClient(ctx)
+	if err != nil {
+		return err
+	}
+	if err := client.LibraryModify(cmdCtx, path, ids, method); err != nil {
+		return err
+	}
+	return emitCountStatus(ctx, len(ids), "Updated")
+}
+
 func parseIDs(inputs []string, kind string) ([]string, error) {
 	ids := make([]string, 0, len(inputs))
 	for _, input := range inputs {
-		res, err := spotify.ParseTypedID(strings.TrimSpace(input), kind)
+		res, err := parseAliasedID(input, kind)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,115 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/steipete/spogo/internal/app"
+	"github.com/steipete/spogo/internal/spotify"
+)
+
+type LibraryContainsCmd struct {
+	IDs  []string `arg:"" required:"" help:"IDs/URLs/URIs to check."`
+	Type string   `help:"Type for raw IDs (track|album|artist|playlist|show|episode|audiobook)." default:"track"`
+}
+
+// checkableTypes are the item types Spotify can report library membership
+// for; artists and playlists report whether they are followed.
+var checkableTypes = map[string]bool{
+	"track":     true,
+	"album":     true,
+	"artist":    true,
+	"playlist":  true,
+	"show":      true,
+	"episode":   true,
+	"audiobook": true,
+}
+
+func (cmd *LibraryContainsCmd) Run(ctx *app.Context) error {
+	resources := make([]spotify.Resource, 0, len(cmd.IDs))
+	uris := make([]string, 0, len(cmd.IDs))
+	for _, input := range cmd.IDs {
+		res, err := spotify.ParseResource(input)
+		if err != nil {
+			return err
+		}
+		if res.URI == "" {
+			res.Type = cmd.Type
+			res.URI = "spotify:" + cmd.Type + ":" + res.ID
+		}
+		if !checkableTypes[res.Type] {
+			return fmt.Errorf("cannot check library for %s", res.Type)
+		}
+		resources = append(resources, res)
+		uris = append(uris, res.URI)
+	}
+	client, cmdCtx, err := spotifyClient(ctx)
+	if err != nil {
+		return err
+	}
+	checker, err := libraryChecker(client)
+	if err != nil {
+		return err
+	}
+	saved, err := checker.LibraryContains(cmdCtx, uris)
+	if err != nil {
+		return err
+	}
+	type result struct {
+		ID    string `json:"id"`
+		URI   string `json:"uri"`
+		Type  string `json:"type"`
+		Saved bool   `json:"saved"`
+	}
+	results := make([]result, 0, len(resources))
+	plain := make([]string, 0, len(resources))
+	human := make([]string, 0, len(resources))
+	for i, res := range resources {
+		results = append(results, result{ID: res.ID, URI: res.URI, Type: res.Type, Saved: saved[i]})
+		plain = append(plain, fmt.Sprintf("%s\t%t", res.URI, saved[i]))
+		if saved[i] {
+			human = append(human, fmt.Sprintf("%s %s", ctx.Output.Theme.Success("♥"), res.URI))
+		} else {
+			human = append(human, fmt.Sprintf("%s %s", ctx.Output.Theme.Muted("·"), ctx.Output.Theme.Muted(res.URI)))
+		}
+	}
+	return ctx.Output.Emit(map[string]any{"items": results}, plain, human)
+}
+
+// markSaved fills Saved/SavedKnown on items Spotify can check, in a single
+// LibraryContains call.
+func markSaved(ctx context.Context, client spotify.API, items []spotify.Item) error {
+	indexes := []int{}
+	uris := []string{}
+	for i, item := range items {
+		if item.URI != "" && checkableTypes[item.Type] {
+			indexes = append(indexes, i)
+			uris = append(uris, item.URI)
+		}
+	}
+	if len(uris) == 0 {
+		return nil
+	}
+	checker, err := libraryChecker(client)
+	if err != nil {
+		return err
+	}
+	saved, err := checker.LibraryContains(ctx, uris)
+	if err != nil {
+		return err
+	}
+	for i, index := range indexes {
+		items[index].Saved = saved[i]
+		items[index].SavedKnown = true
+	}
+	return nil
+}
+
+func libraryChecker(client spotify.API) (spotify.LibraryChecker, error) {
+	checker, ok := client.(spotify.LibraryChecker)
+	if !ok {
+		return nil, errors.New("library checks not supported by engine")
+	}
+	return checker, nil
+}
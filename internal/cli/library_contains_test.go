@@ -0,0 +1,131 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/steipete/spogo/internal/output"
+	"github.com/steipete/spogo/internal/spotify"
+	"github.com/steipete/spogo/internal/testutil"
+)
+
+func containsMock(saved map[string]bool, seen *[]string) *testutil.SpotifyMock {
+	return &testutil.SpotifyMock{
+		LibraryContainsFn: func(_ context.Context, uris []string) ([]bool, error) {
+			*seen = append(*seen, uris...)
+			out := make([]bool, len(uris))
+			for i, uri := range uris {
+				out[i] = saved[uri]
+			}
+			return out, nil
+		},
+	}
+}
+
+func TestLibraryContainsCmd(t *testing.T) {
+	var seen []string
+	ctx, out, _ := testutil.NewTestContext(t, output.FormatPlain)
+	ctx.SetSpotify(containsMock(map[string]bool{"spotify:track:t1": true, "spotify:artist:a1": true}, &seen))
+	cmd := &LibraryContainsCmd{IDs: []string{"t1", "https://open.spotify.com/artist/a1", "spotify:album:b1"}, Type: "track"}
+	if err := cmd.Run(ctx); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	want := "spotify:track:t1\ttrue\nspotify:artist:a1\ttrue\nspotify:album:b1\tfalse"
+	if got := strings.TrimSpace(out.String()); got != want {
+		t.Fatalf("plain %q", got)
+	}
+
+	ctx, out, _ = testutil.NewTestContext(t, output.FormatJSON)
+	ctx.SetSpotify(containsMock(map[string]bool{"spotify:show:s1": true}, &seen))
+	if err := (&LibraryContainsCmd{IDs: []string{"s1"}, Type: "show"}).Run(ctx); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	var payload struct {
+		Items []struct {
+			ID    string `json:"id"`
+			Type  string `json:"type"`
+			Saved bool   `json:"saved"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal(out.Bytes(), &payload); err != nil {
+		t.Fatalf("json: %v", err)
+	}
+	if len(payload.Items) != 1 || payload.Items[0].Type != "show" || !payload.Items[0].Saved {
+		t.Fatalf("payload %#v", payload)
+	}
+
+	if err := (&LibraryContainsCmd{IDs: []string{"spotify:chapter:c1"}}).Run(ctx); err == nil {
+		t.Fatalf("expected error for chapter")
+	}
+	ctx.SetSpotify(struct{ spotify.API }{&testutil.SpotifyMock{}})
+	if err := (&LibraryContainsCmd{IDs: []string{"t1"}, Type: "track"}).Run(ctx); err == nil || !strings.Contains(err.Error(), "not supported") {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+}
+
+func TestSearchSavedFlag(t *testing.T) {
+	var seen []string
+	mock := containsMock(map[string]bool{"spotify:track:t2": true}, &seen)
+	mock.SearchFn = func(context.Context, string, string, int, int) (spotify.SearchResult, error) {
+		return spotify.SearchResult{Items: []spotify.Item{
+			{ID: "t1", URI: "spotify:track:t1", Name: "One", Type: "track"},
+			{ID: "t2", URI: "spotify:track:t2", Name: "Two", Type: "track"},
+		}, Total: 2}, nil
+	}
+	ctx, out, _ := testutil.NewTestContext(t, output.FormatPlain)
+	ctx.SetSpotify(mock)
+	if err := runSearch(ctx, "track", SearchArgs{Query: "q", Saved: true}); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 2 || !strings.HasSuffix(lines[0], "\tfalse") || !strings.HasSuffix(lines[1], "\ttrue") {
+		t.Fatalf("plain %q", lines)
+	}
+
+	ctx, out, _ = testutil.NewTestContext(t, output.FormatHuman)
+	ctx.SetSpotify(mock)
+	if err := runSearch(ctx, "track", SearchArgs{Query: "q", Saved: true}); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if strings.Count(out.String(), "♥") != 1 {
+		t.Fatalf("human %q", out.String())
+	}
+}
+
+func TestStatusSavedFlag(t *testing.T) {
+	var seen []string
+	mock := containsMock(map[string]bool{"spotify:track:t1": true}, &seen)
+	mock.PlaybackFn = func(context.Context) (spotify.PlaybackStatus, error) {
+		return spotify.PlaybackStatus{IsPlaying: true, Item: &spotify.Item{ID: "t1", URI: "spotify:track:t1", Name: "Song", Type: "track"}}, nil
+	}
+	ctx, out, _ := testutil.NewTestContext(t, output.FormatJSON)
+	ctx.SetSpotify(mock)
+	if err := (&StatusCmd{Saved: true}).Run(ctx); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	var payload struct {
+		Item map[string]any `json:"item"`
+	}
+	if err := json.Unmarshal(out.Bytes(), &payload); err != nil {
+		t.Fatalf("json: %v", err)
+	}
+	if payload.Item["saved"] != true {
+		t.Fatalf("payload %s", out.String())
+	}
+
+	ctx, out, _ = testutil.NewTestContext(t, output.FormatPlain)
+	ctx.SetSpotify(mock)
+	if err := (&StatusCmd{}).Run(ctx); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if strings.HasSuffix(strings.TrimSpace(out.String()), "true") || len(seen) != 1 {
+		t.Fatalf("saved checked without flag: %q %v", out.String(), seen)
+	}
+	ctx, out, _ = testutil.NewTestContext(t, output.FormatHuman)
+	ctx.SetSpotify(mock)
+	if err := (&StatusCmd{Saved: true}).Run(ctx); err != nil || !strings.Contains(out.String(), "♥") {
+		t.Fatalf("human %q %v", out.String(), err)
+	}
+}
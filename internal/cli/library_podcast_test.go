@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/steipete/spogo/internal/app"
+	"github.com/steipete/spogo/internal/output"
+	"github.com/steipete/spogo/internal/spotify"
+	"github.com/steipete/spogo/internal/testutil"
+)
+
+func TestLibraryShowsAndEpisodesModify(t *testing.T) {
+	type call struct {
+		path, method string
+		ids          []string
+	}
+	var calls []call
+	ctx, _, _ := testutil.NewTestContext(t, output.FormatPlain)
+	ctx.SetSpotify(&testutil.SpotifyMock{
+		LibraryModifyFn: func(_ context.Context, path string, ids []string, method string) error {
+			calls = append(calls, call{path, method, ids})
+			return nil
+		},
+	})
+	for _, cmd := range []interface{ Run(*app.Context) error }{
+		&LibraryShowsAddCmd{IDs: []string{"spotify:show:s1"}},
+		&LibraryShowsRemoveCmd{IDs: []string{"https://open.spotify.com/show/s2"}},
+		&LibraryEpisodesAddCmd{IDs: []string{"e1", "spotify:episode:e2"}},
+		&LibraryEpisodesRemoveCmd{IDs: []string{"e3"}},
+	} {
+		if err := cmd.Run(ctx); err != nil {
+			t.Fatalf("run %T: %v", cmd, err)
+		}
+	}
+	want := []call{
+		{"/me/shows", "PUT", []string{"s1"}},
+		{"/me/shows", "DELETE", []string{"s2"}},
+		{"/me/episodes", "PUT", []string{"e1", "e2"}},
+		{"/me/episodes", "DELETE", []string{"e3"}},
+	}
+	if len(calls) != len(want) {
+		t.Fatalf("calls %#v", calls)
+	}
+	for i := range want {
+		if calls[i].path != want[i].path || calls[i].method != want[i].method || strings.Join(calls[i].ids, ",") != strings.Join(want[i].ids, ",") {
+			t.Fatalf("call %d = %#v, want %#v", i, calls[i], want[i])
+		}
+	}
+	if err := (&LibraryEpisodesAddCmd{IDs: []string{"spotify:show:s1"}}).Run(ctx); err == nil {
+		t.Fatalf("expected invalid episode error")
+	}
+}
+
+func TestLibraryShowsAndEpisodesList(t *testing.T) {
+	ctx, out, _ := testutil.NewTestContext(t, output.FormatPlain)
+	ctx.SetSpotify(&testutil.SpotifyMock{
+		LibraryShowsFn: func(_ context.Context, limit, offset int) ([]spotify.Item, int, error) {
+			if limit != 50 || offset != 5 {
+				t.Fatalf("unexpected paging %d %d", limit, offset)
+			}
+			return []spotify.Item{{ID: "s1", Name: "Daily", Type: "show", Publisher: "Studio"}}, 1, nil
+		},
+		LibraryEpisodesFn: func(context.Context, int, int) ([]spotify.Item, int, error) {
+			return []spotify.Item{{ID: "e1", Name: "Saved", Type: "episode", DurationMS: 60000}}, 1, nil
+		},
+	})
+	if err := (&LibraryShowsListCmd{Limit: 100, Offset: 5}).Run(ctx); err != nil {
+		t.Fatalf("shows: %v", err)
+	}
+	if err := (&LibraryEpisodesListCmd{}).Run(ctx); err != nil {
+		t.Fatalf("episodes: %v", err)
+	}
+	if !strings.Contains(out.String(), "show\ts1\tDaily\tStudio") || !strings.Contains(out.String(), "episode\te1\tSaved\t60000") {
+		t.Fatalf("output %q", out.String())
+	}
+
+	ctx.SetSpotify(&testutil.SpotifyMock{
+		LibraryShowsFn:    func(context.Context, int, int) ([]spotify.Item, int, error) { return nil, 0, errors.New("boom") },
+		LibraryEpisodesFn: func(context.Context, int, int) ([]spotify.Item, int, error) { return nil, 0, errors.New("boom") },
+		LibraryModifyFn:   func(context.Context, string, []string, string) error { return errors.New("boom") },
+	})
+	if err := (&LibraryShowsListCmd{}).Run(ctx); err == nil {
+		t.Fatalf("expected shows error")
+	}
+	if err := (&LibraryEpisodesListCmd{}).Run(ctx); err == nil {
+		t.Fatalf("expected episodes error")
+	}
+	if err := (&LibraryShowsAddCmd{IDs: []string{"s1"}}).Run(ctx); err == nil {
+		t.Fatalf("expected modify error")
+	}
+}
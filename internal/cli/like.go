@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/steipete/spogo/internal/app"
+	"github.com/steipete/spogo/internal/spotify"
+)
+
+type LikeCmd struct {
+	Toggle bool `help:"Unlike instead when the item is already saved."`
+}
+
+type UnlikeCmd struct{}
+
+// likePaths are the library collections the playing item can be saved to.
+var likePaths = map[string]string{
+	"track":   "/me/tracks",
+	"episode": "/me/episodes",
+}
+
+func (cmd *LikeCmd) Run(ctx *app.Context) error {
+	return runLike(ctx, true, cmd.Toggle)
+}
+
+func (cmd *UnlikeCmd) Run(ctx *app.Context) error {
+	return runLike(ctx, false, false)
+}
+
+// runLike saves (or removes) the playing track or episode. With toggle, the
+// current library state decides the direction instead of want.
+func runLike(ctx *app.Context, want, toggle bool) error {
+	client, cmdCtx, err := spotifyClient(ctx)
+	if err != nil {
+		return err
+	}
+	status, err := client.Playback(cmdCtx)
+	if err != nil {
+		return err
+	}
+	if status.Item == nil || status.Item.URI == "" {
+		return errors.New("nothing playing")
+	}
+	item := *status.Item
+	res, err := spotify.ParseResource(item.URI)
+	if err != nil {
+		return err
+	}
+	path, ok := likePaths[res.Type]
+	if !ok {
+		return fmt.Errorf("cannot like a %s", res.Type)
+	}
+	item.Type = res.Type
+	if toggle {
+		checker, err := libraryChecker(client)
+		if err != nil {
+			return err
+		}
+		saved, err := checker.LibraryContains(cmdCtx, []string{res.URI})
+		if err != nil {
+			return err
+		}
+		want = !saved[0]
+	}
+	method := "DELETE"
+	if want {
+		method = "PUT"
+	}
+	if err := client.LibraryModify(cmdCtx, path, []string{res.ID}, method); err != nil {
+		return err
+	}
+	item.Saved, item.SavedKnown = want, true
+	human := "Removed " + itemHuman(ctx.Output, item) + " from your library"
+	if want {
+		human = "Liked " + itemHuman(ctx.Output, item)
+	}
+	payload := map[string]any{"status": "ok", "saved": want, "item": item}
+	return ctx.Output.Emit(payload, []string{fmt.Sprintf("%t\t%s", want, item.URI)}, []string{human})
+}
@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/steipete/spogo/internal/app"
+	"github.com/steipete/spogo/internal/output"
+	"github.com/steipete/spogo/internal/spotify"
+	"github.com/steipete/spogo/internal/testutil"
+)
+
+func likeMock(uri string, saved bool, calls *[]string) *testutil.SpotifyMock {
+	return &testutil.SpotifyMock{
+		PlaybackFn: func(context.Context) (spotify.PlaybackStatus, error) {
+			if uri == "" {
+				return spotify.PlaybackStatus{}, nil
+			}
+			return spotify.PlaybackStatus{IsPlaying: true, Item: &spotify.Item{URI: uri, Name: "Song"}}, nil
+		},
+		LibraryContainsFn: func(context.Context, []string) ([]bool, error) {
+			return []bool{saved}, nil
+		},
+		LibraryModifyFn: func(_ context.Context, path string, ids []string, method string) error {
+			*calls = append(*calls, method+" "+path+" "+strings.Join(ids, ","))
+			return nil
+		},
+	}
+}
+
+func TestLikeCmds(t *testing.T) {
+	tests := []struct {
+		name  string
+		cmd   interface{ Run(*app.Context) error }
+		uri   string
+		saved bool
+		call  string
+		plain string
+	}{
+		{"like", &LikeCmd{}, "spotify:track:t1", true, "PUT /me/tracks t1", "true\tspotify:track:t1"},
+		{"unlike", &UnlikeCmd{}, "spotify:track:t1", false, "DELETE /me/tracks t1", "false\tspotify:track:t1"},
+		{"toggle off", &LikeCmd{Toggle: true}, "spotify:track:t1", true, "DELETE /me/tracks t1", "false\tspotify:track:t1"},
+		{"toggle on episode", &LikeCmd{Toggle: true}, "spotify:episode:e1", false, "PUT /me/episodes e1", "true\tspotify:episode:e1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls []string
+			ctx, out, _ := testutil.NewTestContext(t, output.FormatPlain)
+			ctx.SetSpotify(likeMock(tt.uri, tt.saved, &calls))
+			if err := tt.cmd.Run(ctx); err != nil {
+				t.Fatalf("run: %v", err)
+			}
+			if len(calls) != 1 || calls[0] != tt.call {
+				t.Fatalf("calls %q", calls)
+			}
+			if got := strings.TrimSpace(out.String()); got != tt.plain {
+				t.Fatalf("plain %q", got)
+			}
+		})
+	}
+}
+
+func TestLikeCmdOutputAndErrors(t *testing.T) {
+	var calls []string
+	ctx, out, _ := testutil.NewTestContext(t, output.FormatJSON)
+	ctx.SetSpotify(likeMock("spotify:track:t1", false, &calls))
+	if err := (&LikeCmd{Toggle: true}).Run(ctx); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	var payload struct {
+		Saved bool           `json:"saved"`
+		Item  map[string]any `json:"item"`
+	}
+	if err := json.Unmarshal(out.Bytes(), &payload); err != nil {
+		t.Fatalf("json: %v", err)
+	}
+	if !payload.Saved || payload.Item["saved"] != true || payload.Item["type"] != "track" {
+		t.Fatalf("payload %s", out.String())
+	}
+
+	ctx, out, _ = testutil.NewTestContext(t, output.FormatHuman)
+	ctx.SetSpotify(likeMock("spotify:track:t1", false, &calls))
+	if err := (&UnlikeCmd{}).Run(ctx); err != nil || !strings.Contains(out.String(), "Removed") {
+		t.Fatalf("human %q %v", out.String(), err)
+	}
+
+	ctx.SetSpotify(likeMock("", false, &calls))
+	if err := (&LikeCmd{}).Run(ctx); err == nil || !strings.Contains(err.Error(), "nothing playing") {
+		t.Fatalf("expected nothing playing, got %v", err)
+	}
+	ctx.SetSpotify(likeMock("spotify:chapter:c1", false, &calls))
+	if err := (&LikeCmd{}).Run(ctx); err == nil || !strings.Contains(err.Error(), "cannot like") {
+		t.Fatalf("expected cannot like, got %v", err)
+	}
+	mock := likeMock("spotify:track:t1", false, &calls)
+	ctx.SetSpotify(struct{ spotify.API }{mock})
+	if err := (&LikeCmd{Toggle: true}).Run(ctx); err == nil || !strings.Contains(err.Error(), "not supported") {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+}
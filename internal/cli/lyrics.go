@@ -0,0 +1,232 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/mattn/go-isatty"
+	"github.com/steipete/spogo/internal/app"
+	"github.com/steipete/spogo/internal/output"
+	"github.com/steipete/spogo/internal/spotify"
+)
+
+// lyricsWindow is how many lines `lyrics --follow` shows around the current
+// one on a terminal.
+const lyricsWindow = 4
+
+var (
+	// lyricsPoll is how often --follow re-reads playback; progress is
+	// interpolated in between so the highlight keeps moving.
+	lyricsPoll = 2 * time.Second
+	// lyricsTick is how often --follow re-checks the current line.
+	lyricsTick = 250 * time.Millisecond
+)
+
+type LyricsCmd struct {
+	Track  string `arg:"" optional:"" help:"Track id/url/uri (default: currently playing)."`
+	LRC    bool   `name:"lrc" help:"Print synced lyrics in LRC format."`
+	Follow bool   `help:"Follow playback and highlight the current line."`
+}
+
+type lyricsFetcher interface {
+	Lyrics(ctx context.Context, trackID string) (spotify.Lyrics, error)
+}
+
+func (cmd *LyricsCmd) Run(ctx *app.Context) error {
+	if cmd.Follow && (cmd.LRC || cmd.Track != "") {
+		return app.WrapExit(2, errors.New("--follow uses the current track and cannot be combined with --lrc or a track"))
+	}
+	client, cmdCtx, err := spotifyClient(ctx)
+	if err != nil {
+		return err
+	}
+	fetcher, ok := client.(lyricsFetcher)
+	if !ok {
+		return errors.New("lyrics not supported by engine")
+	}
+	if cmd.Follow {
+		return followLyrics(ctx, cmdCtx, client, fetcher)
+	}
+	item, err := lyricsTrack(cmdCtx, client, cmd.Track)
+	if err != nil {
+		return err
+	}
+	lyrics, err := fetcher.Lyrics(cmdCtx, item.ID)
+	if err != nil {
+		return err
+	}
+	if cmd.LRC {
+		if !lyrics.Synced {
+			return fmt.Errorf("lyrics for %s are not synced", orDefault(item.Name, item.URI))
+		}
+		return ctx.Output.WriteLines(formatLRC(item, lyrics))
+	}
+	plain := make([]string, 0, len(lyrics.Lines))
+	human := []string{ctx.Output.Theme.Bold(trackLabel(item)), ""}
+	for _, line := range lyrics.Lines {
+		if lyrics.Synced {
+			plain = append(plain, fmt.Sprintf("%d\t%s", line.StartMS, line.Text))
+		} else {
+			plain = append(plain, line.Text)
+		}
+		human = append(human, line.Text)
+	}
+	if lyrics.Provider != "" {
+		human = append(human, "", ctx.Output.Theme.Muted("Lyrics provided by "+lyrics.Provider))
+	}
+	return ctx.Output.Emit(lyricsPayload(item, lyrics), plain, human)
+}
+
+// lyricsTrack resolves the track argument, or the currently playing track
+// when it is empty.
+func lyricsTrack(ctx context.Context, client spotify.API, input string) (spotify.Item, error) {
+	if strings.TrimSpace(input) != "" {
+		res, err := spotify.ParseTypedID(input, "track")
+		if err != nil {
+			return spotify.Item{}, err
+		}
+		return client.GetTrack(ctx, res.ID)
+	}
+	status, err := client.Playback(ctx)
+	if err != nil {
+		return spotify.Item{}, err
+	}
+	if status.Item == nil || status.Item.ID == "" {
+		return spotify.Item{}, errors.New("nothing playing")
+	}
+	if status.Item.Type != "" && status.Item.Type != "track" {
+		return spotify.Item{}, fmt.Errorf("lyrics are only available for tracks, not %ss", status.Item.Type)
+	}
+	return *status.Item, nil
+}
+
+// followLyrics tracks playback until interrupted. On a terminal it redraws
+// a window around the current line; otherwise it prints each line once as
+// it comes up, which suits piping into another display.
+func followLyrics(ctx *app.Context, cmdCtx context.Context, client spotify.API, fetcher lyricsFetcher) error {
+	runCtx, stop := signal.NotifyContext(cmdCtx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	redraw := ctx.Output.Format == output.FormatHuman && isTerminal(ctx.Output.Out)
+	var (
+		status  spotify.PlaybackStatus
+		lyrics  spotify.Lyrics
+		item    spotify.Item
+		polled  time.Time
+		current = -2
+	)
+	for {
+		if polled.IsZero() || time.Since(polled) >= lyricsPoll {
+			next, err := client.Playback(runCtx)
+			if err != nil {
+				if runCtx.Err() != nil && cmdCtx.Err() == nil {
+					return nil // interrupted
+				}
+				return err
+			}
+			status, polled = next, time.Now()
+			if status.Item != nil && status.Item.ID != item.ID {
+				item = *status.Item
+				lyrics, err = fetcher.Lyrics(runCtx, item.ID)
+				if errors.Is(err, spotify.ErrNoLyrics) {
+					lyrics = spotify.Lyrics{TrackID: item.ID}
+					ctx.Output.Errorf("no lyrics for %s", trackLabel(item))
+				} else if err != nil {
+					return err
+				}
+				current = -2
+			}
+		}
+		progress := status.ProgressMS
+		if status.IsPlaying {
+			progress += int(time.Since(polled).Milliseconds())
+		}
+		if index := lyrics.LineAt(progress); index != current {
+			current = index
+			if err := renderLyricsLine(ctx, redraw, item, lyrics, current); err != nil {
+				return err
+			}
+		}
+		if err := waitFor(runCtx, lyricsTick); err != nil {
+			if cmdCtx.Err() == nil {
+				return nil // interrupted
+			}
+			return err
+		}
+	}
+}
+
+func renderLyricsLine(ctx *app.Context, redraw bool, item spotify.Item, lyrics spotify.Lyrics, index int) error {
+	theme := ctx.Output.Theme
+	if redraw {
+		lines := []string{"\x1b[H\x1b[2J" + theme.Bold(trackLabel(item)), ""}
+		start, end := 0, len(lyrics.Lines)
+		if lyrics.Synced {
+			start, end = max(index-lyricsWindow, 0), min(index+lyricsWindow+1, len(lyrics.Lines))
+		}
+		for i := start; i < end; i++ {
+			if i == index {
+				lines = append(lines, theme.Accent("› "+lyrics.Lines[i].Text))
+			} else {
+				lines = append(lines, theme.Muted("  "+lyrics.Lines[i].Text))
+			}
+		}
+		return ctx.Output.WriteLines(lines)
+	}
+	if index < 0 || index >= len(lyrics.Lines) {
+		return nil
+	}
+	line := lyrics.Lines[index]
+	payload := map[string]any{"uri": item.URI, "index": index, "start_ms": line.StartMS, "text": line.Text}
+	return ctx.Output.Emit(payload, []string{fmt.Sprintf("%d\t%s", line.StartMS, line.Text)}, []string{line.Text})
+}
+
+// formatLRC renders synced lyrics as an LRC file with title/artist/album
+// tags and [mm:ss.xx] timestamps.
+func formatLRC(item spotify.Item, lyrics spotify.Lyrics) []string {
+	lines := make([]string, 0, len(lyrics.Lines)+4)
+	for _, tag := range [][2]string{{"ti", item.Name}, {"ar", strings.Join(item.Artists, ", ")}, {"al", item.Album}} {
+		if tag[1] != "" {
+			lines = append(lines, fmt.Sprintf("[%s:%s]", tag[0], tag[1]))
+		}
+	}
+	if item.DurationMS > 0 {
+		lines = append(lines, fmt.Sprintf("[length:%s]", formatClock(item.DurationMS)))
+	}
+	for _, line := range lyrics.Lines {
+		ms := max(line.StartMS, 0)
+		lines = append(lines, fmt.Sprintf("[%02d:%02d.%02d]%s", ms/60000, ms/1000%60, ms%1000/10, line.Text))
+	}
+	return lines
+}
+
+func lyricsPayload(item spotify.Item, lyrics spotify.Lyrics) map[string]any {
+	return map[string]any{
+		"uri":      item.URI,
+		"name":     item.Name,
+		"artists":  item.Artists,
+		"synced":   lyrics.Synced,
+		"provider": lyrics.Provider,
+		"language": lyrics.Language,
+		"lines":    lyrics.Lines,
+	}
+}
+
+func trackLabel(item spotify.Item) string {
+	label := orDefault(item.Name, item.URI)
+	if len(item.Artists) > 0 {
+		label += " — " + strings.Join(item.Artists, ", ")
+	}
+	return label
+}
+
+func isTerminal(w io.Writer) bool {
+	file, ok := w.(*os.File)
+	return ok && isatty.IsTerminal(file.Fd())
+}
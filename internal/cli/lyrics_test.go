@@ -0,0 +1,150 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/steipete/spogo/internal/output"
+	"github.com/steipete/spogo/internal/spotify"
+	"github.com/steipete/spogo/internal/testutil"
+)
+
+var testLyrics = spotify.Lyrics{
+	Synced:   true,
+	Provider: "MusixMatch",
+	Lines:    []spotify.LyricLine{{StartMS: 1000, Text: "Hello"}, {StartMS: 65430, Text: "World"}},
+}
+
+func lyricsMock(playing spotify.Item) *testutil.SpotifyMock {
+	return &testutil.SpotifyMock{
+		PlaybackFn: func(context.Context) (spotify.PlaybackStatus, error) {
+			return spotify.PlaybackStatus{Item: &playing, IsPlaying: true, ProgressMS: 2000}, nil
+		},
+		GetTrackFn: func(_ context.Context, id string) (spotify.Item, error) {
+			return spotify.Item{ID: id, URI: "spotify:track:" + id, Name: "Other", Type: "track"}, nil
+		},
+		LyricsFn: func(_ context.Context, trackID string) (spotify.Lyrics, error) {
+			if trackID == "none" {
+				return spotify.Lyrics{}, spotify.ErrNoLyrics
+			}
+			lyrics := testLyrics
+			lyrics.TrackID = trackID
+			return lyrics, nil
+		},
+	}
+}
+
+func TestLyricsCmdCurrentTrack(t *testing.T) {
+	ctx, out, _ := testutil.NewTestContext(t, output.FormatHuman)
+	ctx.SetSpotify(lyricsMock(spotify.Item{ID: "t1", Name: "Song", Artists: []string{"Band"}, Type: "track"}))
+	if err := (&LyricsCmd{}).Run(ctx); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if got := out.String(); !strings.Contains(got, "Song — Band\n\nHello\nWorld\n") || !strings.Contains(got, "MusixMatch") {
+		t.Fatalf("output %q", got)
+	}
+}
+
+func TestLyricsCmdPlainAndLRC(t *testing.T) {
+	ctx, out, _ := testutil.NewTestContext(t, output.FormatPlain)
+	ctx.SetSpotify(lyricsMock(spotify.Item{}))
+	if err := (&LyricsCmd{Track: "spotify:track:t2"}).Run(ctx); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if out.String() != "1000\tHello\n65430\tWorld\n" {
+		t.Fatalf("plain %q", out.String())
+	}
+	out.Reset()
+	if err := (&LyricsCmd{Track: "t2", LRC: true}).Run(ctx); err != nil {
+		t.Fatalf("lrc: %v", err)
+	}
+	if out.String() != "[ti:Other]\n[00:01.00]Hello\n[01:05.43]World\n" {
+		t.Fatalf("lrc %q", out.String())
+	}
+}
+
+func TestLyricsCmdErrors(t *testing.T) {
+	ctx, _, _ := testutil.NewTestContext(t, output.FormatPlain)
+	mock := lyricsMock(spotify.Item{ID: "e1", Type: "episode"})
+	ctx.SetSpotify(mock)
+	if err := (&LyricsCmd{}).Run(ctx); err == nil || !strings.Contains(err.Error(), "episodes") {
+		t.Fatalf("expected episode error, got %v", err)
+	}
+	if err := (&LyricsCmd{Track: "none"}).Run(ctx); !errors.Is(err, spotify.ErrNoLyrics) {
+		t.Fatalf("expected no lyrics, got %v", err)
+	}
+	unsynced := mock.LyricsFn
+	mock.LyricsFn = func(ctx context.Context, id string) (spotify.Lyrics, error) {
+		lyrics, err := unsynced(ctx, id)
+		lyrics.Synced = false
+		return lyrics, err
+	}
+	if err := (&LyricsCmd{Track: "t1", LRC: true}).Run(ctx); err == nil || !strings.Contains(err.Error(), "not synced") {
+		t.Fatalf("expected unsynced error, got %v", err)
+	}
+	if err := (&LyricsCmd{Track: "t1", Follow: true}).Run(ctx); err == nil {
+		t.Fatalf("expected usage error")
+	}
+	ctx.SetSpotify(&testutil.SpotifyMock{PlaybackFn: func(context.Context) (spotify.PlaybackStatus, error) {
+		return spotify.PlaybackStatus{}, nil
+	}})
+	if err := (&LyricsCmd{}).Run(ctx); err == nil || !strings.Contains(err.Error(), "nothing playing") {
+		t.Fatalf("expected nothing playing, got %v", err)
+	}
+	ctx.SetSpotify(struct{ spotify.API }{&testutil.SpotifyMock{}})
+	if err := (&LyricsCmd{}).Run(ctx); err == nil || !strings.Contains(err.Error(), "not supported") {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+}
+
+func TestLyricsCmdFollow(t *testing.T) {
+	ctx, out, errOut := testutil.NewTestContext(t, output.FormatPlain)
+	stubWait(t, 3)
+	orig := lyricsPoll
+	lyricsPoll = 0
+	t.Cleanup(func() { lyricsPoll = orig })
+	statuses := []spotify.PlaybackStatus{
+		{Item: &spotify.Item{ID: "t1"}, ProgressMS: 1500},
+		{Item: &spotify.Item{ID: "t1"}, ProgressMS: 1600},
+		{Item: &spotify.Item{ID: "t1"}, ProgressMS: 70000},
+		{Item: &spotify.Item{ID: "none", Name: "Quiet"}},
+	}
+	mock := lyricsMock(spotify.Item{})
+	mock.PlaybackFn = func(context.Context) (spotify.PlaybackStatus, error) {
+		status := statuses[0]
+		statuses = statuses[1:]
+		return status, nil
+	}
+	ctx.SetSpotify(mock)
+	if err := (&LyricsCmd{Follow: true}).Run(ctx); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if out.String() != "1000\tHello\n65430\tWorld\n" || !strings.Contains(errOut.String(), "no lyrics for Quiet") {
+		t.Fatalf("out %q err %q", out.String(), errOut.String())
+	}
+}
+
+func TestLyricsCmdFollowPlaybackError(t *testing.T) {
+	ctx, _, _ := testutil.NewTestContext(t, output.FormatJSON)
+	ctx.SetSpotify(&testutil.SpotifyMock{
+		PlaybackFn: func(context.Context) (spotify.PlaybackStatus, error) {
+			return spotify.PlaybackStatus{}, errors.New("boom")
+		},
+		LyricsFn: func(context.Context, string) (spotify.Lyrics, error) { return spotify.Lyrics{}, nil },
+	})
+	if err := (&LyricsCmd{Follow: true}).Run(ctx); err == nil || err.Error() != "boom" {
+		t.Fatalf("expected boom, got %v", err)
+	}
+}
+
+func TestRenderLyricsRedraw(t *testing.T) {
+	ctx, out, _ := testutil.NewTestContext(t, output.FormatHuman)
+	if err := renderLyricsLine(ctx, true, spotify.Item{Name: "Song"}, testLyrics, 1); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if got := out.String(); !strings.Contains(got, "\x1b[2J") || !strings.Contains(got, "  Hello\n› World") {
+		t.Fatalf("redraw %q", got)
+	}
+}
@@ -0,0 +1,212 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/mattn/go-isatty"
+	"github.com/steipete/spogo/internal/app"
+	"github.com/steipete/spogo/internal/output"
+	"github.com/steipete/spogo/internal/spotify"
+)
+
+const playSearchLimit = 5
+
+var playSearchTypes = []string{"track", "album", "artist", "playlist", "show"}
+
+type playCandidate struct {
+	Item  spotify.Item `json:"item"`
+	Score float64      `json:"score"`
+}
+
+func (cmd *PlayCmd) runSearch(ctx *app.Context) error {
+	client, cmdCtx, err := spotifyClient(ctx)
+	if err != nil {
+		return err
+	}
+	candidates, err := searchPlayCandidates(cmdCtx, client, cmd.Search, cmd.Type)
+	if err != nil {
+		return err
+	}
+	if len(candidates) == 0 {
+		return fmt.Errorf("no matches for %q", cmd.Search)
+	}
+	pick := candidates[0]
+	if cmd.Pick {
+		interactive := isatty.IsTerminal(os.Stdin.Fd()) && !ctx.Settings.NoInput
+		if interactive {
+			pick, err = promptPlayCandidate(os.Stdin, ctx.Output, candidates)
+			if err != nil {
+				return err
+			}
+		} else {
+			ctx.Output.Errorf("--pick needs an interactive terminal; using best match")
+		}
+	}
+	res, err := spotify.ParseResource(pick.Item.URI)
+	if err != nil {
+		return err
+	}
+	uri, err := playbackURI(cmdCtx, client, res)
+	if err != nil {
+		return err
+	}
+	if err := startPlayback(cmdCtx, client, uri, cmd.Shuffle); err != nil {
+		return err
+	}
+	payload := map[string]any{"status": "ok", "item": pick.Item, "score": pick.Score}
+	plain := []string{itemPlain(pick.Item)}
+	human := []string{"Playing " + itemHuman(ctx.Output, pick.Item)}
+	return ctx.Output.Emit(payload, plain, human)
+}
+
+// searchPlayCandidates runs the query against every playable type (or just
+// the hinted one) and returns the merged results, best first.
+func searchPlayCandidates(ctx context.Context, client spotify.API, query, kind string) ([]playCandidate, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, errors.New("search query required")
+	}
+	kinds := playSearchTypes
+	if kind != "" {
+		kinds = []string{strings.ToLower(strings.TrimSpace(kind))}
+	}
+	var candidates []playCandidate
+	var firstErr error
+	for _, k := range kinds {
+		res, err := client.Search(ctx, k, query, playSearchLimit, 0)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		for rank, item := range res.Items {
+			if item.URI == "" {
+				continue
+			}
+			if item.Type == "" {
+				item.Type = k
+			}
+			candidates = append(candidates, playCandidate{
+				Item:  item,
+				Score: scorePlayCandidate(query, item, rank, len(res.Items)),
+			})
+		}
+	}
+	if len(candidates) == 0 && firstErr != nil {
+		return nil, firstErr
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Score > candidates[j].Score
+	})
+	return candidates, nil
+}
+
+// scorePlayCandidate favors exact title matches, then titles and artists
+// named in the query, then query coverage, with search rank and follower
+// counts as popularity tie-breakers.
+func scorePlayCandidate(query string, item spotify.Item, rank, total int) float64 {
+	q := normalizeMatchText(query)
+	name := normalizeMatchText(item.Name)
+	if q == "" || name == "" {
+		return 0
+	}
+	score := 0.0
+	switch {
+	case name == q:
+		score += 100
+	case containsPhrase(q, name):
+		score += 40 * float64(len(name)) / float64(len(q))
+	}
+	words := []string{name}
+	for _, artist := range item.Artists {
+		artist = normalizeMatchText(artist)
+		if artist == "" {
+			continue
+		}
+		words = append(words, artist)
+		if containsPhrase(q, artist) {
+			score += 30
+		}
+	}
+	score += 30 * tokenCoverage(q, strings.Join(words, " "))
+	if total > 0 {
+		score += 10 * float64(total-rank) / float64(total)
+	}
+	if item.Followers > 0 {
+		score += 2 * math.Log10(float64(item.Followers)+1)
+	}
+	return math.Round(score*10) / 10
+}
+
+func normalizeMatchText(value string) string {
+	var b strings.Builder
+	space := false
+	for _, r := range strings.ToLower(value) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+			space = false
+			continue
+		}
+		if !space && b.Len() > 0 {
+			b.WriteByte(' ')
+			space = true
+		}
+	}
+	return strings.TrimSpace(b.String())
+}
+
+func containsPhrase(haystack, needle string) bool {
+	return strings.Contains(" "+haystack+" ", " "+needle+" ")
+}
+
+func tokenCoverage(query, text string) float64 {
+	tokens := strings.Fields(query)
+	if len(tokens) == 0 {
+		return 0
+	}
+	have := map[string]struct{}{}
+	for _, token := range strings.Fields(text) {
+		have[token] = struct{}{}
+	}
+	hits := 0
+	for _, token := range tokens {
+		if _, ok := have[token]; ok {
+			hits++
+		}
+	}
+	return float64(hits) / float64(len(tokens))
+}
+
+func promptPlayCandidate(r io.Reader, out *output.Writer, candidates []playCandidate) (playCandidate, error) {
+	if len(candidates) > 10 {
+		candidates = candidates[:10]
+	}
+	for i, candidate := range candidates {
+		_, _ = fmt.Fprintf(out.Err, "%2d) %s %s\n", i+1, itemHuman(out, candidate.Item), out.Theme.Muted("["+candidate.Item.Type+"]"))
+	}
+	_, _ = fmt.Fprintf(out.Err, "Pick [1-%d] (default 1): ", len(candidates))
+	line, err := bufio.NewReader(r).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return playCandidate{}, err
+	}
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return candidates[0], nil
+	}
+	choice, err := strconv.Atoi(line)
+	if err != nil || choice < 1 || choice > len(candidates) {
+		return playCandidate{}, fmt.Errorf("invalid choice %q", line)
+	}
+	return candidates[choice-1], nil
+}
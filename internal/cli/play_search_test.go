@@ -0,0 +1,155 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/steipete/spogo/internal/output"
+	"github.com/steipete/spogo/internal/spotify"
+	"github.com/steipete/spogo/internal/testutil"
+)
+
+func playSearchMock(t *testing.T, played *string) *testutil.SpotifyMock {
+	t.Helper()
+	results := map[string][]spotify.Item{
+		"track": {
+			{ID: "t1", URI: "spotify:track:t1", Name: "One More Time", Type: "track", Artists: []string{"Daft Punk"}, Album: "Discovery"},
+		},
+		"album": {
+			{ID: "a1", URI: "spotify:album:a1", Name: "Discovery", Type: "album", Artists: []string{"Daft Punk"}},
+		},
+		"artist": {
+			{ID: "ar1", URI: "spotify:artist:ar1", Name: "Daft Punk", Type: "artist", Followers: 9000000},
+		},
+	}
+	return &testutil.SpotifyMock{
+		SearchFn: func(ctx context.Context, kind, query string, limit, offset int) (spotify.SearchResult, error) {
+			if limit != playSearchLimit {
+				t.Fatalf("limit %d", limit)
+			}
+			return spotify.SearchResult{Type: kind, Items: results[kind]}, nil
+		},
+		PlayFn: func(ctx context.Context, uri string) error {
+			*played = uri
+			return nil
+		},
+	}
+}
+
+func TestPlayCmdSearchPicksBestMatch(t *testing.T) {
+	ctx, out, _ := testutil.NewTestContext(t, output.FormatPlain)
+	played := ""
+	ctx.SetSpotify(playSearchMock(t, &played))
+	if err := (&PlayCmd{Search: "daft punk discovery"}).Run(ctx); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if played != "spotify:album:a1" {
+		t.Fatalf("played %q", played)
+	}
+	if !strings.HasPrefix(out.String(), "album\ta1") {
+		t.Fatalf("output %q", out.String())
+	}
+}
+
+func TestPlayCmdSearchTypeHint(t *testing.T) {
+	ctx, _, _ := testutil.NewTestContext(t, output.FormatPlain)
+	played := ""
+	mock := playSearchMock(t, &played)
+	mock.ArtistTopTracksFn = func(ctx context.Context, id string, limit int) ([]spotify.Item, error) {
+		if id != "ar1" {
+			t.Fatalf("id %s", id)
+		}
+		return []spotify.Item{{URI: "spotify:track:top"}}, nil
+	}
+	ctx.SetSpotify(mock)
+	if err := (&PlayCmd{Search: "daft punk discovery", Type: "artist"}).Run(ctx); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if played != "spotify:track:top" {
+		t.Fatalf("played %q", played)
+	}
+}
+
+func TestPlayCmdSearchNoMatches(t *testing.T) {
+	ctx, _, _ := testutil.NewTestContext(t, output.FormatPlain)
+	ctx.SetSpotify(&testutil.SpotifyMock{
+		SearchFn: func(context.Context, string, string, int, int) (spotify.SearchResult, error) {
+			return spotify.SearchResult{}, nil
+		},
+	})
+	if err := (&PlayCmd{Search: "nothing"}).Run(ctx); err == nil {
+		t.Fatalf("expected error")
+	}
+}
+
+func TestPlayCmdSearchErrors(t *testing.T) {
+	ctx, _, _ := testutil.NewTestContext(t, output.FormatPlain)
+	ctx.SetSpotify(&testutil.SpotifyMock{
+		SearchFn: func(context.Context, string, string, int, int) (spotify.SearchResult, error) {
+			return spotify.SearchResult{}, errors.New("boom")
+		},
+	})
+	if err := (&PlayCmd{Search: "x"}).Run(ctx); err == nil || err.Error() != "boom" {
+		t.Fatalf("expected boom, got %v", err)
+	}
+	if err := (&PlayCmd{Search: "x", Item: "spotify:track:1"}).Run(ctx); err == nil {
+		t.Fatalf("expected conflict error")
+	}
+}
+
+func TestScorePlayCandidate(t *testing.T) {
+	exact := scorePlayCandidate("Bohemian Rhapsody", spotify.Item{Name: "Bohemian Rhapsody", Artists: []string{"Queen"}}, 2, 5)
+	partial := scorePlayCandidate("Bohemian Rhapsody", spotify.Item{Name: "Bohemian Rhapsody (Live)", Artists: []string{"Queen"}}, 0, 5)
+	if exact <= partial {
+		t.Fatalf("exact %v <= partial %v", exact, partial)
+	}
+	if got := scorePlayCandidate("", spotify.Item{Name: "x"}, 0, 1); got != 0 {
+		t.Fatalf("empty query score %v", got)
+	}
+}
+
+func TestNormalizeMatchText(t *testing.T) {
+	if got := normalizeMatchText("  Beyoncé -- Halo!! "); got != "beyoncé halo" {
+		t.Fatalf("got %q", got)
+	}
+}
+
+func TestPromptPlayCandidate(t *testing.T) {
+	ctx, _, errOut := testutil.NewTestContext(t, output.FormatHuman)
+	w := ctx.Output
+	candidates := []playCandidate{
+		{Item: spotify.Item{Name: "A", Type: "track"}},
+		{Item: spotify.Item{Name: "B", Type: "album"}},
+	}
+	pick, err := promptPlayCandidate(strings.NewReader("2\n"), w, candidates)
+	if err != nil || pick.Item.Name != "B" {
+		t.Fatalf("pick %#v err %v", pick, err)
+	}
+	if !strings.Contains(errOut.String(), "Pick [1-2]") {
+		t.Fatalf("prompt %q", errOut.String())
+	}
+	pick, err = promptPlayCandidate(strings.NewReader("\n"), w, candidates)
+	if err != nil || pick.Item.Name != "A" {
+		t.Fatalf("default pick %#v err %v", pick, err)
+	}
+	if _, err := promptPlayCandidate(strings.NewReader("9\n"), w, candidates); err == nil {
+		t.Fatalf("expected error")
+	}
+}
+
+func TestPlayCmdSearchPickWithoutTTY(t *testing.T) {
+	ctx, _, errOut := testutil.NewTestContext(t, output.FormatPlain)
+	played := ""
+	ctx.SetSpotify(playSearchMock(t, &played))
+	if err := (&PlayCmd{Search: "one more time", Pick: true}).Run(ctx); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if played != "spotify:track:t1" {
+		t.Fatalf("played %q", played)
+	}
+	if !strings.Contains(errOut.String(), "using best match") {
+		t.Fatalf("stderr %q", errOut.String())
+	}
+}
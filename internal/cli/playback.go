@@ -14,8 +14,10 @@ import (
 
 type PlayCmd struct {
 	Item    string `arg:"" optional:"" help:"Spotify ID/URL/URI."`
-	Type    string `help:"Type for raw IDs (track|album|playlist|show|episode)."`
+	Type    string `help:"Type for raw IDs or --search hint (track|album|artist|playlist|show|episode|audiobook|chapter)."`
 	Shuffle bool   `help:"Enable shuffle before playing."`
+	Search  string `help:"Free-text query; plays the best match."`
+	Pick    bool   `help:"Choose among --search matches interactively."`
 }
 
 type PauseCmd struct{}
@@ -25,11 +27,16 @@ type NextCmd struct{}
 type PrevCmd struct{}
 
 type SeekCmd struct {
-	Position string `arg:"" required:"" help:"Position ms or mm:ss."`
+	Position string `arg:"" required:"" help:"Position ms, mm:ss or duration; +/- prefix seeks relative, N% seeks into the track."`
 }
 
 type VolumeCmd struct {
-	Level int `arg:"" required:"" help:"Volume 0-100."`
+	Set  VolumeSetCmd  `kong:"cmd,default='withargs',help='Set or step volume (default).'"`
+	Fade VolumeFadeCmd `kong:"cmd,help='Fade volume to a level over time.'"`
+}
+
+type VolumeSetCmd struct {
+	Level string `arg:"" required:"" help:"Volume 0-100, +N/-N relative, mute or unmute."`
 }
 
 type ShuffleCmd struct {
@@ -40,13 +47,17 @@ type RepeatCmd struct {
 	Mode string `arg:"" required:"" help:"off|track|context."`
 }
 
-type StatusCmd struct{}
-
-type artistTopTracks interface {
-	ArtistTopTracks(ctx context.Context, id string, limit int) ([]spotify.Item, error)
+type StatusCmd struct {
+	Saved bool `help:"Also report whether the current item is in your library."`
 }
 
 func (cmd *PlayCmd) Run(ctx *app.Context) error {
+	if cmd.Search != "" {
+		if cmd.Item != "" {
+			return errors.New("pass either an item or --search, not both")
+		}
+		return cmd.runSearch(ctx)
+	}
 	client, cmdCtx, err := spotifyClient(ctx)
 	if err != nil {
 		return err
@@ -64,53 +75,65 @@ func (cmd *PlayCmd) Run(ctx *app.Context) error {
 			res.Type = cmd.Type
 			res.URI = "spotify:" + cmd.Type + ":" + res.ID
 		}
-		if res.Type == "artist" {
-			topTracks, ok := client.(artistTopTracks)
-			if !ok {
-				return errors.New("artist playback not supported by engine")
-			}
-			tracks, err := topTracks.ArtistTopTracks(cmdCtx, res.ID, 10)
-			if err == nil && len(tracks) > 0 {
-				uri = tracks[0].URI
-			} else {
-				artist, aerr := client.GetArtist(cmdCtx, res.ID)
-				if aerr != nil || artist.Name == "" {
-					if err != nil {
-						return err
-					}
-					return errors.New("no artist tracks found")
-				}
-				query := fmt.Sprintf("artist:%q", artist.Name)
-				search, serr := client.Search(cmdCtx, "track", query, 1, 0)
-				if serr != nil {
-					if err != nil {
-						return err
-					}
-					return serr
-				}
-				if len(search.Items) == 0 {
-					if err != nil {
-						return err
-					}
-					return errors.New("no artist tracks found")
-				}
-				uri = search.Items[0].URI
-			}
-		} else {
-			uri = res.URI
-		}
-	}
-	if cmd.Shuffle {
-		if err := client.Shuffle(cmdCtx, true); err != nil {
+		uri, err = playbackURI(cmdCtx, client, res)
+		if err != nil {
 			return err
 		}
 	}
-	if err := client.Play(cmdCtx, uri); err != nil {
+	if err := startPlayback(cmdCtx, client, uri, cmd.Shuffle); err != nil {
 		return err
 	}
 	return emitOK(ctx, nil, "Playback started")
 }
 
+// playbackURI maps a resource to something Play accepts; artists have no
+// playable context, so they resolve to their top track, and audiobooks and
+// chapters play through their show and episode URIs.
+func playbackURI(ctx context.Context, client spotify.API, res spotify.Resource) (string, error) {
+	if res.Type != "artist" {
+		return spotify.PlayableURI(res.URI), nil
+	}
+	catalog, ok := client.(spotify.ArtistCatalog)
+	if !ok {
+		return "", errors.New("artist playback not supported by engine")
+	}
+	tracks, err := catalog.ArtistTopTracks(ctx, res.ID, 10)
+	if err == nil && len(tracks) > 0 {
+		return tracks[0].URI, nil
+	}
+	artist, aerr := client.GetArtist(ctx, res.ID)
+	if aerr != nil || artist.Name == "" {
+		if err != nil {
+			return "", err
+		}
+		return "", errors.New("no artist tracks found")
+	}
+	query := fmt.Sprintf("artist:%q", artist.Name)
+	search, serr := client.Search(ctx, "track", query, 1, 0)
+	if serr != nil {
+		if err != nil {
+			return "", err
+		}
+		return "", serr
+	}
+	if len(search.Items) == 0 {
+		if err != nil {
+			return "", err
+		}
+		return "", errors.New("no artist tracks found")
+	}
+	return search.Items[0].URI, nil
+}
+
+func startPlayback(ctx context.Context, client spotify.API, uri string, shuffle bool) error {
+	if shuffle {
+		if err := client.Shuffle(ctx, true); err != nil {
+			return err
+		}
+	}
+	return client.Play(ctx, uri)
+}
+
 func (cmd *PauseCmd) Run(ctx *app.Context) error {
 	client, cmdCtx, err := spotifyClient(ctx)
 	if err != nil {
@@ -145,32 +168,58 @@ func (cmd *PrevCmd) Run(ctx *app.Context) error {
 }
 
 func (cmd *SeekCmd) Run(ctx *app.Context) error {
-	client, cmdCtx, err := spotifyClient(ctx)
+	target, err := parseSeekTarget(cmd.Position)
 	if err != nil {
 		return err
 	}
-	position, err := parsePosition(cmd.Position)
+	client, cmdCtx, err := spotifyClient(ctx)
 	if err != nil {
 		return err
 	}
+	position := target.ms
+	if target.kind != seekAbsolute {
+		status, err := client.Playback(cmdCtx)
+		if err != nil {
+			return err
+		}
+		position, err = target.resolve(status)
+		if err != nil {
+			return err
+		}
+	}
 	if err := client.Seek(cmdCtx, position); err != nil {
 		return err
 	}
 	return emitOK(ctx, map[string]any{"status": "ok", "position_ms": position}, fmt.Sprintf("Seeked to %s", humanDuration(position)))
 }
 
-func (cmd *VolumeCmd) Run(ctx *app.Context) error {
-	if cmd.Level < 0 || cmd.Level > 100 {
-		return fmt.Errorf("volume must be 0-100")
+func (cmd *VolumeSetCmd) Run(ctx *app.Context) error {
+	input := strings.ToLower(strings.TrimSpace(cmd.Level))
+	switch input {
+	case "mute":
+		return runMute(ctx)
+	case "unmute":
+		return runUnmute(ctx)
+	}
+	level, relative, err := parseVolumeLevel(input)
+	if err != nil {
+		return err
 	}
 	client, cmdCtx, err := spotifyClient(ctx)
 	if err != nil {
 		return err
 	}
-	if err := client.Volume(cmdCtx, cmd.Level); err != nil {
+	if relative {
+		status, err := client.Playback(cmdCtx)
+		if err != nil {
+			return err
+		}
+		level = clampVolume(status.Device.Volume + level)
+	}
+	if err := client.Volume(cmdCtx, level); err != nil {
 		return err
 	}
-	return emitOK(ctx, map[string]any{"status": "ok", "volume": cmd.Level}, fmt.Sprintf("Volume %d", cmd.Level))
+	return emitOK(ctx, map[string]any{"status": "ok", "volume": level}, fmt.Sprintf("Volume %d", level))
 }
 
 func (cmd *ShuffleCmd) Run(ctx *app.Context) error {
@@ -212,6 +261,13 @@ func (cmd *StatusCmd) Run(ctx *app.Context) error {
 	if err != nil {
 		return err
 	}
+	if cmd.Saved && status.Item != nil {
+		items := []spotify.Item{*status.Item}
+		if err := markSaved(cmdCtx, client, items); err != nil {
+			return err
+		}
+		status.Item = &items[0]
+	}
 	plain := []string{playbackPlain(status)}
 	human := []string{playbackHuman(ctx.Output, status)}
 	return ctx.Output.Emit(status, plain, human)
@@ -238,6 +294,13 @@ func parsePosition(input string) (int, error) {
 		d := time.Duration(min)*time.Minute + time.Duration(sec)*time.Second
 		return int(d / time.Millisecond), nil
 	}
+	if strings.ContainsAny(input, "hms") {
+		d, err := time.ParseDuration(input)
+		if err != nil {
+			return 0, err
+		}
+		return int(d / time.Millisecond), nil
+	}
 	ms, err := strconv.Atoi(input)
 	if err != nil {
 		return 0, err
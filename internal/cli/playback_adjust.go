@@ -0,0 +1,157 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/steipete/spogo/internal/app"
+	"github.com/steipete/spogo/internal/spotify"
+	"github.com/steipete/spogo/internal/state"
+)
+
+type seekKind int
+
+const (
+	seekAbsolute seekKind = iota
+	seekRelative
+	seekPercent
+)
+
+type seekTarget struct {
+	kind    seekKind
+	ms      int
+	percent float64
+}
+
+// parseSeekTarget accepts an absolute position (`90000`, `1:30`, `90s`), a
+// signed offset from the current position (`+15s`, `-1:00`) or a share of
+// the current item (`50%`).
+func parseSeekTarget(input string) (seekTarget, error) {
+	input = strings.TrimSpace(input)
+	if value, ok := strings.CutSuffix(input, "%"); ok {
+		percent, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || percent < 0 || percent > 100 {
+			return seekTarget{}, fmt.Errorf("seek percent must be 0-100%%")
+		}
+		return seekTarget{kind: seekPercent, percent: percent}, nil
+	}
+	sign := 0
+	switch {
+	case strings.HasPrefix(input, "+"):
+		sign = 1
+	case strings.HasPrefix(input, "-"):
+		sign = -1
+	}
+	if sign == 0 {
+		ms, err := parsePosition(input)
+		if err != nil {
+			return seekTarget{}, err
+		}
+		if ms < 0 {
+			return seekTarget{}, errors.New("position must not be negative")
+		}
+		return seekTarget{kind: seekAbsolute, ms: ms}, nil
+	}
+	ms, err := parsePosition(input[1:])
+	if err != nil {
+		return seekTarget{}, err
+	}
+	if ms < 0 {
+		return seekTarget{}, fmt.Errorf("invalid seek offset %q", input)
+	}
+	return seekTarget{kind: seekRelative, ms: sign * ms}, nil
+}
+
+func (t seekTarget) resolve(status spotify.PlaybackStatus) (int, error) {
+	duration := 0
+	if status.Item != nil {
+		duration = status.Item.DurationMS
+	}
+	switch t.kind {
+	case seekPercent:
+		if duration <= 0 {
+			return 0, errors.New("nothing playing with a known duration")
+		}
+		return int(float64(duration) * t.percent / 100), nil
+	case seekRelative:
+		position := max(status.ProgressMS+t.ms, 0)
+		if duration > 0 {
+			position = min(position, duration)
+		}
+		return position, nil
+	default:
+		return t.ms, nil
+	}
+}
+
+// parseVolumeLevel returns an absolute 0-100 level, or a signed step when
+// the input starts with + or -.
+func parseVolumeLevel(input string) (int, bool, error) {
+	input = strings.TrimSuffix(strings.TrimSpace(input), "%")
+	relative := strings.HasPrefix(input, "+") || strings.HasPrefix(input, "-")
+	level, err := strconv.Atoi(input)
+	if err != nil {
+		return 0, false, fmt.Errorf("volume must be 0-100, +N, -N, mute or unmute")
+	}
+	if !relative && (level < 0 || level > 100) {
+		return 0, false, fmt.Errorf("volume must be 0-100")
+	}
+	return level, relative, nil
+}
+
+func clampVolume(level int) int {
+	return min(max(level, 0), 100)
+}
+
+// runMute remembers the current level in the profile state file before
+// dropping the volume to zero, so `volume unmute` can restore it.
+func runMute(ctx *app.Context) error {
+	client, cmdCtx, err := spotifyClient(ctx)
+	if err != nil {
+		return err
+	}
+	status, err := client.Playback(cmdCtx)
+	if err != nil {
+		return err
+	}
+	previous := status.Device.Volume
+	if previous > 0 {
+		if err := state.Update(ctx.ResolveStatePath(), func(st *state.State) error {
+			st.MutedVolume = previous
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	if err := client.Volume(cmdCtx, 0); err != nil {
+		return err
+	}
+	payload := map[string]any{"status": "ok", "volume": 0, "muted": true, "previous": previous}
+	return emitOK(ctx, payload, fmt.Sprintf("Muted (was %d)", previous))
+}
+
+func runUnmute(ctx *app.Context) error {
+	path := ctx.ResolveStatePath()
+	st, err := state.Read(path)
+	if err != nil {
+		return err
+	}
+	if st.MutedVolume <= 0 {
+		return errors.New("no muted volume to restore; set one with `spogo volume <0-100>`")
+	}
+	client, cmdCtx, err := spotifyClient(ctx)
+	if err != nil {
+		return err
+	}
+	level := st.MutedVolume
+	if err := client.Volume(cmdCtx, level); err != nil {
+		return err
+	}
+	st.MutedVolume = 0
+	if err := state.Write(path, st); err != nil {
+		return err
+	}
+	return emitOK(ctx, map[string]any{"status": "ok", "volume": level, "muted": false}, fmt.Sprintf("Volume %d", level))
+}
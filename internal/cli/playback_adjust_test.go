@@ -0,0 +1,178 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steipete/spogo/internal/output"
+	"github.com/steipete/spogo/internal/spotify"
+	"github.com/steipete/spogo/internal/state"
+	"github.com/steipete/spogo/internal/testutil"
+)
+
+func adjustMock(volume int, seeked, set *int) *testutil.SpotifyMock {
+	return &testutil.SpotifyMock{
+		PlaybackFn: func(context.Context) (spotify.PlaybackStatus, error) {
+			return spotify.PlaybackStatus{
+				ProgressMS: 60_000,
+				Item:       &spotify.Item{DurationMS: 200_000},
+				Device:     spotify.Device{Volume: volume},
+			}, nil
+		},
+		SeekFn: func(_ context.Context, position int) error {
+			*seeked = position
+			return nil
+		},
+		VolumeFn: func(_ context.Context, level int) error {
+			*set = level
+			return nil
+		},
+	}
+}
+
+func TestSeekCmdRelativeAndPercent(t *testing.T) {
+	cases := map[string]int{
+		"+15s":  75_000,
+		"-1:00": 0,
+		"-2m":   0,
+		"+500s": 200_000,
+		"50%":   100_000,
+		"90s":   90_000,
+		"1500":  1500,
+	}
+	for input, want := range cases {
+		ctx, _, _ := testutil.NewTestContext(t, output.FormatPlain)
+		seeked, set := -1, -1
+		ctx.SetSpotify(adjustMock(40, &seeked, &set))
+		if err := (&SeekCmd{Position: input}).Run(ctx); err != nil {
+			t.Fatalf("seek %q: %v", input, err)
+		}
+		if seeked != want {
+			t.Fatalf("seek %q = %d, want %d", input, seeked, want)
+		}
+	}
+}
+
+func TestSeekCmdRelativeErrors(t *testing.T) {
+	ctx, _, _ := testutil.NewTestContext(t, output.FormatPlain)
+	ctx.SetSpotify(&testutil.SpotifyMock{
+		PlaybackFn: func(context.Context) (spotify.PlaybackStatus, error) {
+			return spotify.PlaybackStatus{}, nil
+		},
+	})
+	if err := (&SeekCmd{Position: "50%"}).Run(ctx); err == nil {
+		t.Fatalf("expected error without duration")
+	}
+	for _, input := range []string{"150%", "x%", "+abc", "+-5", "-1:2:3", "1h2x"} {
+		if _, err := parseSeekTarget(input); err == nil {
+			t.Fatalf("expected error for %q", input)
+		}
+	}
+	ctx.SetSpotify(&testutil.SpotifyMock{
+		PlaybackFn: func(context.Context) (spotify.PlaybackStatus, error) {
+			return spotify.PlaybackStatus{}, errors.New("boom")
+		},
+	})
+	if err := (&SeekCmd{Position: "+5s"}).Run(ctx); err == nil || err.Error() != "boom" {
+		t.Fatalf("expected boom, got %v", err)
+	}
+}
+
+func TestVolumeCmdRelative(t *testing.T) {
+	cases := map[string]int{"+5": 45, "-10": 30, "+80": 100, "-60": 0, "70%": 70}
+	for input, want := range cases {
+		ctx, _, _ := testutil.NewTestContext(t, output.FormatPlain)
+		seeked, set := -1, -1
+		ctx.SetSpotify(adjustMock(40, &seeked, &set))
+		if err := (&VolumeSetCmd{Level: input}).Run(ctx); err != nil {
+			t.Fatalf("volume %q: %v", input, err)
+		}
+		if set != want {
+			t.Fatalf("volume %q = %d, want %d", input, set, want)
+		}
+	}
+	ctx, _, _ := testutil.NewTestContext(t, output.FormatPlain)
+	if err := (&VolumeSetCmd{Level: "loud"}).Run(ctx); err == nil {
+		t.Fatalf("expected parse error")
+	}
+	ctx.SetSpotify(&testutil.SpotifyMock{
+		PlaybackFn: func(context.Context) (spotify.PlaybackStatus, error) {
+			return spotify.PlaybackStatus{}, errors.New("boom")
+		},
+	})
+	if err := (&VolumeSetCmd{Level: "+5"}).Run(ctx); err == nil || err.Error() != "boom" {
+		t.Fatalf("expected boom, got %v", err)
+	}
+}
+
+func TestVolumeMuteUnmute(t *testing.T) {
+	ctx, out, _ := testutil.NewTestContext(t, output.FormatHuman)
+	ctx.ConfigPath = filepath.Join(t.TempDir(), "config.toml")
+	ctx.ProfileKey = "default"
+	seeked, set := -1, -1
+	ctx.SetSpotify(adjustMock(35, &seeked, &set))
+	if err := (&VolumeSetCmd{Level: "mute"}).Run(ctx); err != nil {
+		t.Fatalf("mute: %v", err)
+	}
+	if set != 0 || !strings.Contains(out.String(), "Muted (was 35)") {
+		t.Fatalf("set %d out %q", set, out.String())
+	}
+	st, err := state.Read(ctx.ResolveStatePath())
+	if err != nil || st.MutedVolume != 35 {
+		t.Fatalf("state %#v %v", st, err)
+	}
+
+	// Muting again at volume 0 keeps the remembered level.
+	ctx.SetSpotify(adjustMock(0, &seeked, &set))
+	if err := (&VolumeSetCmd{Level: "Mute"}).Run(ctx); err != nil {
+		t.Fatalf("mute again: %v", err)
+	}
+	if err := (&VolumeSetCmd{Level: "unmute"}).Run(ctx); err != nil {
+		t.Fatalf("unmute: %v", err)
+	}
+	if set != 35 {
+		t.Fatalf("unmute set %d", set)
+	}
+	if err := (&VolumeSetCmd{Level: "unmute"}).Run(ctx); err == nil {
+		t.Fatalf("expected error with nothing to restore")
+	}
+}
+
+func TestVolumeMuteErrors(t *testing.T) {
+	ctx, _, _ := testutil.NewTestContext(t, output.FormatPlain)
+	seeked, set := -1, -1
+	ctx.SetSpotify(adjustMock(35, &seeked, &set))
+	if err := (&VolumeSetCmd{Level: "mute"}).Run(ctx); err == nil {
+		t.Fatalf("expected state path error")
+	}
+	if err := (&VolumeSetCmd{Level: "unmute"}).Run(ctx); err == nil {
+		t.Fatalf("expected state path error")
+	}
+	if set != -1 {
+		t.Fatalf("volume changed to %d", set)
+	}
+	ctx.ConfigPath = filepath.Join(t.TempDir(), "config.toml")
+	ctx.SetSpotify(&testutil.SpotifyMock{
+		PlaybackFn: func(context.Context) (spotify.PlaybackStatus, error) {
+			return spotify.PlaybackStatus{}, errors.New("boom")
+		},
+	})
+	if err := (&VolumeSetCmd{Level: "mute"}).Run(ctx); err == nil || err.Error() != "boom" {
+		t.Fatalf("expected boom, got %v", err)
+	}
+	if err := state.Write(ctx.ResolveStatePath(), state.State{MutedVolume: 20}); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	ctx.SetSpotify(&testutil.SpotifyMock{
+		VolumeFn: func(context.Context, int) error { return errors.New("offline") },
+	})
+	if err := (&VolumeSetCmd{Level: "unmute"}).Run(ctx); err == nil || err.Error() != "offline" {
+		t.Fatalf("expected offline, got %v", err)
+	}
+	if st, _ := state.Read(ctx.ResolveStatePath()); st.MutedVolume != 20 {
+		t.Fatalf("state cleared after failed unmute: %#v", st)
+	}
+}
@@ -77,7 +77,7 @@ func TestVolumeCmdError(t *testing.T) {
 		},
 	}
 	ctx.SetSpotify(mock)
-	cmd := VolumeCmd{Level: 25}
+	cmd := VolumeSetCmd{Level: "25"}
 	if err := cmd.Run(ctx); err == nil {
 		t.Fatalf("expected error")
 	}
@@ -83,7 +83,7 @@ func TestVolumeCmd(t *testing.T) {
 		},
 	}
 	ctx.SetSpotify(mock)
-	cmd := VolumeCmd{Level: 50}
+	cmd := VolumeSetCmd{Level: "50"}
 	if err := cmd.Run(ctx); err != nil {
 		t.Fatalf("run: %v", err)
 	}
@@ -185,7 +185,7 @@ func TestPlayCmdMissingType(t *testing.T) {
 
 func TestVolumeCmdInvalid(t *testing.T) {
 	ctx, _, _ := testutil.NewTestContext(t, output.FormatPlain)
-	cmd := VolumeCmd{Level: 200}
+	cmd := VolumeSetCmd{Level: "200"}
 	if err := cmd.Run(ctx); err == nil {
 		t.Fatalf("expected error")
 	}
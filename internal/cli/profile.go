@@ -0,0 +1,351 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+	"github.com/steipete/spogo/internal/app"
+	"github.com/steipete/spogo/internal/config"
+	"github.com/steipete/spogo/internal/secrets"
+)
+
+type ProfileCmd struct {
+	List   ProfileListCmd   `kong:"cmd,help='List profiles.'"`
+	Show   ProfileShowCmd   `kong:"cmd,help='Show a profile.'"`
+	Use    ProfileUseCmd    `kong:"cmd,help='Make a profile the default.'"`
+	Create ProfileCreateCmd `kong:"cmd,help='Create an empty profile.'"`
+	Copy   ProfileCopyCmd   `kong:"cmd,help='Copy a profile.'"`
+	Rename ProfileRenameCmd `kong:"cmd,help='Rename a profile and its files.'"`
+	Delete ProfileDeleteCmd `kong:"cmd,help='Delete a profile and its files.'"`
+}
+
+type ProfileListCmd struct{}
+
+type ProfileShowCmd struct {
+	Name string `arg:"" optional:"" help:"Profile name (default: the active profile)."`
+}
+
+type ProfileUseCmd struct {
+	Name string `arg:"" required:"" help:"Profile name."`
+}
+
+type ProfileCreateCmd struct {
+	Name string `arg:"" required:"" help:"Profile name."`
+	Use  bool   `help:"Also make it the default profile."`
+}
+
+type ProfileCopyCmd struct {
+	From    string `arg:"" required:"" help:"Source profile."`
+	To      string `arg:"" required:"" help:"New profile."`
+	Cookies bool   `help:"Also copy the cookie file, so the copy stays logged in."`
+}
+
+type ProfileRenameCmd struct {
+	From string `arg:"" required:"" help:"Current name."`
+	To   string `arg:"" required:"" help:"New name."`
+}
+
+type ProfileDeleteCmd struct {
+	Name string `arg:"" required:"" help:"Profile name."`
+}
+
+func (cmd *ProfileListCmd) Run(ctx *app.Context) error {
+	cfg := ctx.Config
+	type entry struct {
+		Name    string `json:"name"`
+		Default bool   `json:"default"`
+		Engine  string `json:"engine,omitempty"`
+		Market  string `json:"market,omitempty"`
+		Cookies bool   `json:"cookies"`
+	}
+	entries := []entry{}
+	plain := []string{}
+	human := []string{}
+	for _, name := range cfg.ProfileNames() {
+		profile := cfg.Profile(name)
+		e := entry{
+			Name:    name,
+			Default: name == cfg.DefaultProfile,
+			Engine:  profile.Engine,
+			Market:  profile.Market,
+			Cookies: profileHasCookies(ctx, name, profile),
+		}
+		entries = append(entries, e)
+		plain = append(plain, fmt.Sprintf("%s\t%t\t%s\t%t", e.Name, e.Default, e.Engine, e.Cookies))
+		marker := " "
+		if e.Default {
+			marker = ctx.Output.Theme.Success("*")
+		}
+		details := []string{}
+		if e.Engine != "" {
+			details = append(details, "engine "+e.Engine)
+		}
+		if e.Market != "" {
+			details = append(details, "market "+e.Market)
+		}
+		if !e.Cookies {
+			details = append(details, "no cookies")
+		}
+		line := marker + " " + e.Name
+		if len(details) > 0 {
+			line += " " + ctx.Output.Theme.Muted("("+strings.Join(details, ", ")+")")
+		}
+		human = append(human, line)
+	}
+	return ctx.Output.Emit(map[string]any{"default": cfg.DefaultProfile, "profiles": entries}, plain, human)
+}
+
+func (cmd *ProfileShowCmd) Run(ctx *app.Context) error {
+	name := cmd.Name
+	if name == "" {
+		name = ctx.ProfileKey
+	}
+	if !ctx.Config.HasProfile(name) {
+		return unknownProfile(name)
+	}
+	profile := ctx.Config.Profile(name)
+	data, err := toml.Marshal(profile)
+	if err != nil {
+		return err
+	}
+	settings := map[string]any{}
+	if err := toml.Unmarshal(data, &settings); err != nil {
+		return err
+	}
+	payload := map[string]any{
+		"name":        name,
+		"default":     name == ctx.Config.DefaultProfile,
+		"settings":    settings,
+		"cookie_path": profileCookiePath(ctx.ConfigPath, name, profile),
+		"cache_path":  config.CachePath(ctx.ConfigPath, name),
+		"state_path":  config.StatePath(ctx.ConfigPath, name),
+	}
+	if isKeyringProfile(profile) {
+		delete(payload, "cookie_path")
+		delete(payload, "cache_path")
+		payload["cookie_key"] = secrets.CookieKey(name)
+		payload["cache_key"] = secrets.CacheKey(name)
+	}
+	lines := []string{}
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		// Unset scalar settings marshal as `key = ''`; they add nothing here.
+		if !strings.HasSuffix(line, " = ''") {
+			lines = append(lines, line)
+		}
+	}
+	header := ctx.Output.Theme.Bold(name)
+	if name == ctx.Config.DefaultProfile {
+		header += " " + ctx.Output.Theme.Muted("(default)")
+	}
+	return ctx.Output.Emit(payload, lines, append([]string{header}, lines...))
+}
+
+func (cmd *ProfileUseCmd) Run(ctx *app.Context) error {
+	if !ctx.Config.HasProfile(cmd.Name) {
+		return unknownProfile(cmd.Name)
+	}
+	ctx.Config.DefaultProfile = cmd.Name
+	if err := config.Save(ctx.ConfigPath, ctx.Config); err != nil {
+		return err
+	}
+	return emitOK(ctx, map[string]any{"status": "ok", "default": cmd.Name}, fmt.Sprintf("Default profile is now %s", cmd.Name))
+}
+
+func (cmd *ProfileCreateCmd) Run(ctx *app.Context) error {
+	if err := checkNewProfile(ctx, cmd.Name); err != nil {
+		return err
+	}
+	ctx.Config.SetProfile(cmd.Name, config.Profile{})
+	if cmd.Use {
+		ctx.Config.DefaultProfile = cmd.Name
+	}
+	if err := config.Save(ctx.ConfigPath, ctx.Config); err != nil {
+		return err
+	}
+	return emitOK(ctx, map[string]any{"status": "ok", "profile": cmd.Name, "default": ctx.Config.DefaultProfile},
+		fmt.Sprintf("Created profile %s; run spogo --profile %s auth import to log in", cmd.Name, cmd.Name))
+}
+
+func (cmd *ProfileCopyCmd) Run(ctx *app.Context) error {
+	if !ctx.Config.HasProfile(cmd.From) {
+		return unknownProfile(cmd.From)
+	}
+	if err := checkNewProfile(ctx, cmd.To); err != nil {
+		return err
+	}
+	source := ctx.Config.Profile(cmd.From)
+	profile := source
+	// Both profiles pointing at one cookie file would make them the same
+	// account; the copy gets its own file or none.
+	profile.CookiePath = ""
+	profile.Alarms = append([]config.Alarm(nil), source.Alarms...)
+	if cmd.Cookies && isKeyringProfile(source) {
+		data, err := ctx.Secrets().Get(secrets.CookieKey(cmd.From))
+		if errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("profile %s has no cookies in the keyring to copy", cmd.From)
+		}
+		if err != nil {
+			return err
+		}
+		if err := ctx.Secrets().Set(secrets.CookieKey(cmd.To), data); err != nil {
+			return err
+		}
+	} else if cmd.Cookies {
+		src := profileCookiePath(ctx.ConfigPath, cmd.From, source)
+		dst := config.CookiePath(ctx.ConfigPath, cmd.To)
+		if !config.FileExists(src) {
+			return fmt.Errorf("profile %s has no cookie file to copy", cmd.From)
+		}
+		if err := config.CopyFile(src, dst); err != nil {
+			return err
+		}
+		profile.CookiePath = dst
+	}
+	ctx.Config.SetProfile(cmd.To, profile)
+	if err := config.Save(ctx.ConfigPath, ctx.Config); err != nil {
+		return err
+	}
+	return emitOK(ctx, map[string]any{"status": "ok", "profile": cmd.To, "from": cmd.From, "cookies": cmd.Cookies},
+		fmt.Sprintf("Copied profile %s to %s", cmd.From, cmd.To))
+}
+
+func (cmd *ProfileRenameCmd) Run(ctx *app.Context) error {
+	if !ctx.Config.HasProfile(cmd.From) {
+		return unknownProfile(cmd.From)
+	}
+	if err := checkNewProfile(ctx, cmd.To); err != nil {
+		return err
+	}
+	if err := config.MoveProfileFiles(ctx.ConfigPath, cmd.From, cmd.To); err != nil {
+		return err
+	}
+	profile := ctx.Config.Profile(cmd.From)
+	keyring := isKeyringProfile(profile)
+	if keyring {
+		if err := moveProfileSecrets(ctx.Secrets(), cmd.From, cmd.To); err != nil {
+			_ = config.MoveProfileFiles(ctx.ConfigPath, cmd.To, cmd.From)
+			return err
+		}
+	}
+	stored, hadStored := ctx.Config.Profiles[cmd.From]
+	defaultProfile := ctx.Config.DefaultProfile
+	renamed := profile
+	if renamed.CookiePath == config.CookiePath(ctx.ConfigPath, cmd.From) {
+		renamed.CookiePath = config.CookiePath(ctx.ConfigPath, cmd.To)
+	}
+	delete(ctx.Config.Profiles, cmd.From)
+	ctx.Config.SetProfile(cmd.To, renamed)
+	if ctx.Config.DefaultProfile == cmd.From {
+		ctx.Config.DefaultProfile = cmd.To
+	}
+	if err := config.Save(ctx.ConfigPath, ctx.Config); err != nil {
+		// The config on disk still names the old profile; put its files back.
+		delete(ctx.Config.Profiles, cmd.To)
+		if hadStored {
+			ctx.Config.SetProfile(cmd.From, stored)
+		}
+		ctx.Config.DefaultProfile = defaultProfile
+		if keyring {
+			_ = moveProfileSecrets(ctx.Secrets(), cmd.To, cmd.From)
+		}
+		_ = config.MoveProfileFiles(ctx.ConfigPath, cmd.To, cmd.From)
+		return err
+	}
+	return emitOK(ctx, map[string]any{"status": "ok", "profile": cmd.To, "from": cmd.From},
+		fmt.Sprintf("Renamed profile %s to %s", cmd.From, cmd.To))
+}
+
+func (cmd *ProfileDeleteCmd) Run(ctx *app.Context) error {
+	if !ctx.Config.HasProfile(cmd.Name) {
+		return unknownProfile(cmd.Name)
+	}
+	if cmd.Name == ctx.Config.DefaultProfile {
+		return fmt.Errorf("%s is the default profile; run spogo profile use <other> first", cmd.Name)
+	}
+	if isKeyringProfile(ctx.Config.Profile(cmd.Name)) {
+		for _, key := range []string{secrets.CookieKey(cmd.Name), secrets.CacheKey(cmd.Name)} {
+			if err := ctx.Secrets().Delete(key); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return err
+			}
+		}
+	}
+	files := config.ProfileFiles(ctx.ConfigPath, cmd.Name)
+	// Cookies go to the Trash, like auth clear; the cache and state are
+	// rebuilt on demand and are simply removed.
+	if config.FileExists(files[0]) {
+		if err := trashFile(files[0]); err != nil {
+			return err
+		}
+	}
+	for _, path := range files[1:] {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	delete(ctx.Config.Profiles, cmd.Name)
+	if err := config.Save(ctx.ConfigPath, ctx.Config); err != nil {
+		return err
+	}
+	return emitOK(ctx, map[string]any{"status": "ok", "profile": cmd.Name}, fmt.Sprintf("Deleted profile %s", cmd.Name))
+}
+
+func checkNewProfile(ctx *app.Context, name string) error {
+	if err := config.ValidateProfileName(name); err != nil {
+		return err
+	}
+	if ctx.Config.HasProfile(name) {
+		return fmt.Errorf("profile %s already exists", name)
+	}
+	return nil
+}
+
+func unknownProfile(name string) error {
+	return fmt.Errorf("unknown profile %q (see spogo profile list)", name)
+}
+
+// profileCookiePath is the cookie file a profile reads: its configured
+// cookie_path, or the default location next to the config.
+func profileCookiePath(configPath, name string, profile config.Profile) string {
+	if path := strings.TrimSpace(profile.CookiePath); path != "" {
+		return path
+	}
+	return config.CookiePath(configPath, name)
+}
+
+func isKeyringProfile(profile config.Profile) bool {
+	return strings.EqualFold(strings.TrimSpace(profile.CookieStore), "keyring")
+}
+
+func profileHasCookies(ctx *app.Context, name string, profile config.Profile) bool {
+	if isKeyringProfile(profile) {
+		return ctx.Secrets().Has(secrets.CookieKey(name))
+	}
+	return config.FileExists(profileCookiePath(ctx.ConfigPath, name, profile))
+}
+
+// moveProfileSecrets re-keys a profile's keyring entries; a missing entry
+// (nothing imported yet, no cache) is skipped.
+func moveProfileSecrets(store secrets.Store, from, to string) error {
+	for _, keys := range [][2]string{
+		{secrets.CookieKey(from), secrets.CookieKey(to)},
+		{secrets.CacheKey(from), secrets.CacheKey(to)},
+	} {
+		data, err := store.Get(keys[0])
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if err := store.Set(keys[1], data); err != nil {
+			return err
+		}
+		if err := store.Delete(keys[0]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steipete/spogo/internal/app"
+	"github.com/steipete/spogo/internal/config"
+	"github.com/steipete/spogo/internal/output"
+	"github.com/steipete/spogo/internal/testutil"
+)
+
+func profileContext(t *testing.T, format output.Format, dir string) (*app.Context, *bytes.Buffer) {
+	t.Helper()
+	ctx, out, _ := testutil.NewTestContext(t, format)
+	ctx.ConfigPath = filepath.Join(dir, "config.toml")
+	cfg, err := config.Load(ctx.ConfigPath)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	ctx.Config = cfg
+	ctx.ProfileKey = cfg.DefaultProfile
+	return ctx, out
+}
+
+func writeProfileFile(t *testing.T, path string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(path, []byte("[]"), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+}
+
+func TestProfileLifecycle(t *testing.T) {
+	dir := t.TempDir()
+	ctx, _ := profileContext(t, output.FormatPlain, dir)
+	ctx.Config.SetProfile("default", config.Profile{Engine: "connect", Market: "DE"})
+	writeProfileFile(t, config.CookiePath(ctx.ConfigPath, "default"))
+
+	if err := (&ProfileCreateCmd{Name: "bad/name"}).Run(ctx); err == nil {
+		t.Fatalf("expected invalid name")
+	}
+	if err := (&ProfileCreateCmd{Name: "default"}).Run(ctx); err == nil {
+		t.Fatalf("expected duplicate error")
+	}
+	if err := (&ProfileCreateCmd{Name: "empty"}).Run(ctx); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if err := (&ProfileCopyCmd{From: "empty", To: "x", Cookies: true}).Run(ctx); err == nil {
+		t.Fatalf("expected missing cookies error")
+	}
+	if err := (&ProfileCopyCmd{From: "default", To: "work", Cookies: true}).Run(ctx); err != nil {
+		t.Fatalf("copy: %v", err)
+	}
+	if err := (&ProfileRenameCmd{From: "work", To: "job"}).Run(ctx); err != nil {
+		t.Fatalf("rename: %v", err)
+	}
+	if err := (&ProfileUseCmd{Name: "job"}).Run(ctx); err != nil {
+		t.Fatalf("use: %v", err)
+	}
+	if err := (&ProfileUseCmd{Name: "missing"}).Run(ctx); err == nil {
+		t.Fatalf("expected unknown profile")
+	}
+
+	loaded, err := config.Load(ctx.ConfigPath)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	job := loaded.Profile("job")
+	if loaded.DefaultProfile != "job" || loaded.HasProfile("work") || job.Engine != "connect" || job.Market != "DE" {
+		t.Fatalf("config %#v", loaded)
+	}
+	if job.CookiePath != config.CookiePath(ctx.ConfigPath, "job") || !config.FileExists(job.CookiePath) {
+		t.Fatalf("cookie path %q", job.CookiePath)
+	}
+
+	ctx, out := profileContext(t, output.FormatPlain, dir)
+	if err := (&ProfileListCmd{}).Run(ctx); err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	want := "default\tfalse\tconnect\ttrue\nempty\tfalse\t\tfalse\njob\ttrue\tconnect\ttrue\n"
+	if out.String() != want {
+		t.Fatalf("list %q", out.String())
+	}
+
+	if err := (&ProfileDeleteCmd{Name: "job"}).Run(ctx); err == nil || !strings.Contains(err.Error(), "default profile") {
+		t.Fatalf("expected default guard, got %v", err)
+	}
+	writeProfileFile(t, config.CachePath(ctx.ConfigPath, "default"))
+	script := filepath.Join(dir, "trash")
+	if err := os.WriteFile(script, []byte("#!/bin/sh\n/bin/rm \"$1\"\n"), 0o755); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	t.Setenv("PATH", dir)
+	if err := (&ProfileDeleteCmd{Name: "default"}).Run(ctx); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	for _, path := range config.ProfileFiles(ctx.ConfigPath, "default") {
+		if config.FileExists(path) {
+			t.Fatalf("%s left behind", path)
+		}
+	}
+	if err := (&ProfileDeleteCmd{Name: "default"}).Run(ctx); err == nil {
+		t.Fatalf("expected unknown profile")
+	}
+}
+
+func TestProfileRenameRollsBackOnSaveError(t *testing.T) {
+	dir := t.TempDir()
+	ctx, _ := profileContext(t, output.FormatPlain, dir)
+	ctx.Config.SetProfile("work", config.Profile{Market: "DE"})
+	writeProfileFile(t, config.CookiePath(ctx.ConfigPath, "work"))
+	if err := os.MkdirAll(ctx.ConfigPath, 0o700); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := (&ProfileRenameCmd{From: "work", To: "job"}).Run(ctx); err == nil {
+		t.Fatalf("expected save error")
+	}
+	if !config.FileExists(config.CookiePath(ctx.ConfigPath, "work")) || config.FileExists(config.CookiePath(ctx.ConfigPath, "job")) {
+		t.Fatalf("cookie file not moved back")
+	}
+	if !ctx.Config.HasProfile("work") || ctx.Config.HasProfile("job") || ctx.Config.DefaultProfile != "default" {
+		t.Fatalf("config %#v", ctx.Config)
+	}
+}
+
+func TestProfileShow(t *testing.T) {
+	dir := t.TempDir()
+	ctx, out := profileContext(t, output.FormatJSON, dir)
+	ctx.Config.SetProfile("default", config.Profile{Engine: "web", Market: "US"})
+	if err := (&ProfileShowCmd{}).Run(ctx); err != nil {
+		t.Fatalf("show: %v", err)
+	}
+	var payload struct {
+		Name       string         `json:"name"`
+		Default    bool           `json:"default"`
+		Settings   map[string]any `json:"settings"`
+		CookiePath string         `json:"cookie_path"`
+	}
+	if err := json.Unmarshal(out.Bytes(), &payload); err != nil {
+		t.Fatalf("json: %v", err)
+	}
+	if payload.Name != "default" || !payload.Default || payload.Settings["engine"] != "web" || payload.CookiePath != config.CookiePath(ctx.ConfigPath, "default") {
+		t.Fatalf("payload %#v", payload)
+	}
+
+	ctx, out = profileContext(t, output.FormatHuman, dir)
+	ctx.Config.SetProfile("default", config.Profile{Market: "US"})
+	if err := (&ProfileShowCmd{Name: "default"}).Run(ctx); err != nil {
+		t.Fatalf("show human: %v", err)
+	}
+	if out.String() != "default (default)\nmarket = 'US'\n" {
+		t.Fatalf("human %q", out.String())
+	}
+	if err := (&ProfileShowCmd{Name: "nope"}).Run(ctx); err == nil {
+		t.Fatalf("expected unknown profile")
+	}
+
+	ctx, out = profileContext(t, output.FormatHuman, dir)
+	if err := (&ProfileListCmd{}).Run(ctx); err != nil || out.String() != "* default (no cookies)\n" {
+		t.Fatalf("list human %q %v", out.String(), err)
+	}
+}
@@ -0,0 +1,139 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/steipete/spogo/internal/app"
+	"github.com/steipete/spogo/internal/output"
+	"github.com/steipete/spogo/internal/spotify"
+)
+
+type RadioCmd struct {
+	Seed string `arg:"" required:"" help:"Track, artist, album or playlist ID/URL/URI."`
+	Type string `help:"Type for raw IDs (track|artist|album|playlist)." default:"track"`
+}
+
+type RecommendCmd struct {
+	SeedTracks   []string `name:"seed-tracks" help:"Comma-separated seed track IDs/URLs/URIs."`
+	SeedArtists  []string `name:"seed-artists" help:"Comma-separated seed artist IDs/URLs/URIs."`
+	SeedGenres   []string `name:"seed-genres" help:"Comma-separated seed genres."`
+	Limit        int      `help:"Limit results (1-100)." default:"20"`
+	IntoPlaylist string   `name:"into-playlist" help:"Also add the tracks to this playlist ID/URL/URI."`
+}
+
+// radioSeedTypes are the seeds Spotify builds a radio from.
+var radioSeedTypes = map[string]bool{
+	"track":    true,
+	"artist":   true,
+	"album":    true,
+	"playlist": true,
+}
+
+func (cmd *RadioCmd) Run(ctx *app.Context) error {
+	res, err := spotify.ParseResource(cmd.Seed)
+	if err != nil {
+		return err
+	}
+	if res.URI == "" {
+		res.Type = cmd.Type
+		res.URI = "spotify:" + cmd.Type + ":" + res.ID
+	}
+	if !radioSeedTypes[res.Type] {
+		return fmt.Errorf("cannot start radio from a %s", res.Type)
+	}
+	client, cmdCtx, err := spotifyClient(ctx)
+	if err != nil {
+		return err
+	}
+	starter, ok := client.(spotify.RadioStarter)
+	if !ok {
+		return errors.New("radio not supported by engine")
+	}
+	radio, err := starter.Radio(cmdCtx, res.URI)
+	if err != nil {
+		return err
+	}
+	if err := client.Play(cmdCtx, radio); err != nil {
+		return err
+	}
+	payload := map[string]any{"status": "ok", "seed": res.URI, "context_uri": radio}
+	return ctx.Output.Emit(payload, []string{radio}, []string{fmt.Sprintf("Radio started for %s", res.URI)})
+}
+
+func (cmd *RecommendCmd) Run(ctx *app.Context) error {
+	seeds := spotify.RecommendationSeeds{Genres: cmd.SeedGenres}
+	var err error
+	if seeds.Tracks, err = seedIDs(cmd.SeedTracks, "track"); err != nil {
+		return err
+	}
+	if seeds.Artists, err = seedIDs(cmd.SeedArtists, "artist"); err != nil {
+		return err
+	}
+	if count := seeds.Count(); count == 0 || count > spotify.MaxRecommendationSeeds {
+		return fmt.Errorf("pass 1-%d seeds across --seed-tracks, --seed-artists and --seed-genres", spotify.MaxRecommendationSeeds)
+	}
+	playlist := spotify.Resource{}
+	if cmd.IntoPlaylist != "" {
+		if playlist, err = spotify.ParseTypedID(cmd.IntoPlaylist, "playlist"); err != nil {
+			return err
+		}
+	}
+	client, cmdCtx, err := spotifyClient(ctx)
+	if err != nil {
+		return err
+	}
+	recommender, ok := client.(spotify.Recommender)
+	if !ok {
+		return errors.New("recommendations not supported by engine")
+	}
+	items, err := recommender.Recommendations(cmdCtx, seeds, recommendLimit(cmd.Limit))
+	if err != nil {
+		return err
+	}
+	plain, human := renderItems(ctx.Output, items)
+	payload := map[string]any{"total": len(items), "items": items, "seeds": seeds}
+	if playlist.ID != "" && len(items) > 0 {
+		uris := make([]string, 0, len(items))
+		for _, item := range items {
+			uris = append(uris, item.URI)
+		}
+		if err := client.AddTracks(cmdCtx, playlist.ID, uris); err != nil {
+			return err
+		}
+		payload["playlist"] = playlist.URI
+		if ctx.Output.Format == output.FormatHuman {
+			human = append(human, ctx.Output.Theme.Success(fmt.Sprintf("Added %d tracks to %s", len(uris), playlist.URI)))
+		}
+	}
+	return ctx.Output.Emit(payload, plain, human)
+}
+
+func seedIDs(inputs []string, kind string) ([]string, error) {
+	ids := make([]string, 0, len(inputs))
+	for _, input := range inputs {
+		input = strings.TrimSpace(input)
+		if input == "" {
+			continue
+		}
+		res, err := spotify.ParseTypedID(input, kind)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, res.ID)
+	}
+	return ids, nil
+}
+
+// recommendLimit is clampLimit with Spotify's higher cap for
+// recommendations.
+func recommendLimit(limit int) int {
+	if limit <= 0 {
+		return 20
+	}
+	if limit > 100 {
+		return 100
+	}
+	return limit
+}
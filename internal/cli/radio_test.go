@@ -0,0 +1,98 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/steipete/spogo/internal/output"
+	"github.com/steipete/spogo/internal/spotify"
+	"github.com/steipete/spogo/internal/testutil"
+)
+
+func TestRadioCmd(t *testing.T) {
+	var seed, played string
+	mock := &testutil.SpotifyMock{
+		RadioFn: func(_ context.Context, seedURI string) (string, error) {
+			seed = seedURI
+			return "spotify:playlist:mix", nil
+		},
+		PlayFn: func(_ context.Context, uri string) error {
+			played = uri
+			return nil
+		},
+	}
+	ctx, out, _ := testutil.NewTestContext(t, output.FormatPlain)
+	ctx.SetSpotify(mock)
+	if err := (&RadioCmd{Seed: "a1", Type: "artist"}).Run(ctx); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if seed != "spotify:artist:a1" || played != "spotify:playlist:mix" || strings.TrimSpace(out.String()) != "spotify:playlist:mix" {
+		t.Fatalf("seed %q played %q out %q", seed, played, out.String())
+	}
+	if err := (&RadioCmd{Seed: "spotify:show:s1"}).Run(ctx); err == nil || !strings.Contains(err.Error(), "cannot start radio") {
+		t.Fatalf("expected type error, got %v", err)
+	}
+	ctx.SetSpotify(struct{ spotify.API }{mock})
+	if err := (&RadioCmd{Seed: "spotify:track:t1"}).Run(ctx); err == nil || !strings.Contains(err.Error(), "not supported") {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+}
+
+func TestRecommendCmd(t *testing.T) {
+	var gotSeeds spotify.RecommendationSeeds
+	var gotLimit int
+	var added []string
+	mock := &testutil.SpotifyMock{
+		RecommendationsFn: func(_ context.Context, seeds spotify.RecommendationSeeds, limit int) ([]spotify.Item, error) {
+			gotSeeds, gotLimit = seeds, limit
+			return []spotify.Item{{ID: "r1", URI: "spotify:track:r1", Name: "Rec", Type: "track"}}, nil
+		},
+		AddTracksFn: func(_ context.Context, playlistID string, uris []string) error {
+			added = append([]string{playlistID}, uris...)
+			return nil
+		},
+	}
+	ctx, out, _ := testutil.NewTestContext(t, output.FormatJSON)
+	ctx.SetSpotify(mock)
+	cmd := &RecommendCmd{
+		SeedTracks:   []string{"spotify:track:t1", " t2"},
+		SeedArtists:  []string{"https://open.spotify.com/artist/a1"},
+		SeedGenres:   []string{"jazz"},
+		Limit:        500,
+		IntoPlaylist: "spotify:playlist:p1",
+	}
+	if err := cmd.Run(ctx); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if strings.Join(gotSeeds.Tracks, ",") != "t1,t2" || strings.Join(gotSeeds.Artists, ",") != "a1" || gotLimit != 100 {
+		t.Fatalf("seeds %#v limit %d", gotSeeds, gotLimit)
+	}
+	if strings.Join(added, " ") != "p1 spotify:track:r1" {
+		t.Fatalf("added %q", added)
+	}
+	var payload struct {
+		Total    int    `json:"total"`
+		Playlist string `json:"playlist"`
+	}
+	if err := json.Unmarshal(out.Bytes(), &payload); err != nil || payload.Total != 1 || payload.Playlist != "spotify:playlist:p1" {
+		t.Fatalf("payload %s %v", out.String(), err)
+	}
+
+	ctx, out, _ = testutil.NewTestContext(t, output.FormatHuman)
+	ctx.SetSpotify(mock)
+	if err := (&RecommendCmd{SeedGenres: []string{"jazz"}, IntoPlaylist: "p1"}).Run(ctx); err != nil || !strings.Contains(out.String(), "Added 1 tracks") {
+		t.Fatalf("human %q %v", out.String(), err)
+	}
+	if err := (&RecommendCmd{}).Run(ctx); err == nil || !strings.Contains(err.Error(), "seeds") {
+		t.Fatalf("expected seed error, got %v", err)
+	}
+	if err := (&RecommendCmd{SeedTracks: []string{"spotify:album:x"}}).Run(ctx); err == nil {
+		t.Fatalf("expected type error")
+	}
+	ctx.SetSpotify(struct{ spotify.API }{mock})
+	if err := (&RecommendCmd{SeedGenres: []string{"jazz"}}).Run(ctx); err == nil || !strings.Contains(err.Error(), "not supported") {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+}
@@ -20,6 +20,14 @@ func renderItems(w *output.Writer, items []spotify.Item) (plain []string, human
 }
 
 func itemPlain(item spotify.Item) string {
+	line := itemPlainFields(item)
+	if item.SavedKnown {
+		line += fmt.Sprintf("\t%t", item.Saved)
+	}
+	return line
+}
+
+func itemPlainFields(item spotify.Item) string {
 	switch item.Type {
 	case "track":
 		return fmt.Sprintf("track\t%s\t%s\t%s\t%s\t%s", item.ID, item.Name, strings.Join(item.Artists, ", "), item.Album, item.URI)
@@ -33,12 +41,25 @@ func itemPlain(item spotify.Item) string {
 		return fmt.Sprintf("show\t%s\t%s\t%s\t%d", item.ID, item.Name, item.Publisher, item.TotalEpisodes)
 	case "episode":
 		return fmt.Sprintf("episode\t%s\t%s\t%d", item.ID, item.Name, item.DurationMS)
+	case "audiobook":
+		return fmt.Sprintf("audiobook\t%s\t%s\t%s\t%s\t%d", item.ID, item.Name, strings.Join(item.Authors, ", "),
+			strings.Join(item.Narrators, ", "), item.TotalChapters)
+	case "chapter":
+		return fmt.Sprintf("chapter\t%s\t%s\t%d\t%d", item.ID, item.Name, item.ChapterNumber, item.DurationMS)
 	default:
 		return fmt.Sprintf("item\t%s\t%s\t%s", item.ID, item.Name, item.URI)
 	}
 }
 
 func itemHuman(w *output.Writer, item spotify.Item) string {
+	line := itemHumanFields(w, item)
+	if item.SavedKnown && item.Saved {
+		line += " " + w.Theme.Success("♥")
+	}
+	return line
+}
+
+func itemHumanFields(w *output.Writer, item spotify.Item) string {
 	accent := w.Theme.Accent
 	muted := w.Theme.Muted
 	switch item.Type {
@@ -57,6 +78,17 @@ func itemHuman(w *output.Writer, item spotify.Item) string {
 		return fmt.Sprintf("%s — %s %s", accent(item.Name), item.Publisher, muted(fmt.Sprintf("· %d episodes", item.TotalEpisodes)))
 	case "episode":
 		return fmt.Sprintf("%s %s", accent(item.Name), muted(fmt.Sprintf("· %s", humanDuration(item.DurationMS))))
+	case "audiobook":
+		line := fmt.Sprintf("%s — %s", accent(item.Name), strings.Join(item.Authors, ", "))
+		if len(item.Narrators) > 0 {
+			line += " " + muted("· read by "+strings.Join(item.Narrators, ", "))
+		}
+		if item.TotalChapters > 0 {
+			line += " " + muted(fmt.Sprintf("· %d chapters", item.TotalChapters))
+		}
+		return line
+	case "chapter":
+		return fmt.Sprintf("%s %s", accent(item.Name), muted(fmt.Sprintf("· %s", humanDuration(item.DurationMS))))
 	default:
 		return accent(item.Name)
 	}
@@ -84,7 +116,11 @@ func playbackPlain(status spotify.PlaybackStatus) string {
 	if status.Item != nil {
 		track = status.Item.Name
 	}
-	return fmt.Sprintf("%t\t%d\t%s\t%s", status.IsPlaying, status.ProgressMS, status.Device.Name, track)
+	line := fmt.Sprintf("%t\t%d\t%s\t%s", status.IsPlaying, status.ProgressMS, status.Device.Name, track)
+	if status.Item != nil && status.Item.SavedKnown {
+		line += fmt.Sprintf("\t%t", status.Item.Saved)
+	}
+	return line
 }
 
 func playbackHuman(w *output.Writer, status spotify.PlaybackStatus) string {
@@ -97,6 +133,9 @@ func playbackHuman(w *output.Writer, status spotify.PlaybackStatus) string {
 	track := ""
 	if status.Item != nil {
 		track = fmt.Sprintf("%s — %s", accent(status.Item.Name), strings.Join(status.Item.Artists, ", "))
+		if status.Item.SavedKnown && status.Item.Saved {
+			track += " " + w.Theme.Success("♥")
+		}
 	}
 	return fmt.Sprintf("%s %s %s", accent(strings.ToUpper(state)), track, muted("· "+status.Device.Name))
 }
@@ -9,18 +9,21 @@ import (
 )
 
 type SearchCmd struct {
-	Track    SearchTrackCmd    `kong:"cmd,help='Search tracks.'"`
-	Album    SearchAlbumCmd    `kong:"cmd,help='Search albums.'"`
-	Artist   SearchArtistCmd   `kong:"cmd,help='Search artists.'"`
-	Playlist SearchPlaylistCmd `kong:"cmd,help='Search playlists.'"`
-	Episode  SearchEpisodeCmd  `kong:"cmd,help='Search episodes.'"`
-	Show     SearchShowCmd     `kong:"cmd,help='Search shows.'"`
+	Track     SearchTrackCmd     `kong:"cmd,help='Search tracks.'"`
+	Album     SearchAlbumCmd     `kong:"cmd,help='Search albums.'"`
+	Artist    SearchArtistCmd    `kong:"cmd,help='Search artists.'"`
+	Playlist  SearchPlaylistCmd  `kong:"cmd,help='Search playlists.'"`
+	Episode   SearchEpisodeCmd   `kong:"cmd,help='Search episodes.'"`
+	Show      SearchShowCmd      `kong:"cmd,help='Search shows.'"`
+	Audiobook SearchAudiobookCmd `kong:"cmd,help='Search audiobooks.'"`
 }
 
 type SearchArgs struct {
-	Query  string `arg:"" required:"" help:"Search query."`
-	Limit  int    `help:"Limit results." default:"20"`
-	Offset int    `help:"Offset results." default:"0"`
+	Query       string `arg:"" required:"" help:"Search query."`
+	Limit       int    `help:"Limit results." default:"20"`
+	Offset      int    `help:"Offset results." default:"0"`
+	Interactive bool   `short:"i" help:"Browse results in an interactive picker (TTY only)."`
+	Saved       bool   `help:"Mark results already in your library."`
 }
 
 type SearchTrackCmd struct{ SearchArgs }
@@ -35,6 +38,8 @@ type SearchEpisodeCmd struct{ SearchArgs }
 
 type SearchShowCmd struct{ SearchArgs }
 
+type SearchAudiobookCmd struct{ SearchArgs }
+
 func (cmd *SearchTrackCmd) Run(ctx *app.Context) error {
 	return runSearch(ctx, "track", cmd.SearchArgs)
 }
@@ -59,7 +64,14 @@ func (cmd *SearchShowCmd) Run(ctx *app.Context) error {
 	return runSearch(ctx, "show", cmd.SearchArgs)
 }
 
+func (cmd *SearchAudiobookCmd) Run(ctx *app.Context) error {
+	return runSearch(ctx, "audiobook", cmd.SearchArgs)
+}
+
 func runSearch(ctx *app.Context, kind string, args SearchArgs) error {
+	if args.Interactive {
+		return runInteractiveSearch(ctx, kind, args)
+	}
 	client, cmdCtx, err := spotifyClient(ctx)
 	if err != nil {
 		return err
@@ -72,6 +84,11 @@ func runSearch(ctx *app.Context, kind string, args SearchArgs) error {
 	if err != nil {
 		return err
 	}
+	if args.Saved {
+		if err := markSaved(cmdCtx, client, res.Items); err != nil {
+			return err
+		}
+	}
 	plain, human := renderItems(ctx.Output, res.Items)
 	header := fmt.Sprintf("%s results: %d", strings.ToUpper(kind), res.Total)
 	if ctx.Output.Format == output.FormatHuman {
@@ -0,0 +1,489 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/mattn/go-isatty"
+	"github.com/steipete/spogo/internal/app"
+	"github.com/steipete/spogo/internal/spotify"
+	"github.com/steipete/spogo/internal/tui"
+)
+
+var pickerDebounce = 250 * time.Millisecond
+
+var interactiveTerminal = func(ctx *app.Context) bool {
+	return !ctx.Settings.NoInput && isatty.IsTerminal(os.Stdout.Fd()) && isatty.IsTerminal(os.Stdin.Fd())
+}
+
+type pickerMode int
+
+const (
+	pickerList pickerMode = iota
+	pickerActionMenu
+	pickerPlaylists
+)
+
+type pickerAction struct {
+	Key   rune
+	Name  string
+	Label string
+}
+
+var pickerActions = []pickerAction{
+	{Key: 'p', Name: "play", Label: "Play"},
+	{Key: 'q', Name: "queue", Label: "Add to queue"},
+	{Key: 's', Name: "save", Label: "Save to library"},
+	{Key: 'a', Name: "playlist", Label: "Add to playlist"},
+	{Key: 'c', Name: "copy", Label: "Copy URI"},
+}
+
+var savePaths = map[string]string{
+	"track":   "/me/tracks",
+	"album":   "/me/albums",
+	"show":    "/me/shows",
+	"episode": "/me/episodes",
+}
+
+type pickerResult struct {
+	Action   string        `json:"action"`
+	Item     spotify.Item  `json:"item"`
+	Playlist *spotify.Item `json:"playlist,omitempty"`
+}
+
+type pickerSearch struct {
+	seq int
+	res spotify.SearchResult
+	err error
+}
+
+// searchPicker is the state behind `search --interactive`. Keys mutate it
+// via handleKey and view renders it, so both run without a terminal in tests.
+type searchPicker struct {
+	ctx    context.Context
+	client spotify.API
+	copy   func(string) error
+	kind   string
+	limit  int
+
+	query     string
+	items     []spotify.Item
+	total     int
+	cursor    int
+	mode      pickerMode
+	action    int
+	playlists []spotify.Item
+	playlist  int
+	status    string
+	loading   bool
+	quit      bool
+	results   []pickerResult
+}
+
+func runInteractiveSearch(ctx *app.Context, kind string, args SearchArgs) error {
+	if !interactiveTerminal(ctx) {
+		return app.WrapExit(2, errors.New("--interactive needs a terminal and is disabled by --no-input, --profiles and --all-profiles"))
+	}
+	client, cmdCtx, err := spotifyClient(ctx)
+	if err != nil {
+		return err
+	}
+	term, err := tui.Open(os.Stdin, ctx.Output.Out)
+	if err != nil {
+		return err
+	}
+	picker := &searchPicker{
+		ctx:    cmdCtx,
+		client: client,
+		copy: func(text string) error {
+			return tui.Copy(term.Writer(), text)
+		},
+		kind:  kind,
+		limit: clampLimit(args.Limit),
+		query: args.Query,
+	}
+	runErr := picker.run(term.Keys(), term.Draw, term.Size)
+	if err := term.Close(); err != nil && runErr == nil {
+		runErr = err
+	}
+	if runErr != nil {
+		return runErr
+	}
+	return emitPickerResults(ctx, picker.results)
+}
+
+func (p *searchPicker) run(keys <-chan tui.Key, draw func([]string), size func() (int, int)) error {
+	done := make(chan struct{})
+	defer close(done)
+	results := make(chan pickerSearch)
+	seq := 0
+	search := func() {
+		seq++
+		if strings.TrimSpace(p.query) == "" {
+			p.items, p.total, p.cursor, p.loading = nil, 0, 0, false
+			return
+		}
+		p.loading = true
+		id, query := seq, p.query
+		go func() {
+			res, err := p.client.Search(p.ctx, p.kind, query, p.limit, 0)
+			select {
+			case results <- pickerSearch{seq: id, res: res, err: err}:
+			case <-done:
+			}
+		}()
+	}
+	search()
+	var debounce <-chan time.Time
+	for !p.quit {
+		draw(p.view(size()))
+		select {
+		case <-p.ctx.Done():
+			return p.ctx.Err()
+		case key, ok := <-keys:
+			if !ok {
+				return nil
+			}
+			if p.handleKey(key) {
+				debounce = time.After(pickerDebounce)
+			}
+		case <-debounce:
+			debounce = nil
+			search()
+		case r := <-results:
+			if r.seq != seq {
+				continue
+			}
+			p.loading = false
+			if r.err != nil {
+				p.status = r.err.Error()
+				continue
+			}
+			p.status = ""
+			p.items, p.total, p.cursor = r.res.Items, r.res.Total, 0
+		}
+	}
+	return nil
+}
+
+// handleKey applies one keypress and reports whether the query changed.
+func (p *searchPicker) handleKey(key tui.Key) bool {
+	if key.IsCtrl('c') {
+		p.quit = true
+		return false
+	}
+	switch p.mode {
+	case pickerActionMenu:
+		p.handleActionKey(key)
+		return false
+	case pickerPlaylists:
+		p.handlePlaylistKey(key)
+		return false
+	}
+	switch {
+	case key.Code == tui.KeyEsc:
+		p.quit = true
+	case key.Code == tui.KeyUp || key.IsCtrl('p'):
+		p.cursor = moveCursor(p.cursor, -1, len(p.items))
+	case key.Code == tui.KeyDown || key.IsCtrl('n'):
+		p.cursor = moveCursor(p.cursor, 1, len(p.items))
+	case key.Code == tui.KeyPageUp:
+		p.cursor = moveCursor(p.cursor, -10, len(p.items))
+	case key.Code == tui.KeyPageDown:
+		p.cursor = moveCursor(p.cursor, 10, len(p.items))
+	case key.Code == tui.KeyEnter:
+		p.perform("play")
+	case key.Code == tui.KeyTab || key.Code == tui.KeyRight:
+		if len(p.items) > 0 {
+			p.mode, p.action = pickerActionMenu, 0
+		}
+	case key.Code == tui.KeyBackspace:
+		if p.query == "" {
+			return false
+		}
+		_, size := utf8.DecodeLastRuneInString(p.query)
+		p.query = p.query[:len(p.query)-size]
+		return true
+	case key.IsCtrl('u'):
+		p.query = ""
+		return true
+	case key.IsCtrl('w'):
+		trimmed := strings.TrimRight(p.query, " ")
+		p.query = trimmed[:strings.LastIndex(trimmed, " ")+1]
+		return true
+	case key.Code == tui.KeyRune:
+		p.query += string(key.Rune)
+		return true
+	}
+	return false
+}
+
+func (p *searchPicker) handleActionKey(key tui.Key) {
+	switch key.Code {
+	case tui.KeyEsc, tui.KeyLeft:
+		p.mode = pickerList
+	case tui.KeyUp:
+		p.action = moveCursor(p.action, -1, len(pickerActions))
+	case tui.KeyDown, tui.KeyTab:
+		p.action = moveCursor(p.action, 1, len(pickerActions))
+	case tui.KeyEnter:
+		p.mode = pickerList
+		p.perform(pickerActions[p.action].Name)
+	case tui.KeyRune:
+		for _, action := range pickerActions {
+			if action.Key == key.Rune {
+				p.mode = pickerList
+				p.perform(action.Name)
+				return
+			}
+		}
+	}
+}
+
+func (p *searchPicker) handlePlaylistKey(key tui.Key) {
+	switch key.Code {
+	case tui.KeyEsc, tui.KeyLeft:
+		p.mode = pickerList
+	case tui.KeyUp:
+		p.playlist = moveCursor(p.playlist, -1, len(p.playlists))
+	case tui.KeyDown:
+		p.playlist = moveCursor(p.playlist, 1, len(p.playlists))
+	case tui.KeyEnter:
+		p.mode = pickerList
+		item, ok := p.selected()
+		if !ok || len(p.playlists) == 0 {
+			return
+		}
+		playlist := p.playlists[p.playlist]
+		if err := p.client.AddTracks(p.ctx, playlist.ID, []string{item.URI}); err != nil {
+			p.status = err.Error()
+			return
+		}
+		p.record(pickerResult{Action: "playlist", Item: item, Playlist: &playlist})
+		p.status = fmt.Sprintf("Added %s to %s", item.Name, playlist.Name)
+	}
+}
+
+func (p *searchPicker) selected() (spotify.Item, bool) {
+	if p.cursor < 0 || p.cursor >= len(p.items) {
+		return spotify.Item{}, false
+	}
+	return p.items[p.cursor], true
+}
+
+func (p *searchPicker) perform(action string) {
+	item, ok := p.selected()
+	if !ok {
+		return
+	}
+	if err := p.apply(action, item); err != nil {
+		p.status = err.Error()
+	}
+}
+
+func (p *searchPicker) apply(action string, item spotify.Item) error {
+	switch action {
+	case "play":
+		res, err := spotify.ParseResource(item.URI)
+		if err != nil {
+			return err
+		}
+		uri, err := playbackURI(p.ctx, p.client, res)
+		if err != nil {
+			return err
+		}
+		if err := startPlayback(p.ctx, p.client, uri, false); err != nil {
+			return err
+		}
+		p.record(pickerResult{Action: action, Item: item})
+		p.quit = true
+		return nil
+	case "queue":
+		if item.Type != "track" && item.Type != "episode" {
+			return fmt.Errorf("cannot queue a %s", item.Type)
+		}
+		if err := p.client.QueueAdd(p.ctx, item.URI); err != nil {
+			return err
+		}
+		p.status = "Queued " + item.Name
+	case "save":
+		if err := saveItem(p.ctx, p.client, item); err != nil {
+			return err
+		}
+		p.status = "Saved " + item.Name
+	case "playlist":
+		if item.Type != "track" && item.Type != "episode" {
+			return fmt.Errorf("cannot add a %s to a playlist", item.Type)
+		}
+		playlists, _, err := p.client.Playlists(p.ctx, 50, 0)
+		if err != nil {
+			return err
+		}
+		if len(playlists) == 0 {
+			return errors.New("no playlists")
+		}
+		p.playlists, p.playlist, p.mode = playlists, 0, pickerPlaylists
+		return nil
+	case "copy":
+		if err := p.copy(item.URI); err != nil {
+			return err
+		}
+		p.status = "Copied " + item.URI
+	default:
+		return fmt.Errorf("unknown action %q", action)
+	}
+	p.record(pickerResult{Action: action, Item: item})
+	return nil
+}
+
+func (p *searchPicker) record(result pickerResult) {
+	p.results = append(p.results, result)
+}
+
+func saveItem(ctx context.Context, client spotify.API, item spotify.Item) error {
+	if item.Type == "artist" {
+		return client.FollowArtists(ctx, []string{item.ID}, "PUT")
+	}
+	path, ok := savePaths[item.Type]
+	if !ok {
+		return fmt.Errorf("cannot save a %s", item.Type)
+	}
+	return client.LibraryModify(ctx, path, []string{item.ID}, "PUT")
+}
+
+func moveCursor(cursor, delta, n int) int {
+	if n == 0 {
+		return 0
+	}
+	cursor += delta
+	if cursor < 0 {
+		return 0
+	}
+	if cursor >= n {
+		return n - 1
+	}
+	return cursor
+}
+
+func (p *searchPicker) view(width, height int) []string {
+	lines := []string{
+		tui.Truncate(fmt.Sprintf("Search %s: %s▏", p.kind, p.query), width),
+		tui.Dim(tui.Pad(p.statusLine(), width)),
+	}
+	rows := height - len(lines) - 1
+	switch p.mode {
+	case pickerActionMenu:
+		item, _ := p.selected()
+		lines = append(lines, tui.Truncate(pickerLabel(item), width))
+		for i, action := range pickerActions {
+			lines = append(lines, pickerRow(fmt.Sprintf("[%c] %s", action.Key, action.Label), i == p.action, width))
+		}
+	case pickerPlaylists:
+		start, end := tui.Window(len(p.playlists), p.playlist, rows)
+		for i := start; i < end; i++ {
+			lines = append(lines, pickerRow(p.playlists[i].Name, i == p.playlist, width))
+		}
+	default:
+		start, end := tui.Window(len(p.items), p.cursor, rows)
+		for i := start; i < end; i++ {
+			lines = append(lines, pickerRow(pickerLabel(p.items[i]), i == p.cursor, width))
+		}
+	}
+	for len(lines) < height-1 {
+		lines = append(lines, "")
+	}
+	return append(lines, tui.Dim(tui.Truncate(p.help(), width)))
+}
+
+func (p *searchPicker) statusLine() string {
+	switch {
+	case p.status != "":
+		return p.status
+	case p.loading:
+		return "searching…"
+	case strings.TrimSpace(p.query) == "":
+		return "type to search"
+	default:
+		return fmt.Sprintf("%d of %d results", len(p.items), p.total)
+	}
+}
+
+func (p *searchPicker) help() string {
+	switch p.mode {
+	case pickerActionMenu:
+		return "↑/↓ move · enter or key to run · esc back"
+	case pickerPlaylists:
+		return "↑/↓ move · enter add · esc back"
+	default:
+		return "↑/↓ move · enter play · tab actions · esc quit"
+	}
+}
+
+func pickerRow(label string, selected bool, width int) string {
+	if selected {
+		return tui.Reverse(tui.Pad("> "+label, width))
+	}
+	return tui.Truncate("  "+label, width)
+}
+
+func pickerLabel(item spotify.Item) string {
+	parts := []string{item.Name}
+	switch item.Type {
+	case "track", "album":
+		if len(item.Artists) > 0 {
+			parts = append(parts, strings.Join(item.Artists, ", "))
+		}
+		if item.Album != "" {
+			parts = append(parts, item.Album)
+		}
+	case "playlist":
+		parts = append(parts, item.Owner)
+	case "show":
+		parts = append(parts, item.Publisher)
+	case "episode", "chapter":
+		parts = append(parts, humanDuration(item.DurationMS))
+	case "audiobook":
+		parts = append(parts, strings.Join(item.Authors, ", "))
+	}
+	out := parts[0]
+	for _, part := range parts[1:] {
+		if part != "" {
+			out += " · " + part
+		}
+	}
+	return out
+}
+
+func emitPickerResults(ctx *app.Context, results []pickerResult) error {
+	plain := make([]string, 0, len(results))
+	human := make([]string, 0, len(results))
+	for _, result := range results {
+		plain = append(plain, result.Action+"\t"+result.Item.URI)
+		human = append(human, pickerSummary(result))
+	}
+	if results == nil {
+		results = []pickerResult{}
+	}
+	return ctx.Output.Emit(map[string]any{"actions": results}, plain, human)
+}
+
+func pickerSummary(result pickerResult) string {
+	name := result.Item.Name
+	switch result.Action {
+	case "play":
+		return "Playing " + name
+	case "queue":
+		return "Queued " + name
+	case "save":
+		return "Saved " + name
+	case "playlist":
+		return fmt.Sprintf("Added %s to %s", name, result.Playlist.Name)
+	default:
+		return "Copied " + result.Item.URI
+	}
+}
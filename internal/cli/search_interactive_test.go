@@ -0,0 +1,405 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steipete/spogo/internal/app"
+	"github.com/steipete/spogo/internal/output"
+	"github.com/steipete/spogo/internal/spotify"
+	"github.com/steipete/spogo/internal/testutil"
+	"github.com/steipete/spogo/internal/tui"
+)
+
+func pickerItems() []spotify.Item {
+	return []spotify.Item{
+		{ID: "t1", URI: "spotify:track:t1", Name: "One More Time", Type: "track", Artists: []string{"Daft Punk"}, Album: "Discovery"},
+		{ID: "a1", URI: "spotify:album:a1", Name: "Discovery", Type: "album", Artists: []string{"Daft Punk"}},
+		{ID: "ar1", URI: "spotify:artist:ar1", Name: "Daft Punk", Type: "artist"},
+		{ID: "p1", URI: "spotify:playlist:p1", Name: "Mix", Type: "playlist", Owner: "me"},
+	}
+}
+
+func newTestPicker(mock *testutil.SpotifyMock) *searchPicker {
+	return &searchPicker{
+		ctx:    context.Background(),
+		client: mock,
+		copy:   func(string) error { return nil },
+		kind:   "track",
+		limit:  20,
+		items:  pickerItems(),
+	}
+}
+
+func TestSearchPickerRunRequeriesAndPlays(t *testing.T) {
+	orig := pickerDebounce
+	pickerDebounce = 0
+	t.Cleanup(func() { pickerDebounce = orig })
+	played := ""
+	mock := &testutil.SpotifyMock{
+		SearchFn: func(_ context.Context, kind, query string, limit, offset int) (spotify.SearchResult, error) {
+			if query == "daftx" {
+				return spotify.SearchResult{Items: []spotify.Item{{URI: "spotify:track:x", Name: "Xtra", Type: "track"}}, Total: 1}, nil
+			}
+			return spotify.SearchResult{Items: pickerItems(), Total: 4}, nil
+		},
+		PlayFn: func(_ context.Context, uri string) error {
+			played = uri
+			return nil
+		},
+	}
+	picker := newTestPicker(mock)
+	picker.items = nil
+	picker.query = "daft"
+	keys := make(chan tui.Key)
+	frames := make(chan string, 256)
+	draw := func(lines []string) { frames <- strings.Join(lines, "\n") }
+	size := func() (int, int) { return 80, 10 }
+	errCh := make(chan error, 1)
+	go func() { errCh <- picker.run(keys, draw, size) }()
+	waitFrame(t, frames, "One More Time")
+	keys <- tui.Key{Code: tui.KeyRune, Rune: 'x'}
+	waitFrame(t, frames, "Xtra")
+	keys <- tui.Key{Code: tui.KeyEnter}
+	if err := <-errCh; err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if played != "spotify:track:x" || len(picker.results) != 1 || picker.results[0].Action != "play" {
+		t.Fatalf("played %q results %#v", played, picker.results)
+	}
+}
+
+func TestSearchPickerRunSearchErrorAndClosedKeys(t *testing.T) {
+	mock := &testutil.SpotifyMock{
+		SearchFn: func(context.Context, string, string, int, int) (spotify.SearchResult, error) {
+			return spotify.SearchResult{}, errors.New("boom")
+		},
+	}
+	picker := newTestPicker(mock)
+	picker.query = "x"
+	keys := make(chan tui.Key)
+	frames := make(chan string, 256)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- picker.run(keys, func(lines []string) { frames <- strings.Join(lines, "\n") }, func() (int, int) { return 40, 8 })
+	}()
+	waitFrame(t, frames, "boom")
+	close(keys)
+	if err := <-errCh; err != nil {
+		t.Fatalf("run: %v", err)
+	}
+}
+
+func TestSearchPickerRunContextDone(t *testing.T) {
+	picker := newTestPicker(&testutil.SpotifyMock{})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	picker.ctx = ctx
+	if err := picker.run(make(chan tui.Key), func([]string) {}, func() (int, int) { return 40, 8 }); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected canceled, got %v", err)
+	}
+}
+
+func waitFrame(t *testing.T, frames <-chan string, want string) {
+	t.Helper()
+	timeout := time.After(2 * time.Second)
+	for {
+		select {
+		case frame := <-frames:
+			if strings.Contains(frame, want) {
+				return
+			}
+		case <-timeout:
+			t.Fatalf("no frame containing %q", want)
+		}
+	}
+}
+
+func TestSearchPickerEditing(t *testing.T) {
+	picker := newTestPicker(&testutil.SpotifyMock{})
+	picker.query = "daft punk"
+	if !picker.handleKey(tui.Key{Code: tui.KeyBackspace}) || picker.query != "daft pun" {
+		t.Fatalf("backspace %q", picker.query)
+	}
+	if !picker.handleKey(tui.Key{Code: tui.KeyCtrl, Rune: 'w'}) || picker.query != "daft " {
+		t.Fatalf("ctrl-w %q", picker.query)
+	}
+	if !picker.handleKey(tui.Key{Code: tui.KeyCtrl, Rune: 'u'}) || picker.query != "" {
+		t.Fatalf("ctrl-u %q", picker.query)
+	}
+	if picker.handleKey(tui.Key{Code: tui.KeyBackspace}) {
+		t.Fatalf("backspace on empty query changed it")
+	}
+	picker.handleKey(tui.Key{Code: tui.KeyDown})
+	picker.handleKey(tui.Key{Code: tui.KeyCtrl, Rune: 'n'})
+	picker.handleKey(tui.Key{Code: tui.KeyPageDown})
+	if picker.cursor != 3 {
+		t.Fatalf("cursor %d", picker.cursor)
+	}
+	picker.handleKey(tui.Key{Code: tui.KeyUp})
+	picker.handleKey(tui.Key{Code: tui.KeyCtrl, Rune: 'p'})
+	if picker.cursor != 1 {
+		t.Fatalf("cursor %d", picker.cursor)
+	}
+	picker.handleKey(tui.Key{Code: tui.KeyPageUp})
+	if picker.cursor != 0 {
+		t.Fatalf("cursor %d", picker.cursor)
+	}
+	picker.handleKey(tui.Key{Code: tui.KeyEsc})
+	if !picker.quit {
+		t.Fatalf("expected quit")
+	}
+}
+
+func TestSearchPickerActions(t *testing.T) {
+	queued, saved, followed, added := "", "", "", ""
+	mock := &testutil.SpotifyMock{
+		QueueAddFn: func(_ context.Context, uri string) error {
+			queued = uri
+			return nil
+		},
+		LibraryModifyFn: func(_ context.Context, path string, ids []string, method string) error {
+			saved = path + ":" + ids[0] + ":" + method
+			return nil
+		},
+		FollowArtistsFn: func(_ context.Context, ids []string, method string) error {
+			followed = ids[0]
+			return nil
+		},
+		PlaylistsFn: func(context.Context, int, int) ([]spotify.Item, int, error) {
+			return []spotify.Item{{ID: "pl1", Name: "Faves"}, {ID: "pl2", Name: "Gym"}}, 2, nil
+		},
+		AddTracksFn: func(_ context.Context, id string, uris []string) error {
+			added = id + ":" + uris[0]
+			return nil
+		},
+	}
+	picker := newTestPicker(mock)
+	copied := ""
+	picker.copy = func(text string) error {
+		copied = text
+		return nil
+	}
+
+	picker.handleKey(tui.Key{Code: tui.KeyTab})
+	if picker.mode != pickerActionMenu {
+		t.Fatalf("mode %v", picker.mode)
+	}
+	if !strings.Contains(strings.Join(picker.view(60, 12), "\n"), "[q] Add to queue") {
+		t.Fatalf("actions view missing")
+	}
+	picker.handleKey(tui.Key{Code: tui.KeyRune, Rune: 'q'})
+	if queued != "spotify:track:t1" || picker.status != "Queued One More Time" {
+		t.Fatalf("queued %q status %q", queued, picker.status)
+	}
+	picker.handleKey(tui.Key{Code: tui.KeyTab})
+	picker.handleKey(tui.Key{Code: tui.KeyDown})
+	picker.handleKey(tui.Key{Code: tui.KeyTab})
+	picker.handleKey(tui.Key{Code: tui.KeyUp})
+	picker.handleKey(tui.Key{Code: tui.KeyDown})
+	picker.handleKey(tui.Key{Code: tui.KeyEnter})
+	if saved != "/me/tracks:t1:PUT" {
+		t.Fatalf("saved %q", saved)
+	}
+	picker.handleKey(tui.Key{Code: tui.KeyTab})
+	picker.handleKey(tui.Key{Code: tui.KeyRune, Rune: 'a'})
+	if picker.mode != pickerPlaylists {
+		t.Fatalf("mode %v status %q", picker.mode, picker.status)
+	}
+	if !strings.Contains(strings.Join(picker.view(60, 12), "\n"), "Gym") {
+		t.Fatalf("playlist view missing")
+	}
+	picker.handleKey(tui.Key{Code: tui.KeyDown})
+	picker.handleKey(tui.Key{Code: tui.KeyUp})
+	picker.handleKey(tui.Key{Code: tui.KeyDown})
+	picker.handleKey(tui.Key{Code: tui.KeyEnter})
+	if added != "pl2:spotify:track:t1" || picker.status != "Added One More Time to Gym" {
+		t.Fatalf("added %q status %q", added, picker.status)
+	}
+	picker.handleKey(tui.Key{Code: tui.KeyTab})
+	picker.handleKey(tui.Key{Code: tui.KeyRune, Rune: 'c'})
+	if copied != "spotify:track:t1" {
+		t.Fatalf("copied %q", copied)
+	}
+
+	picker.cursor = 2
+	picker.handleKey(tui.Key{Code: tui.KeyTab})
+	picker.handleKey(tui.Key{Code: tui.KeyRune, Rune: 's'})
+	if followed != "ar1" {
+		t.Fatalf("followed %q", followed)
+	}
+	picker.handleKey(tui.Key{Code: tui.KeyTab})
+	picker.handleKey(tui.Key{Code: tui.KeyRune, Rune: 'q'})
+	if picker.status != "cannot queue a artist" {
+		t.Fatalf("status %q", picker.status)
+	}
+	picker.handleKey(tui.Key{Code: tui.KeyTab})
+	picker.handleKey(tui.Key{Code: tui.KeyRune, Rune: 'a'})
+	if picker.status != "cannot add a artist to a playlist" {
+		t.Fatalf("status %q", picker.status)
+	}
+	picker.cursor = 3
+	picker.handleKey(tui.Key{Code: tui.KeyTab})
+	picker.handleKey(tui.Key{Code: tui.KeyRune, Rune: 's'})
+	if picker.status != "cannot save a playlist" {
+		t.Fatalf("status %q", picker.status)
+	}
+
+	picker.handleKey(tui.Key{Code: tui.KeyTab})
+	picker.handleKey(tui.Key{Code: tui.KeyRune, Rune: 'z'})
+	picker.handleKey(tui.Key{Code: tui.KeyLeft})
+	if picker.mode != pickerList {
+		t.Fatalf("mode %v", picker.mode)
+	}
+	if len(picker.results) != 5 {
+		t.Fatalf("results %#v", picker.results)
+	}
+}
+
+func TestSearchPickerActionErrors(t *testing.T) {
+	mock := &testutil.SpotifyMock{
+		PlaylistsFn: func(context.Context, int, int) ([]spotify.Item, int, error) {
+			return nil, 0, nil
+		},
+		PlayFn: func(context.Context, string) error {
+			return errors.New("no device")
+		},
+		AddTracksFn: func(context.Context, string, []string) error {
+			return errors.New("boom")
+		},
+	}
+	picker := newTestPicker(mock)
+	picker.copy = func(string) error { return errors.New("no clipboard") }
+	picker.handleKey(tui.Key{Code: tui.KeyEnter})
+	if picker.quit || picker.status != "no device" {
+		t.Fatalf("quit %v status %q", picker.quit, picker.status)
+	}
+	picker.perform("playlist")
+	if picker.status != "no playlists" {
+		t.Fatalf("status %q", picker.status)
+	}
+	picker.perform("copy")
+	if picker.status != "no clipboard" {
+		t.Fatalf("status %q", picker.status)
+	}
+	picker.mode, picker.playlists = pickerPlaylists, []spotify.Item{{ID: "pl1"}}
+	picker.handleKey(tui.Key{Code: tui.KeyEnter})
+	if picker.status != "boom" {
+		t.Fatalf("status %q", picker.status)
+	}
+	picker.handleKey(tui.Key{Code: tui.KeyRune, Rune: 'x'})
+	picker.mode = pickerPlaylists
+	picker.handleKey(tui.Key{Code: tui.KeyEsc})
+	if picker.mode != pickerList {
+		t.Fatalf("mode %v", picker.mode)
+	}
+	picker.perform("bogus")
+	if picker.status != `unknown action "bogus"` {
+		t.Fatalf("status %q", picker.status)
+	}
+	picker.items = nil
+	picker.perform("play")
+	picker.handleKey(tui.Key{Code: tui.KeyTab})
+	if picker.mode != pickerList {
+		t.Fatalf("tab with no items opened actions")
+	}
+	picker.handleKey(tui.Key{Code: tui.KeyCtrl, Rune: 'c'})
+	if !picker.quit {
+		t.Fatalf("expected quit")
+	}
+}
+
+func TestSearchPickerView(t *testing.T) {
+	picker := newTestPicker(&testutil.SpotifyMock{})
+	picker.query = "daft"
+	picker.total = 40
+	lines := picker.view(50, 8)
+	if len(lines) != 8 {
+		t.Fatalf("lines %d", len(lines))
+	}
+	if !strings.HasPrefix(lines[0], "Search track: daft") || !strings.Contains(lines[1], "4 of 40 results") {
+		t.Fatalf("header %q", lines[:2])
+	}
+	if !strings.Contains(lines[2], "> One More Time · Daft Punk · Discovery") {
+		t.Fatalf("row %q", lines[2])
+	}
+	if !strings.Contains(lines[7], "enter play") {
+		t.Fatalf("help %q", lines[7])
+	}
+	picker.loading = true
+	if picker.statusLine() != "searching…" {
+		t.Fatalf("status %q", picker.statusLine())
+	}
+	picker.query = " "
+	picker.loading = false
+	if picker.statusLine() != "type to search" {
+		t.Fatalf("status %q", picker.statusLine())
+	}
+	picker.mode = pickerPlaylists
+	if !strings.Contains(picker.help(), "enter add") {
+		t.Fatalf("help %q", picker.help())
+	}
+}
+
+func TestPickerLabel(t *testing.T) {
+	cases := map[string]spotify.Item{
+		"Show · Pub":   {Name: "Show", Type: "show", Publisher: "Pub"},
+		"Ep · 1m00s":   {Name: "Ep", Type: "episode", DurationMS: 60000},
+		"Mix · me":     {Name: "Mix", Type: "playlist", Owner: "me"},
+		"Daft Punk":    {Name: "Daft Punk", Type: "artist"},
+		"Single · Foo": {Name: "Single", Type: "track", Artists: []string{"Foo"}},
+	}
+	for want, item := range cases {
+		if got := pickerLabel(item); got != want {
+			t.Fatalf("got %q want %q", got, want)
+		}
+	}
+}
+
+func TestEmitPickerResults(t *testing.T) {
+	ctx, out, _ := testutil.NewTestContext(t, output.FormatHuman)
+	item := spotify.Item{Name: "One", URI: "spotify:track:1"}
+	playlist := spotify.Item{Name: "Gym"}
+	results := []pickerResult{
+		{Action: "queue", Item: item},
+		{Action: "save", Item: item},
+		{Action: "playlist", Item: item, Playlist: &playlist},
+		{Action: "copy", Item: item},
+		{Action: "play", Item: item},
+	}
+	if err := emitPickerResults(ctx, results); err != nil {
+		t.Fatalf("emit: %v", err)
+	}
+	for _, want := range []string{"Queued One", "Saved One", "Added One to Gym", "Copied spotify:track:1", "Playing One"} {
+		if !strings.Contains(out.String(), want) {
+			t.Fatalf("missing %q in %q", want, out.String())
+		}
+	}
+	jsonCtx, jsonOut, _ := testutil.NewTestContext(t, output.FormatJSON)
+	if err := emitPickerResults(jsonCtx, nil); err != nil {
+		t.Fatalf("emit: %v", err)
+	}
+	if !strings.Contains(jsonOut.String(), `"actions": []`) {
+		t.Fatalf("json %q", jsonOut.String())
+	}
+}
+
+func TestSearchInteractiveRequiresTerminal(t *testing.T) {
+	ctx, _, _ := testutil.NewTestContext(t, output.FormatPlain)
+	ctx.SetSpotify(&testutil.SpotifyMock{})
+	err := (&SearchTrackCmd{SearchArgs{Query: "x", Interactive: true}}).Run(ctx)
+	if err == nil || app.ExitCode(err) != 2 {
+		t.Fatalf("expected usage error, got %v", err)
+	}
+}
+
+func TestSearchInteractiveRefusesUnderNoInput(t *testing.T) {
+	ctx, _, _ := testutil.NewTestContext(t, output.FormatPlain)
+	ctx.Settings.NoInput = true
+	if interactiveTerminal(ctx) {
+		t.Fatalf("--no-input (forced for --profiles runs) must disable the picker")
+	}
+}
@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/steipete/spogo/internal/app"
+	"github.com/steipete/spogo/internal/output"
+	"github.com/steipete/spogo/internal/spotify"
+)
+
+type ShowEpisodesCmd struct {
+	Show     string `arg:"" required:"" help:"Show ID/URL/URI."`
+	Limit    int    `help:"Limit results." default:"20"`
+	Offset   int    `help:"Offset results." default:"0"`
+	Unplayed bool   `help:"Hide fully played episodes."`
+}
+
+type EpisodeResumeCmd struct {
+	Episode string `arg:"" required:"" help:"Episode ID/URL/URI."`
+}
+
+func (cmd *ShowEpisodesCmd) Run(ctx *app.Context) error {
+	client, cmdCtx, err := spotifyClient(ctx)
+	if err != nil {
+		return err
+	}
+	show, err := spotify.ParseTypedID(cmd.Show, "show")
+	if err != nil {
+		return err
+	}
+	items, total, err := client.ShowEpisodes(cmdCtx, show.ID, clampLimit(cmd.Limit), cmd.Offset)
+	if err != nil {
+		return err
+	}
+	if cmd.Unplayed {
+		// Filtering is per page; total still counts every episode so
+		// --offset keeps paging through the show.
+		kept := items[:0]
+		for _, item := range items {
+			if !item.FullyPlayed {
+				kept = append(kept, item)
+			}
+		}
+		items = kept
+	}
+	plain := make([]string, 0, len(items))
+	human := make([]string, 0, len(items))
+	for _, item := range items {
+		plain = append(plain, fmt.Sprintf("episode\t%s\t%s\t%s\t%d\t%d\t%t\t%s", item.ID, item.Name, item.ReleaseDate,
+			item.DurationMS, item.ResumePositionMS, item.FullyPlayed, item.URI))
+		human = append(human, episodeHuman(ctx.Output, item))
+	}
+	payload := map[string]any{"show": show.URI, "total": total, "offset": cmd.Offset, "items": items}
+	return ctx.Output.Emit(payload, plain, human)
+}
+
+func episodeHuman(w *output.Writer, item spotify.Item) string {
+	progress := humanDuration(item.DurationMS)
+	switch {
+	case item.FullyPlayed:
+		progress += " · played"
+	case item.ResumePositionMS > 0:
+		progress += fmt.Sprintf(" · %s left", humanDuration(item.DurationMS-item.ResumePositionMS))
+	}
+	return fmt.Sprintf("%s %s %s", w.Theme.Muted(orDefault(item.ReleaseDate, "----------")), w.Theme.Accent(item.Name), w.Theme.Muted("· "+progress))
+}
+
+func (cmd *EpisodeResumeCmd) Run(ctx *app.Context) error {
+	client, cmdCtx, err := spotifyClient(ctx)
+	if err != nil {
+		return err
+	}
+	res, err := spotify.ParseTypedID(cmd.Episode, "episode")
+	if err != nil {
+		return err
+	}
+	episode, err := client.GetEpisode(cmdCtx, res.ID)
+	if err != nil {
+		return err
+	}
+	// A finished episode has nothing left to resume; start it over.
+	position := episode.ResumePositionMS
+	if episode.FullyPlayed {
+		position = 0
+	}
+	if err := client.Play(cmdCtx, res.URI); err != nil {
+		return err
+	}
+	if position > 0 {
+		if err := client.Seek(cmdCtx, position); err != nil {
+			return err
+		}
+	}
+	payload := map[string]any{"status": "ok", "uri": res.URI, "position_ms": position, "fully_played": episode.FullyPlayed}
+	return emitOK(ctx, payload, fmt.Sprintf("Resumed %s at %s", orDefault(episode.Name, res.URI), formatClock(position)))
+}
@@ -0,0 +1,122 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/steipete/spogo/internal/output"
+	"github.com/steipete/spogo/internal/spotify"
+	"github.com/steipete/spogo/internal/testutil"
+)
+
+func showEpisodesMock() *testutil.SpotifyMock {
+	return &testutil.SpotifyMock{
+		ShowEpisodesFn: func(_ context.Context, id string, limit, offset int) ([]spotify.Item, int, error) {
+			return []spotify.Item{
+				{ID: "e1", URI: "spotify:episode:e1", Name: "Fresh", Type: "episode", ReleaseDate: "2026-05-02", DurationMS: 3600000, ResumePositionMS: 600000},
+				{ID: "e2", URI: "spotify:episode:e2", Name: "Done", Type: "episode", ReleaseDate: "2026-04-25", DurationMS: 1800000, FullyPlayed: true},
+			}, 30, nil
+		},
+	}
+}
+
+func TestShowEpisodesCmd(t *testing.T) {
+	ctx, out, _ := testutil.NewTestContext(t, output.FormatHuman)
+	ctx.SetSpotify(showEpisodesMock())
+	if err := (&ShowEpisodesCmd{Show: "spotify:show:s1"}).Run(ctx); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	text := out.String()
+	if !strings.Contains(text, "2026-05-02") || !strings.Contains(text, "50m00s left") || !strings.Contains(text, "played") {
+		t.Fatalf("output %q", text)
+	}
+
+	ctx, out, _ = testutil.NewTestContext(t, output.FormatPlain)
+	ctx.SetSpotify(showEpisodesMock())
+	if err := (&ShowEpisodesCmd{Show: "s1", Unplayed: true}).Run(ctx); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if got := strings.TrimSpace(out.String()); got != "episode\te1\tFresh\t2026-05-02\t3600000\t600000\tfalse\tspotify:episode:e1" {
+		t.Fatalf("plain %q", got)
+	}
+}
+
+func TestShowEpisodesCmdJSON(t *testing.T) {
+	ctx, out, _ := testutil.NewTestContext(t, output.FormatJSON)
+	ctx.SetSpotify(showEpisodesMock())
+	if err := (&ShowEpisodesCmd{Show: "s1", Offset: 20}).Run(ctx); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	var payload struct {
+		Show   string           `json:"show"`
+		Total  int              `json:"total"`
+		Offset int              `json:"offset"`
+		Items  []map[string]any `json:"items"`
+	}
+	if err := json.Unmarshal(out.Bytes(), &payload); err != nil {
+		t.Fatalf("json: %v", err)
+	}
+	if payload.Show != "spotify:show:s1" || payload.Total != 30 || payload.Offset != 20 || payload.Items[0]["resume_position_ms"] != float64(600000) || payload.Items[1]["fully_played"] != true {
+		t.Fatalf("payload %#v", payload)
+	}
+}
+
+func TestEpisodeResumeCmd(t *testing.T) {
+	var played string
+	var seeked []int
+	mock := &testutil.SpotifyMock{
+		GetEpisodeFn: func(_ context.Context, id string) (spotify.Item, error) {
+			return spotify.Item{ID: id, URI: "spotify:episode:" + id, Name: "Long talk", ResumePositionMS: 754000, FullyPlayed: id == "done"}, nil
+		},
+		PlayFn: func(_ context.Context, uri string) error {
+			played = uri
+			return nil
+		},
+		SeekFn: func(_ context.Context, ms int) error {
+			seeked = append(seeked, ms)
+			return nil
+		},
+	}
+	ctx, out, _ := testutil.NewTestContext(t, output.FormatHuman)
+	ctx.SetSpotify(mock)
+	if err := (&EpisodeResumeCmd{Episode: "https://open.spotify.com/episode/e1"}).Run(ctx); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if played != "spotify:episode:e1" || len(seeked) != 1 || seeked[0] != 754000 || !strings.Contains(out.String(), "12:34") {
+		t.Fatalf("played %q seeked %v output %q", played, seeked, out.String())
+	}
+	if err := (&EpisodeResumeCmd{Episode: "done"}).Run(ctx); err != nil {
+		t.Fatalf("run finished: %v", err)
+	}
+	if played != "spotify:episode:done" || len(seeked) != 1 {
+		t.Fatalf("finished episode should restart: %q %v", played, seeked)
+	}
+}
+
+func TestShowEpisodeCmdErrors(t *testing.T) {
+	ctx, _, _ := testutil.NewTestContext(t, output.FormatPlain)
+	ctx.SetSpotify(&testutil.SpotifyMock{
+		ShowEpisodesFn: func(context.Context, string, int, int) ([]spotify.Item, int, error) {
+			return nil, 0, errors.New("boom")
+		},
+		GetEpisodeFn: func(context.Context, string) (spotify.Item, error) {
+			return spotify.Item{ResumePositionMS: 10}, nil
+		},
+		PlayFn: func(context.Context, string) error { return errors.New("no device") },
+	})
+	if err := (&ShowEpisodesCmd{Show: "s1"}).Run(ctx); err == nil {
+		t.Fatalf("expected episodes error")
+	}
+	if err := (&ShowEpisodesCmd{Show: "spotify:album:a1"}).Run(ctx); err == nil {
+		t.Fatalf("expected invalid show error")
+	}
+	if err := (&EpisodeResumeCmd{Episode: "e1"}).Run(ctx); err == nil || !strings.Contains(err.Error(), "no device") {
+		t.Fatalf("expected play error, got %v", err)
+	}
+	if err := (&EpisodeResumeCmd{Episode: "spotify:track:t1"}).Run(ctx); err == nil {
+		t.Fatalf("expected invalid episode error")
+	}
+}
@@ -0,0 +1,242 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steipete/spogo/internal/app"
+	"github.com/steipete/spogo/internal/spotify"
+	"github.com/steipete/spogo/internal/state"
+)
+
+type SnapshotCmd struct {
+	Save    SnapshotSaveCmd    `kong:"cmd,help='Save what is playing under a name.'"`
+	Restore SnapshotRestoreCmd `kong:"cmd,help='Resume a saved snapshot.'"`
+	List    SnapshotListCmd    `kong:"cmd,help='List snapshots.'"`
+	Remove  SnapshotRemoveCmd  `kong:"cmd,help='Delete a snapshot.'"`
+}
+
+type SnapshotSaveCmd struct {
+	Name string `arg:"" required:"" help:"Snapshot name."`
+}
+
+type SnapshotRestoreCmd struct {
+	Name string `arg:"" required:"" help:"Snapshot name."`
+}
+
+type SnapshotListCmd struct{}
+
+type SnapshotRemoveCmd struct {
+	Name string `arg:"" required:"" help:"Snapshot name."`
+}
+
+type contextPlayer interface {
+	PlayContext(ctx context.Context, contextURI, trackURI string) error
+}
+
+func (cmd *SnapshotSaveCmd) Run(ctx *app.Context) error {
+	name, err := snapshotName(cmd.Name)
+	if err != nil {
+		return err
+	}
+	client, cmdCtx, err := spotifyClient(ctx)
+	if err != nil {
+		return err
+	}
+	status, err := client.Playback(cmdCtx)
+	if err != nil {
+		return err
+	}
+	if status.Item == nil || status.Item.URI == "" {
+		return errors.New("nothing playing to snapshot")
+	}
+	snap := state.Snapshot{SavedAt: time.Now(), Playback: status}
+	if queue, err := client.Queue(cmdCtx); err == nil {
+		snap.Queue = queue.Queue
+	} else if ctx.Settings.Verbose {
+		ctx.Output.Errorf("queue not captured: %v", err)
+	}
+	err = state.Update(ctx.ResolveStatePath(), func(st *state.State) error {
+		if st.Snapshots == nil {
+			st.Snapshots = map[string]state.Snapshot{}
+		}
+		st.Snapshots[name] = snap
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	payload := snapshotPayload(name, snap)
+	payload["status"] = "ok"
+	return emitOK(ctx, payload, fmt.Sprintf("Saved %q: %s at %s", name, snapshotLabel(snap), formatClock(status.ProgressMS)))
+}
+
+// Run re-applies a snapshot in dependency order: move to the device, start
+// the context at the saved item, seek, then volume and modes. A snapshot
+// taken while paused is paused again at the end.
+func (cmd *SnapshotRestoreCmd) Run(ctx *app.Context) error {
+	name, err := snapshotName(cmd.Name)
+	if err != nil {
+		return err
+	}
+	st, err := state.Read(ctx.ResolveStatePath())
+	if err != nil {
+		return err
+	}
+	snap, ok := st.Snapshots[name]
+	if !ok {
+		return fmt.Errorf("no snapshot %q", name)
+	}
+	client, cmdCtx, err := spotifyClient(ctx)
+	if err != nil {
+		return err
+	}
+	playback := snap.Playback
+	if playback.Item == nil {
+		return fmt.Errorf("snapshot %q has no item", name)
+	}
+	if playback.Device.ID != "" {
+		if err := client.Transfer(cmdCtx, playback.Device.ID); err != nil {
+			return err
+		}
+	}
+	if err := playSnapshotItem(cmdCtx, client, playback); err != nil {
+		return err
+	}
+	if playback.ProgressMS > 0 {
+		if err := client.Seek(cmdCtx, playback.ProgressMS); err != nil {
+			return err
+		}
+	}
+	if playback.Device.ID != "" {
+		// Some devices expose no remote volume; that should not block the rest.
+		if err := client.Volume(cmdCtx, playback.Device.Volume); err != nil {
+			ctx.Output.Errorf("could not restore volume %d: %v", playback.Device.Volume, err)
+		}
+	}
+	if err := client.Shuffle(cmdCtx, playback.Shuffle); err != nil {
+		return err
+	}
+	if err := client.Repeat(cmdCtx, orDefault(playback.Repeat, "off")); err != nil {
+		return err
+	}
+	if !playback.IsPlaying {
+		if err := client.Pause(cmdCtx); err != nil {
+			return err
+		}
+	}
+	payload := snapshotPayload(name, snap)
+	payload["status"] = "ok"
+	return emitOK(ctx, payload, fmt.Sprintf("Restored %q: %s at %s", name, snapshotLabel(snap), formatClock(playback.ProgressMS)))
+}
+
+// playSnapshotItem resumes the saved context at the saved item. Engines
+// without context offsets play the item on its own, so at least the
+// position is right.
+func playSnapshotItem(ctx context.Context, client spotify.API, playback spotify.PlaybackStatus) error {
+	itemURI := playback.Item.URI
+	if playback.ContextURI != "" && playback.ContextURI != itemURI {
+		if player, ok := client.(contextPlayer); ok {
+			err := player.PlayContext(ctx, playback.ContextURI, itemURI)
+			if err == nil || !errors.Is(err, spotify.ErrUnsupported) {
+				return err
+			}
+		}
+	}
+	return client.Play(ctx, itemURI)
+}
+
+func (cmd *SnapshotListCmd) Run(ctx *app.Context) error {
+	st, err := state.Read(ctx.ResolveStatePath())
+	if err != nil {
+		return err
+	}
+	names := make([]string, 0, len(st.Snapshots))
+	for name := range st.Snapshots {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	payload := make([]map[string]any, 0, len(names))
+	plain := make([]string, 0, len(names))
+	human := make([]string, 0, len(names))
+	for _, name := range names {
+		snap := st.Snapshots[name]
+		item := snap.Playback.Item
+		if item == nil {
+			item = &spotify.Item{}
+		}
+		payload = append(payload, snapshotPayload(name, snap))
+		plain = append(plain, fmt.Sprintf("%s\t%s\t%s\t%d\t%s", name, snap.SavedAt.Format(time.RFC3339), item.URI, snap.Playback.ProgressMS, snap.Playback.Device.Name))
+		line := fmt.Sprintf("%s  %s at %s", name, snapshotLabel(snap), formatClock(snap.Playback.ProgressMS))
+		if snap.Playback.Device.Name != "" {
+			line += " on " + snap.Playback.Device.Name
+		}
+		human = append(human, line+ctx.Output.Theme.Muted(" · saved "+snap.SavedAt.Format("Mon Jan 2 15:04")))
+	}
+	if len(human) == 0 {
+		human = append(human, "No snapshots")
+	}
+	return ctx.Output.Emit(payload, plain, human)
+}
+
+func (cmd *SnapshotRemoveCmd) Run(ctx *app.Context) error {
+	name, err := snapshotName(cmd.Name)
+	if err != nil {
+		return err
+	}
+	err = state.Update(ctx.ResolveStatePath(), func(st *state.State) error {
+		if _, ok := st.Snapshots[name]; !ok {
+			return fmt.Errorf("no snapshot %q", name)
+		}
+		delete(st.Snapshots, name)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return emitOK(ctx, map[string]any{"status": "ok", "name": name}, fmt.Sprintf("Removed snapshot %q", name))
+}
+
+func snapshotName(input string) (string, error) {
+	name := strings.TrimSpace(input)
+	if name == "" {
+		return "", app.WrapExit(2, errors.New("snapshot name required"))
+	}
+	return name, nil
+}
+
+func snapshotLabel(snap state.Snapshot) string {
+	if snap.Playback.Item == nil {
+		return "-"
+	}
+	return trackLabel(*snap.Playback.Item)
+}
+
+func snapshotPayload(name string, snap state.Snapshot) map[string]any {
+	playback := snap.Playback
+	payload := map[string]any{
+		"name":        name,
+		"saved_at":    snap.SavedAt.Format(time.RFC3339),
+		"progress_ms": playback.ProgressMS,
+		"is_playing":  playback.IsPlaying,
+		"shuffle":     playback.Shuffle,
+		"repeat":      playback.Repeat,
+		"volume":      playback.Device.Volume,
+		"queue":       len(snap.Queue),
+	}
+	if playback.Item != nil {
+		payload["uri"] = playback.Item.URI
+	}
+	if playback.ContextURI != "" {
+		payload["context_uri"] = playback.ContextURI
+	}
+	if playback.Device.ID != "" {
+		payload["device"] = playback.Device.Name
+		payload["device_id"] = playback.Device.ID
+	}
+	return payload
+}
@@ -0,0 +1,147 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steipete/spogo/internal/app"
+	"github.com/steipete/spogo/internal/output"
+	"github.com/steipete/spogo/internal/spotify"
+	"github.com/steipete/spogo/internal/state"
+	"github.com/steipete/spogo/internal/testutil"
+)
+
+func snapshotPlayback() spotify.PlaybackStatus {
+	return spotify.PlaybackStatus{
+		IsPlaying:  true,
+		ProgressMS: 83_000,
+		Item:       &spotify.Item{URI: "spotify:track:t2", Name: "Song", Artists: []string{"Band"}},
+		ContextURI: "spotify:album:a1",
+		Device:     spotify.Device{ID: "d1", Name: "Kitchen", Volume: 35},
+		Shuffle:    true,
+		Repeat:     "context",
+	}
+}
+
+func TestSnapshotSaveListRestoreRemove(t *testing.T) {
+	ctx, out, _ := testutil.NewTestContext(t, output.FormatHuman)
+	ctx.ConfigPath = filepath.Join(t.TempDir(), "config.toml")
+	ctx.ProfileKey = "default"
+	var calls []string
+	record := func(call string) error { calls = append(calls, call); return nil }
+	ctx.SetSpotify(&testutil.SpotifyMock{
+		PlaybackFn: func(context.Context) (spotify.PlaybackStatus, error) { return snapshotPlayback(), nil },
+		QueueFn: func(context.Context) (spotify.Queue, error) {
+			return spotify.Queue{Queue: []spotify.Item{{URI: "spotify:track:t3"}}}, nil
+		},
+		TransferFn: func(_ context.Context, id string) error { return record("transfer " + id) },
+		PlayContextFn: func(_ context.Context, contextURI, trackURI string) error {
+			return record("play " + contextURI + " " + trackURI)
+		},
+		SeekFn:    func(_ context.Context, ms int) error { return record("seek " + formatClock(ms)) },
+		VolumeFn:  func(_ context.Context, level int) error { return record("volume " + formatClock(level*1000)) },
+		ShuffleFn: func(_ context.Context, on bool) error { return record("shuffle " + onOff(on)) },
+		RepeatFn:  func(_ context.Context, mode string) error { return record("repeat " + mode) },
+	})
+	if err := (&SnapshotSaveCmd{Name: "meeting"}).Run(ctx); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	if !strings.Contains(out.String(), `Saved "meeting": Song — Band at 1:23`) {
+		t.Fatalf("save output %q", out.String())
+	}
+	st, err := state.Read(ctx.ResolveStatePath())
+	if err != nil || len(st.Snapshots["meeting"].Queue) != 1 || st.Snapshots["meeting"].Playback.ContextURI != "spotify:album:a1" {
+		t.Fatalf("state %#v %v", st, err)
+	}
+
+	out.Reset()
+	if err := (&SnapshotListCmd{}).Run(ctx); err != nil || !strings.Contains(out.String(), "meeting  Song — Band at 1:23 on Kitchen") {
+		t.Fatalf("list %q %v", out.String(), err)
+	}
+
+	if err := (&SnapshotRestoreCmd{Name: "meeting"}).Run(ctx); err != nil {
+		t.Fatalf("restore: %v", err)
+	}
+	want := "transfer d1|play spotify:album:a1 spotify:track:t2|seek 1:23|volume 0:35|shuffle on|repeat context"
+	if got := strings.Join(calls, "|"); got != want {
+		t.Fatalf("calls\n got %s\nwant %s", got, want)
+	}
+
+	if err := (&SnapshotRemoveCmd{Name: "meeting"}).Run(ctx); err != nil {
+		t.Fatalf("remove: %v", err)
+	}
+	if err := (&SnapshotRestoreCmd{Name: "meeting"}).Run(ctx); err == nil || !strings.Contains(err.Error(), "no snapshot") {
+		t.Fatalf("expected missing snapshot, got %v", err)
+	}
+	if err := (&SnapshotRemoveCmd{Name: "meeting"}).Run(ctx); err == nil {
+		t.Fatalf("expected missing snapshot on remove")
+	}
+	out.Reset()
+	if err := (&SnapshotListCmd{}).Run(ctx); err != nil || !strings.Contains(out.String(), "No snapshots") {
+		t.Fatalf("empty list %q %v", out.String(), err)
+	}
+}
+
+func TestSnapshotRestorePausedWithoutContextSupport(t *testing.T) {
+	ctx, out, errOut := testutil.NewTestContext(t, output.FormatPlain)
+	ctx.ConfigPath = filepath.Join(t.TempDir(), "config.toml")
+	ctx.ProfileKey = "default"
+	playback := snapshotPlayback()
+	playback.IsPlaying = false
+	playback.Repeat = ""
+	if err := state.Write(ctx.ResolveStatePath(), state.State{Snapshots: map[string]state.Snapshot{"a": {Playback: playback}}}); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	var calls []string
+	record := func(call string) error { calls = append(calls, call); return nil }
+	ctx.SetSpotify(&testutil.SpotifyMock{
+		TransferFn:    func(context.Context, string) error { return nil },
+		PlayContextFn: func(context.Context, string, string) error { return spotify.ErrUnsupported },
+		PlayFn:        func(_ context.Context, uri string) error { return record("play " + uri) },
+		SeekFn:        func(context.Context, int) error { return nil },
+		VolumeFn:      func(context.Context, int) error { return errors.New("restricted") },
+		ShuffleFn:     func(context.Context, bool) error { return nil },
+		RepeatFn:      func(_ context.Context, mode string) error { return record("repeat " + mode) },
+		PauseFn:       func(context.Context) error { return record("pause") },
+	})
+	if err := (&SnapshotRestoreCmd{Name: "a"}).Run(ctx); err != nil {
+		t.Fatalf("restore: %v", err)
+	}
+	if got := strings.Join(calls, "|"); got != "play spotify:track:t2|repeat off|pause" {
+		t.Fatalf("calls %s", got)
+	}
+	if strings.TrimSpace(out.String()) != "ok" || !strings.Contains(errOut.String(), "could not restore volume 35") {
+		t.Fatalf("out %q err %q", out.String(), errOut.String())
+	}
+	out.Reset()
+	if err := (&SnapshotListCmd{}).Run(ctx); err != nil || !strings.HasPrefix(out.String(), "a\t0001-01-01T00:00:00Z\tspotify:track:t2\t83000\tKitchen") {
+		t.Fatalf("plain list %q %v", out.String(), err)
+	}
+}
+
+func TestSnapshotErrors(t *testing.T) {
+	ctx, _, _ := testutil.NewTestContext(t, output.FormatPlain)
+	ctx.ConfigPath = filepath.Join(t.TempDir(), "config.toml")
+	ctx.ProfileKey = "default"
+	if err := (&SnapshotSaveCmd{Name: " "}).Run(ctx); err == nil || app.ExitCode(err) != 2 {
+		t.Fatalf("expected usage error, got %v", err)
+	}
+	ctx.SetSpotify(&testutil.SpotifyMock{
+		PlaybackFn: func(context.Context) (spotify.PlaybackStatus, error) { return spotify.PlaybackStatus{}, nil },
+	})
+	if err := (&SnapshotSaveCmd{Name: "x"}).Run(ctx); err == nil || !strings.Contains(err.Error(), "nothing playing") {
+		t.Fatalf("expected nothing playing, got %v", err)
+	}
+	if err := state.Write(ctx.ResolveStatePath(), state.State{Snapshots: map[string]state.Snapshot{"empty": {}}}); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if err := (&SnapshotRestoreCmd{Name: "empty"}).Run(ctx); err == nil || !strings.Contains(err.Error(), "no item") {
+		t.Fatalf("expected no item, got %v", err)
+	}
+	if label := snapshotLabel(state.Snapshot{}); label != "-" {
+		t.Fatalf("label %q", label)
+	}
+}
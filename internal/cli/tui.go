@@ -0,0 +1,447 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/steipete/spogo/internal/app"
+	"github.com/steipete/spogo/internal/spotify"
+	"github.com/steipete/spogo/internal/tui"
+)
+
+const (
+	playerSeekStepMS   = 10_000
+	playerVolumeStep   = 5
+	playerFullEvery    = 5
+	playerLibraryLimit = 50
+)
+
+type TUICmd struct {
+	Refresh time.Duration `help:"Playback refresh interval." default:"1s"`
+}
+
+type playerPane int
+
+const (
+	paneQueue playerPane = iota
+	paneDevices
+	paneLibrary
+)
+
+var paneNames = []string{"Queue", "Devices", "Library"}
+
+// playerSnapshot is one background refresh. Nil fields were not fetched.
+type playerSnapshot struct {
+	status  *spotify.PlaybackStatus
+	queue   *spotify.Queue
+	devices []spotify.Device
+	library []spotify.Item
+	err     error
+	at      time.Time
+}
+
+// playerUI is the state behind `spogo tui`. Like the search picker, keys
+// and refreshes mutate it and view renders it, so it runs without a terminal
+// in tests.
+type playerUI struct {
+	ctx    context.Context
+	client spotify.API
+	now    func() time.Time
+
+	status    spotify.PlaybackStatus
+	fetchedAt time.Time
+	queue     []spotify.Item
+	devices   []spotify.Device
+	library   []spotify.Item
+	pane      playerPane
+	cursors   [3]int
+	message   string
+	quit      bool
+}
+
+func (cmd *TUICmd) Run(ctx *app.Context) error {
+	if !interactiveTerminal(ctx) {
+		return app.WrapExit(2, errors.New("tui needs a terminal and is disabled by --no-input"))
+	}
+	interval := cmd.Refresh
+	if interval < 200*time.Millisecond {
+		interval = time.Second
+	}
+	client, cmdCtx, err := spotifyClient(ctx)
+	if err != nil {
+		return err
+	}
+	term, err := tui.Open(os.Stdin, ctx.Output.Out)
+	if err != nil {
+		return err
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	ui := &playerUI{ctx: cmdCtx, client: client, now: time.Now}
+	runErr := ui.run(term.Keys(), term.Draw, term.Size, ticker.C)
+	if err := term.Close(); err != nil && runErr == nil {
+		runErr = err
+	}
+	return runErr
+}
+
+func (u *playerUI) run(keys <-chan tui.Key, draw func([]string), size func() (int, int), ticks <-chan time.Time) error {
+	done := make(chan struct{})
+	defer close(done)
+	updates := make(chan playerSnapshot)
+	inFlight, pending := false, false
+	refresh := func(full bool) {
+		if inFlight {
+			pending = pending || full
+			return
+		}
+		inFlight = true
+		needLibrary := full && u.library == nil
+		go func() {
+			snap := u.fetch(full, needLibrary)
+			select {
+			case updates <- snap:
+			case <-done:
+			}
+		}()
+	}
+	refresh(true)
+	tick := 0
+	for !u.quit {
+		draw(u.view(size()))
+		select {
+		case <-u.ctx.Done():
+			return u.ctx.Err()
+		case key, ok := <-keys:
+			if !ok {
+				return nil
+			}
+			if u.handleKey(key) {
+				refresh(true)
+			}
+		case snap := <-updates:
+			inFlight = false
+			u.apply(snap)
+			if pending {
+				pending = false
+				refresh(true)
+			}
+		case <-ticks:
+			tick++
+			refresh(tick%playerFullEvery == 0)
+		}
+	}
+	return nil
+}
+
+// fetch reads playback state, plus the queue, devices and (once) the
+// library on full refreshes. It only touches the client, so it is safe to
+// run off the UI goroutine.
+func (u *playerUI) fetch(full, library bool) playerSnapshot {
+	snap := playerSnapshot{at: u.now()}
+	keep := func(err error) {
+		if err != nil && snap.err == nil {
+			snap.err = err
+		}
+	}
+	status, err := u.client.Playback(u.ctx)
+	keep(err)
+	if err == nil {
+		snap.status = &status
+	}
+	if !full {
+		return snap
+	}
+	queue, err := u.client.Queue(u.ctx)
+	keep(err)
+	if err == nil {
+		snap.queue = &queue
+	}
+	devices, err := u.client.Devices(u.ctx)
+	keep(err)
+	if err == nil {
+		snap.devices = devices
+	}
+	if library {
+		items, _, err := u.client.Playlists(u.ctx, playerLibraryLimit, 0)
+		keep(err)
+		if err == nil {
+			snap.library = items
+		}
+	}
+	return snap
+}
+
+func (u *playerUI) apply(snap playerSnapshot) {
+	if snap.status != nil {
+		u.status, u.fetchedAt = *snap.status, snap.at
+	}
+	if snap.queue != nil {
+		u.queue = snap.queue.Queue
+	}
+	if snap.devices != nil {
+		u.devices = snap.devices
+	}
+	if snap.library != nil {
+		u.library = snap.library
+	}
+	if snap.err != nil {
+		u.message = snap.err.Error()
+	}
+	for pane := range u.cursors {
+		u.cursors[pane] = moveCursor(u.cursors[pane], 0, u.paneLen(playerPane(pane)))
+	}
+}
+
+// handleKey applies one keypress and reports whether playback changed, so
+// the caller can refresh right away instead of waiting for the next tick.
+func (u *playerUI) handleKey(key tui.Key) bool {
+	switch {
+	case key.IsCtrl('c') || key.Code == tui.KeyEsc || (key.Code == tui.KeyRune && key.Rune == 'q'):
+		u.quit = true
+		return false
+	case key.Code == tui.KeyTab:
+		u.pane = (u.pane + 1) % playerPane(len(paneNames))
+		return false
+	case key.Code == tui.KeyUp:
+		u.cursors[u.pane] = moveCursor(u.cursors[u.pane], -1, u.paneLen(u.pane))
+		return false
+	case key.Code == tui.KeyDown:
+		u.cursors[u.pane] = moveCursor(u.cursors[u.pane], 1, u.paneLen(u.pane))
+		return false
+	case key.Code == tui.KeyLeft:
+		return u.do(u.seekBy(-playerSeekStepMS))
+	case key.Code == tui.KeyRight:
+		return u.do(u.seekBy(playerSeekStepMS))
+	case key.Code == tui.KeyEnter:
+		if u.cursors[u.pane] >= u.paneLen(u.pane) {
+			return false
+		}
+		return u.do(u.activate())
+	case key.Code != tui.KeyRune:
+		return false
+	}
+	switch key.Rune {
+	case ' ':
+		return u.do(u.togglePlay())
+	case 'n':
+		return u.do(u.client.Next(u.ctx))
+	case 'p':
+		return u.do(u.client.Previous(u.ctx))
+	case '+', '=':
+		return u.do(u.volumeBy(playerVolumeStep))
+	case '-', '_':
+		return u.do(u.volumeBy(-playerVolumeStep))
+	case 's':
+		return u.do(u.toggleShuffle())
+	case 'r':
+		return u.do(u.cycleRepeat())
+	case '1', '2', '3':
+		u.pane = playerPane(key.Rune - '1')
+	}
+	return false
+}
+
+func (u *playerUI) do(err error) bool {
+	if err != nil {
+		u.message = err.Error()
+		return false
+	}
+	u.message = ""
+	return true
+}
+
+func (u *playerUI) togglePlay() error {
+	if u.status.IsPlaying {
+		if err := u.client.Pause(u.ctx); err != nil {
+			return err
+		}
+	} else if err := u.client.Play(u.ctx, ""); err != nil {
+		return err
+	}
+	u.status.ProgressMS = u.progress()
+	u.status.IsPlaying = !u.status.IsPlaying
+	u.fetchedAt = u.now()
+	return nil
+}
+
+func (u *playerUI) seekBy(deltaMS int) error {
+	position := u.progress() + deltaMS
+	if position < 0 {
+		position = 0
+	}
+	if err := u.client.Seek(u.ctx, position); err != nil {
+		return err
+	}
+	u.status.ProgressMS, u.fetchedAt = position, u.now()
+	return nil
+}
+
+func (u *playerUI) volumeBy(delta int) error {
+	level := min(max(u.status.Device.Volume+delta, 0), 100)
+	if err := u.client.Volume(u.ctx, level); err != nil {
+		return err
+	}
+	u.status.Device.Volume = level
+	return nil
+}
+
+func (u *playerUI) toggleShuffle() error {
+	if err := u.client.Shuffle(u.ctx, !u.status.Shuffle); err != nil {
+		return err
+	}
+	u.status.Shuffle = !u.status.Shuffle
+	return nil
+}
+
+func (u *playerUI) cycleRepeat() error {
+	next := map[string]string{"off": "context", "context": "track", "track": "off"}[u.status.Repeat]
+	if next == "" {
+		next = "context"
+	}
+	if err := u.client.Repeat(u.ctx, next); err != nil {
+		return err
+	}
+	u.status.Repeat = next
+	return nil
+}
+
+// activate plays the selected queue or library entry, or moves playback to
+// the selected device.
+func (u *playerUI) activate() error {
+	cursor := u.cursors[u.pane]
+	switch u.pane {
+	case paneDevices:
+		return u.client.Transfer(u.ctx, u.devices[cursor].ID)
+	case paneLibrary:
+		return u.client.Play(u.ctx, u.library[cursor].URI)
+	default:
+		return u.client.Play(u.ctx, u.queue[cursor].URI)
+	}
+}
+
+func (u *playerUI) paneLen(pane playerPane) int {
+	switch pane {
+	case paneDevices:
+		return len(u.devices)
+	case paneLibrary:
+		return len(u.library)
+	default:
+		return len(u.queue)
+	}
+}
+
+// progress extrapolates the playhead from the last refresh so the bar moves
+// smoothly between polls.
+func (u *playerUI) progress() int {
+	progress := u.status.ProgressMS
+	if u.status.IsPlaying && !u.fetchedAt.IsZero() {
+		progress += int(u.now().Sub(u.fetchedAt) / time.Millisecond)
+	}
+	if u.status.Item != nil && u.status.Item.DurationMS > 0 && progress > u.status.Item.DurationMS {
+		progress = u.status.Item.DurationMS
+	}
+	return progress
+}
+
+func (u *playerUI) view(width, height int) []string {
+	lines := append([]string{}, u.nowPlaying(width)...)
+	lines = append(lines, "", u.tabs(width))
+	rows := height - len(lines) - 2
+	cursor := u.cursors[u.pane]
+	start, end := tui.Window(u.paneLen(u.pane), cursor, rows)
+	for i := start; i < end; i++ {
+		lines = append(lines, pickerRow(u.paneLabel(i), i == cursor, width))
+	}
+	if start == end {
+		lines = append(lines, tui.Dim("  (empty)"))
+	}
+	for len(lines) < height-2 {
+		lines = append(lines, "")
+	}
+	lines = append(lines, tui.Truncate(u.message, width))
+	help := "space play/pause · n/p next/prev · ←/→ seek · +/- volume · s shuffle · r repeat · tab pane · enter select · q quit"
+	return append(lines, tui.Dim(tui.Truncate(help, width)))
+}
+
+func (u *playerUI) nowPlaying(width int) []string {
+	state := "⏸ Paused"
+	if u.status.IsPlaying {
+		state = "▶ Playing"
+	}
+	device := u.status.Device.Name
+	if device == "" {
+		device = "no active device"
+	}
+	header := fmt.Sprintf("%s on %s · vol %d%% · shuffle %s · repeat %s",
+		state, device, u.status.Device.Volume, onOff(u.status.Shuffle), orDefault(u.status.Repeat, "off"))
+	lines := []string{tui.Truncate(header, width)}
+	item := u.status.Item
+	if item == nil {
+		return append(lines, "", tui.Dim("Nothing playing"), "")
+	}
+	lines = append(lines, tui.Truncate(item.Name, width), tui.Dim(tui.Truncate(pickerLabel(*item), width)))
+	progress := u.progress()
+	clock := fmt.Sprintf(" %s / %s", formatClock(progress), formatClock(item.DurationMS))
+	bar := progressBar(progress, item.DurationMS, width-len(clock))
+	return append(lines, bar+clock)
+}
+
+func (u *playerUI) tabs(width int) string {
+	parts := make([]string, len(paneNames))
+	for i, name := range paneNames {
+		label := fmt.Sprintf(" %d %s ", i+1, name)
+		if playerPane(i) == u.pane {
+			label = tui.Reverse(label)
+		}
+		parts[i] = label
+	}
+	return strings.Join(parts, " ")
+}
+
+func (u *playerUI) paneLabel(i int) string {
+	switch u.pane {
+	case paneDevices:
+		device := u.devices[i]
+		label := fmt.Sprintf("%s · %s · %d%% %s", device.Name, device.Type, device.Volume, activeMarker(device.Active))
+		return strings.TrimSpace(label)
+	case paneLibrary:
+		return pickerLabel(u.library[i])
+	default:
+		return pickerLabel(u.queue[i])
+	}
+}
+
+func progressBar(progress, duration, width int) string {
+	if width < 3 {
+		return ""
+	}
+	inner := width - 2
+	filled := 0
+	if duration > 0 {
+		filled = min(inner*progress/duration, inner)
+	}
+	return "[" + strings.Repeat("█", filled) + strings.Repeat("░", inner-filled) + "]"
+}
+
+func formatClock(ms int) string {
+	if ms < 0 {
+		ms = 0
+	}
+	seconds := ms / 1000
+	if seconds >= 3600 {
+		return fmt.Sprintf("%d:%02d:%02d", seconds/3600, seconds/60%60, seconds%60)
+	}
+	return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+}
+
+func orDefault(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
@@ -0,0 +1,241 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steipete/spogo/internal/app"
+	"github.com/steipete/spogo/internal/output"
+	"github.com/steipete/spogo/internal/spotify"
+	"github.com/steipete/spogo/internal/testutil"
+	"github.com/steipete/spogo/internal/tui"
+)
+
+type playerCalls struct {
+	log []string
+}
+
+func (c *playerCalls) add(call string) error {
+	c.log = append(c.log, call)
+	return nil
+}
+
+func playerMock(calls *playerCalls) *testutil.SpotifyMock {
+	return &testutil.SpotifyMock{
+		PlaybackFn: func(context.Context) (spotify.PlaybackStatus, error) {
+			return spotify.PlaybackStatus{
+				IsPlaying:  true,
+				ProgressMS: 60_000,
+				Item:       &spotify.Item{Name: "Harder Better", Type: "track", Artists: []string{"Daft Punk"}, DurationMS: 224_000},
+				Device:     spotify.Device{ID: "d1", Name: "Kitchen", Volume: 40},
+				Repeat:     "off",
+			}, nil
+		},
+		QueueFn: func(context.Context) (spotify.Queue, error) {
+			return spotify.Queue{Queue: []spotify.Item{{Name: "Next Up", Type: "track", URI: "spotify:track:q1"}}}, nil
+		},
+		DevicesFn: func(context.Context) ([]spotify.Device, error) {
+			return []spotify.Device{{ID: "d1", Name: "Kitchen", Type: "Speaker", Active: true}, {ID: "d2", Name: "Laptop", Type: "Computer"}}, nil
+		},
+		PlaylistsFn: func(context.Context, int, int) ([]spotify.Item, int, error) {
+			return []spotify.Item{{Name: "Faves", Type: "playlist", URI: "spotify:playlist:p1", Owner: "me"}}, 1, nil
+		},
+		PlayFn:     func(_ context.Context, uri string) error { return calls.add("play " + uri) },
+		PauseFn:    func(context.Context) error { return calls.add("pause") },
+		NextFn:     func(context.Context) error { return calls.add("next") },
+		PreviousFn: func(context.Context) error { return calls.add("prev") },
+		SeekFn: func(_ context.Context, ms int) error {
+			return calls.add("seek " + formatClock(ms))
+		},
+		VolumeFn: func(_ context.Context, level int) error {
+			return calls.add("volume " + formatClock(level*1000))
+		},
+		ShuffleFn:  func(_ context.Context, on bool) error { return calls.add("shuffle " + onOff(on)) },
+		RepeatFn:   func(_ context.Context, mode string) error { return calls.add("repeat " + mode) },
+		TransferFn: func(_ context.Context, id string) error { return calls.add("transfer " + id) },
+	}
+}
+
+func newTestPlayer(mock *testutil.SpotifyMock, now *time.Time) *playerUI {
+	return &playerUI{ctx: context.Background(), client: mock, now: func() time.Time { return *now }}
+}
+
+func TestPlayerUIRunRefreshesAndQuits(t *testing.T) {
+	calls := &playerCalls{}
+	now := time.Unix(100, 0)
+	ui := newTestPlayer(playerMock(calls), &now)
+	keys := make(chan tui.Key)
+	ticks := make(chan time.Time)
+	frames := make(chan string, 256)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- ui.run(keys, func(lines []string) { frames <- strings.Join(lines, "\n") }, func() (int, int) { return 100, 16 }, ticks)
+	}()
+	waitFrame(t, frames, "Next Up")
+	ticks <- now
+	keys <- tui.Key{Code: tui.KeyRune, Rune: 'n'}
+	waitFrame(t, frames, "Harder Better")
+	keys <- tui.Key{Code: tui.KeyRune, Rune: 'q'}
+	if err := <-errCh; err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if len(calls.log) != 1 || calls.log[0] != "next" {
+		t.Fatalf("calls %v", calls.log)
+	}
+	if len(ui.library) != 1 || len(ui.devices) != 2 {
+		t.Fatalf("library %v devices %v", ui.library, ui.devices)
+	}
+}
+
+func TestPlayerUIRunStopsOnClosedKeysAndContext(t *testing.T) {
+	now := time.Unix(100, 0)
+	ui := newTestPlayer(playerMock(&playerCalls{}), &now)
+	keys := make(chan tui.Key)
+	close(keys)
+	if err := ui.run(keys, func([]string) {}, func() (int, int) { return 80, 12 }, nil); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	ui = newTestPlayer(playerMock(&playerCalls{}), &now)
+	ui.ctx = ctx
+	if err := ui.run(make(chan tui.Key), func([]string) {}, func() (int, int) { return 80, 12 }, nil); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected canceled, got %v", err)
+	}
+}
+
+func TestPlayerUIKeys(t *testing.T) {
+	calls := &playerCalls{}
+	now := time.Unix(100, 0)
+	mock := playerMock(calls)
+	ui := newTestPlayer(mock, &now)
+	ui.apply(ui.fetch(true, true))
+	now = now.Add(5 * time.Second)
+
+	press := func(keys ...tui.Key) {
+		for _, key := range keys {
+			ui.handleKey(key)
+		}
+	}
+	r := func(r rune) tui.Key { return tui.Key{Code: tui.KeyRune, Rune: r} }
+	press(tui.Key{Code: tui.KeyRight}, tui.Key{Code: tui.KeyLeft})
+	press(r(' '), r(' '))
+	press(r('p'), r('+'), r('-'), r('s'), r('r'), r('r'), r('r'))
+	press(tui.Key{Code: tui.KeyEnter})
+	press(tui.Key{Code: tui.KeyTab}, tui.Key{Code: tui.KeyDown}, tui.Key{Code: tui.KeyEnter})
+	press(r('3'), tui.Key{Code: tui.KeyUp}, tui.Key{Code: tui.KeyEnter})
+	press(tui.Key{Code: tui.KeyHome}, r('x'))
+	want := []string{
+		"seek 1:15", "seek 1:05",
+		"pause", "play ",
+		"prev", "volume 0:45", "volume 0:40", "shuffle on", "repeat context", "repeat track", "repeat off",
+		"play spotify:track:q1",
+		"transfer d2",
+		"play spotify:playlist:p1",
+	}
+	if strings.Join(calls.log, "|") != strings.Join(want, "|") {
+		t.Fatalf("calls\n got %v\nwant %v", calls.log, want)
+	}
+	if ui.quit {
+		t.Fatalf("unexpected quit")
+	}
+	ui.handleKey(tui.Key{Code: tui.KeyEsc})
+	if !ui.quit {
+		t.Fatalf("expected quit")
+	}
+}
+
+func TestPlayerUIErrors(t *testing.T) {
+	now := time.Unix(100, 0)
+	mock := &testutil.SpotifyMock{
+		PlaybackFn: func(context.Context) (spotify.PlaybackStatus, error) {
+			return spotify.PlaybackStatus{}, errors.New("offline")
+		},
+		QueueFn: func(context.Context) (spotify.Queue, error) {
+			return spotify.Queue{}, errors.New("queue down")
+		},
+		DevicesFn: func(context.Context) ([]spotify.Device, error) {
+			return nil, errors.New("devices down")
+		},
+		PlaylistsFn: func(context.Context, int, int) ([]spotify.Item, int, error) {
+			return nil, 0, errors.New("library down")
+		},
+		SeekFn:    func(context.Context, int) error { return errors.New("seek failed") },
+		PlayFn:    func(context.Context, string) error { return errors.New("play failed") },
+		VolumeFn:  func(context.Context, int) error { return errors.New("volume failed") },
+		ShuffleFn: func(context.Context, bool) error { return errors.New("shuffle failed") },
+		RepeatFn:  func(context.Context, string) error { return errors.New("repeat failed") },
+	}
+	ui := newTestPlayer(mock, &now)
+	ui.apply(ui.fetch(true, true))
+	if ui.message != "offline" || ui.library != nil {
+		t.Fatalf("message %q library %v", ui.message, ui.library)
+	}
+	cases := map[rune]string{' ': "play failed", '+': "volume failed", 's': "shuffle failed", 'r': "repeat failed"}
+	for key, want := range cases {
+		if ui.handleKey(tui.Key{Code: tui.KeyRune, Rune: key}) || ui.message != want {
+			t.Fatalf("key %q message %q", key, ui.message)
+		}
+	}
+	if ui.handleKey(tui.Key{Code: tui.KeyLeft}) || ui.message != "seek failed" {
+		t.Fatalf("message %q", ui.message)
+	}
+	if ui.handleKey(tui.Key{Code: tui.KeyEnter}) {
+		t.Fatalf("enter on empty pane reported a change")
+	}
+	ui.status.IsPlaying = true
+	mock.PauseFn = func(context.Context) error { return errors.New("pause failed") }
+	if ui.handleKey(tui.Key{Code: tui.KeyRune, Rune: ' '}) || ui.message != "pause failed" {
+		t.Fatalf("message %q", ui.message)
+	}
+}
+
+func TestPlayerUIView(t *testing.T) {
+	now := time.Unix(100, 0)
+	ui := newTestPlayer(playerMock(&playerCalls{}), &now)
+	lines := ui.view(60, 12)
+	if len(lines) != 12 || !strings.Contains(lines[0], "no active device") || !strings.Contains(strings.Join(lines, "\n"), "Nothing playing") {
+		t.Fatalf("empty view %q", lines)
+	}
+	ui.apply(ui.fetch(true, true))
+	now = now.Add(500 * time.Second)
+	lines = ui.view(80, 12)
+	joined := strings.Join(lines, "\n")
+	for _, want := range []string{"▶ Playing on Kitchen · vol 40% · shuffle off · repeat off", "Harder Better", "3:44 / 3:44", "Queue", "Next Up", "space play/pause"} {
+		if !strings.Contains(joined, want) {
+			t.Fatalf("missing %q in\n%s", want, joined)
+		}
+	}
+	ui.pane = paneDevices
+	if !strings.Contains(strings.Join(ui.view(80, 12), "\n"), "Kitchen · Speaker · 0% (active)") {
+		t.Fatalf("devices pane")
+	}
+	ui.status.IsPlaying = false
+	if !strings.Contains(ui.view(80, 12)[0], "⏸ Paused") {
+		t.Fatalf("paused header")
+	}
+}
+
+func TestProgressBarAndClock(t *testing.T) {
+	if got := progressBar(50, 100, 12); got != "[█████░░░░░]" {
+		t.Fatalf("bar %q", got)
+	}
+	if progressBar(1, 0, 4) != "[░░]" || progressBar(1, 1, 2) != "" {
+		t.Fatalf("bar edges")
+	}
+	if formatClock(3_723_000) != "1:02:03" || formatClock(-5) != "0:00" || formatClock(65_000) != "1:05" {
+		t.Fatalf("clock")
+	}
+}
+
+func TestTUIRequiresTerminal(t *testing.T) {
+	ctx, _, _ := testutil.NewTestContext(t, output.FormatHuman)
+	ctx.SetSpotify(&testutil.SpotifyMock{})
+	err := (&TUICmd{Refresh: time.Second}).Run(ctx)
+	if err == nil || app.ExitCode(err) != 2 {
+		t.Fatalf("expected usage error, got %v", err)
+	}
+}
@@ -2,8 +2,10 @@ package config
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/pelletier/go-toml/v2"
 )
@@ -19,13 +21,76 @@ type Config struct {
 }
 
 type Profile struct {
-	Browser        string `toml:"browser"`
-	BrowserProfile string `toml:"browser_profile"`
-	CookiePath     string `toml:"cookie_path"`
-	Market         string `toml:"market"`
-	Language       string `toml:"language"`
-	Device         string `toml:"device"`
-	Engine         string `toml:"engine"`
+	Browser        string              `toml:"browser"`
+	BrowserProfile string              `toml:"browser_profile"`
+	CookiePath     string              `toml:"cookie_path"`
+	CookieStore    string              `toml:"cookie_store,omitempty"`
+	Market         string              `toml:"market"`
+	Language       string              `toml:"language"`
+	Device         string              `toml:"device"`
+	Engine         string              `toml:"engine"`
+	WaitDevice     string              `toml:"wait_device,omitempty"`
+	Devices        map[string]string   `toml:"devices,omitempty"`
+	DeviceGroups   map[string][]string `toml:"device_groups,omitempty"`
+	Alarms         []Alarm             `toml:"alarm,omitempty"`
+}
+
+// Alarm is a scheduled playback start. Time is local HH:MM; empty Days
+// means every day.
+type Alarm struct {
+	ID         string   `toml:"id"`
+	Time       string   `toml:"time"`
+	Days       []string `toml:"days,omitempty"`
+	Play       string   `toml:"play"`
+	Device     string   `toml:"device,omitempty"`
+	Volume     int      `toml:"volume,omitempty"`
+	VolumeRamp string   `toml:"volume_ramp,omitempty"`
+	Shuffle    bool     `toml:"shuffle,omitempty"`
+}
+
+// DeviceTargets expands a device alias or group name (case-insensitive) to
+// the device names it stands for. Anything else is returned unchanged.
+func (p Profile) DeviceTargets(name string) []string {
+	name = strings.TrimSpace(name)
+	for group, members := range p.DeviceGroups {
+		if strings.EqualFold(group, name) && len(members) > 0 {
+			targets := make([]string, 0, len(members))
+			for _, member := range members {
+				targets = append(targets, p.deviceAlias(member))
+			}
+			return targets
+		}
+	}
+	return []string{p.deviceAlias(name)}
+}
+
+// IsDeviceGroup reports whether name (case-insensitive) is a device group.
+func (p Profile) IsDeviceGroup(name string) bool {
+	name = strings.TrimSpace(name)
+	for group, members := range p.DeviceGroups {
+		if strings.EqualFold(group, name) && len(members) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateDevice rejects a group as the profile's device: engines target one
+// device, and silently picking a member would hide the mistake.
+func (p Profile) ValidateDevice(name string) error {
+	if p.IsDeviceGroup(name) {
+		return fmt.Errorf("device %q is a device group; use a single device or alias", strings.TrimSpace(name))
+	}
+	return nil
+}
+
+func (p Profile) deviceAlias(name string) string {
+	for alias, target := range p.Devices {
+		if strings.EqualFold(alias, name) && target != "" {
+			return target
+		}
+	}
+	return name
 }
 
 func DefaultPath() (string, error) {
@@ -139,6 +204,17 @@ func CachePath(configPath, profile string) string {
 	return filepath.Join(base, "cache", profile+".json")
 }
 
+func StatePath(configPath, profile string) string {
+	if profile == "" {
+		profile = DefaultProfile
+	}
+	if configPath == "" {
+		return ""
+	}
+	base := filepath.Dir(configPath)
+	return filepath.Join(base, "state", profile+".json")
+}
+
 func (c *Config) normalize() {
 	if c.DefaultProfile == "" {
 		c.DefaultProfile = DefaultProfile
@@ -3,6 +3,8 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -87,6 +89,16 @@ func TestCachePathEmptyConfig(t *testing.T) {
 	}
 }
 
+func TestStatePath(t *testing.T) {
+	path := StatePath("/tmp/spogo/config.toml", "")
+	if filepath.Base(path) != "default.json" || filepath.Base(filepath.Dir(path)) != "state" {
+		t.Fatalf("state path: %s", path)
+	}
+	if StatePath("", "default") != "" {
+		t.Fatalf("expected empty")
+	}
+}
+
 func TestLoadInvalid(t *testing.T) {
 	dir := t.TempDir()
 	path := filepath.Join(dir, "bad.toml")
@@ -133,7 +145,7 @@ func TestSaveInvalidDir(t *testing.T) {
 
 func TestProfileNilConfig(t *testing.T) {
 	var cfg *Config
-	if p := cfg.Profile("default"); p != (Profile{}) {
+	if p := cfg.Profile("default"); !reflect.DeepEqual(p, Profile{}) {
 		t.Fatalf("expected empty profile")
 	}
 }
@@ -164,7 +176,7 @@ func TestSetProfileNilConfig(t *testing.T) {
 
 func TestProfileNilMap(t *testing.T) {
 	cfg := &Config{DefaultProfile: DefaultProfile}
-	if cfg.Profile("default") != (Profile{}) {
+	if !reflect.DeepEqual(cfg.Profile("default"), Profile{}) {
 		t.Fatalf("expected empty profile")
 	}
 }
@@ -185,3 +197,58 @@ func TestNormalize(t *testing.T) {
 		t.Fatalf("expected defaults")
 	}
 }
+
+func TestDeviceTargets(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	data := "[profile.default.devices]\nkitchen = \"Echo Kitchen\"\n\n[profile.default.device_groups]\noffice = [\"kitchen\", \"Desk B\"]\nempty = []\n"
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	profile := cfg.Profile("default")
+	cases := map[string]string{
+		"Kitchen":     "Echo Kitchen",
+		"OFFICE":      "Echo Kitchen,Desk B",
+		"empty":       "empty",
+		" Laptop ":    "Laptop",
+		"Echo Kitche": "Echo Kitche",
+	}
+	for input, want := range cases {
+		if got := strings.Join(profile.DeviceTargets(input), ","); got != want {
+			t.Fatalf("DeviceTargets(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestProfileDeviceValidation(t *testing.T) {
+	profile := Profile{
+		Devices:      map[string]string{"kitchen": "Echo Kitchen"},
+		DeviceGroups: map[string][]string{"office": {"kitchen", "Desk B"}, "empty": {}},
+	}
+	if err := profile.SetSetting("device", "Office"); err == nil || !strings.Contains(err.Error(), "device group") || profile.Device != "" {
+		t.Fatalf("expected group rejection, got %v (device %q)", err, profile.Device)
+	}
+	if err := profile.SetSetting("device", "kitchen"); err != nil || profile.Validate() != nil {
+		t.Fatalf("alias should be accepted: %v", err)
+	}
+	profile.Device = "office"
+	if err := profile.Validate(); err == nil {
+		t.Fatalf("expected group rejection from Validate")
+	}
+	profile.Device = "empty"
+	if err := profile.Validate(); err != nil {
+		t.Fatalf("empty group is not a group: %v", err)
+	}
+	profile.Devices["Kitchen"] = "Kitchen Sonos"
+	if err := profile.Validate(); err == nil || !strings.Contains(err.Error(), `devices: "Kitchen" and "kitchen" differ only in case`) {
+		t.Fatalf("expected alias case clash, got %v", err)
+	}
+	delete(profile.Devices, "Kitchen")
+	profile.DeviceGroups["OFFICE"] = []string{"Desk A"}
+	if err := profile.Validate(); err == nil || !strings.Contains(err.Error(), "device_groups") {
+		t.Fatalf("expected group case clash, got %v", err)
+	}
+}
@@ -0,0 +1,127 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// ValidateProfileName rejects names that cannot double as file names for
+// the per-profile cookie, cache and state files.
+func ValidateProfileName(name string) error {
+	if name == "" {
+		return errors.New("profile name required")
+	}
+	if strings.HasPrefix(name, ".") {
+		return fmt.Errorf("invalid profile name %q: must not start with a dot", name)
+	}
+	for _, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == '.':
+		default:
+			return fmt.Errorf("invalid profile name %q: use letters, digits, '-', '_' or '.'", name)
+		}
+	}
+	return nil
+}
+
+// ProfileNames lists the configured profiles, sorted. The default profile
+// is included even before anything was saved for it.
+func (c *Config) ProfileNames() []string {
+	if c == nil {
+		return nil
+	}
+	names := make([]string, 0, len(c.Profiles)+1)
+	for name := range c.Profiles {
+		names = append(names, name)
+	}
+	if _, ok := c.Profiles[c.DefaultProfile]; !ok && c.DefaultProfile != "" {
+		names = append(names, c.DefaultProfile)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// HasProfile reports whether name is configured or is the default profile.
+func (c *Config) HasProfile(name string) bool {
+	if c == nil {
+		return false
+	}
+	if name == c.DefaultProfile {
+		return true
+	}
+	_, ok := c.Profiles[name]
+	return ok
+}
+
+// ProfileFiles are the cookie, cache and state files spogo keeps next to
+// the config for a profile, in that order.
+func ProfileFiles(configPath, profile string) []string {
+	return []string{
+		CookiePath(configPath, profile),
+		CachePath(configPath, profile),
+		StatePath(configPath, profile),
+	}
+}
+
+// MoveProfileFiles renames from's files to to's. It checks every target
+// first and refuses to overwrite, so a conflict moves nothing.
+func MoveProfileFiles(configPath, from, to string) error {
+	src := ProfileFiles(configPath, from)
+	dst := ProfileFiles(configPath, to)
+	for i := range src {
+		if !FileExists(src[i]) {
+			continue
+		}
+		if FileExists(dst[i]) {
+			return fmt.Errorf("%s already exists", dst[i])
+		}
+	}
+	for i := range src {
+		if !FileExists(src[i]) {
+			continue
+		}
+		if err := os.MkdirAll(filepath.Dir(dst[i]), 0o700); err != nil {
+			return err
+		}
+		if err := os.Rename(src[i], dst[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CopyFile copies src to dst with owner-only permissions, refusing to
+// overwrite dst.
+func CopyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = in.Close() }()
+	if err := os.MkdirAll(filepath.Dir(dst), 0o700); err != nil {
+		return err
+	}
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// FileExists reports whether path names an existing file.
+func FileExists(path string) bool {
+	if path == "" {
+		return false
+	}
+	_, err := os.Stat(path)
+	return err == nil
+}
@@ -0,0 +1,74 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestValidateProfileName(t *testing.T) {
+	for _, name := range []string{"default", "work-2", "a_b.c"} {
+		if err := ValidateProfileName(name); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+	}
+	for _, name := range []string{"", ".hidden", "a/b", "with space", "..", "ü"} {
+		if err := ValidateProfileName(name); err == nil {
+			t.Fatalf("%q: expected error", name)
+		}
+	}
+}
+
+func TestProfileNames(t *testing.T) {
+	cfg := Default()
+	cfg.SetProfile("work", Profile{})
+	cfg.SetProfile("alt", Profile{})
+	if got := cfg.ProfileNames(); !reflect.DeepEqual(got, []string{"alt", "default", "work"}) {
+		t.Fatalf("names %q", got)
+	}
+	if !cfg.HasProfile("default") || !cfg.HasProfile("alt") || cfg.HasProfile("missing") {
+		t.Fatalf("has profile mismatch")
+	}
+	var nilCfg *Config
+	if nilCfg.ProfileNames() != nil || nilCfg.HasProfile("default") {
+		t.Fatalf("nil config")
+	}
+}
+
+func TestMoveProfileFiles(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.toml")
+	from := ProfileFiles(configPath, "old")
+	for _, path := range from[:2] {
+		if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+		if err := os.WriteFile(path, []byte("x"), 0o600); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+	if err := MoveProfileFiles(configPath, "old", "new"); err != nil {
+		t.Fatalf("move: %v", err)
+	}
+	to := ProfileFiles(configPath, "new")
+	if !FileExists(to[0]) || !FileExists(to[1]) || FileExists(to[2]) || FileExists(from[0]) {
+		t.Fatalf("files not moved")
+	}
+
+	if err := CopyFile(to[0], from[0]); err != nil {
+		t.Fatalf("copy: %v", err)
+	}
+	if err := CopyFile(to[0], from[0]); err == nil {
+		t.Fatalf("expected copy to refuse overwrite")
+	}
+	if err := CopyFile(filepath.Join(dir, "missing"), filepath.Join(dir, "x")); err == nil {
+		t.Fatalf("expected missing source error")
+	}
+	if err := MoveProfileFiles(configPath, "new", "old"); err == nil {
+		t.Fatalf("expected conflict")
+	}
+	if !FileExists(to[1]) {
+		t.Fatalf("conflict should move nothing")
+	}
+}
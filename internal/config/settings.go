@@ -0,0 +1,202 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Engine names; the app builds one Spotify client per engine.
+const (
+	EngineAuto        = "auto"
+	EngineWeb         = "web"
+	EngineConnect     = "connect"
+	EngineAppleScript = "applescript"
+)
+
+// Engines are the engine names the app knows how to build a client for.
+var Engines = []string{EngineAuto, EngineWeb, EngineConnect, EngineAppleScript}
+
+// CookieStores are the places a profile's cookies and token cache can live:
+// plaintext files next to the config, or the OS keyring.
+var CookieStores = []string{"file", "keyring"}
+
+// SettingKeys are the scalar profile settings `config get/set/unset` accept,
+// named as in config.toml.
+var SettingKeys = []string{
+	"browser",
+	"browser_profile",
+	"cookie_path",
+	"cookie_store",
+	"device",
+	"engine",
+	"language",
+	"market",
+	"wait_device",
+}
+
+// languageTag is a loose BCP 47 check: a 2-3 letter language followed by
+// script, region or variant subtags.
+var languageTag = regexp.MustCompile(`^[A-Za-z]{2,3}(-[A-Za-z0-9]{1,8})*$`)
+
+// Setting returns the value of a scalar setting.
+func (p Profile) Setting(key string) (string, error) {
+	field, err := p.setting(key)
+	if err != nil {
+		return "", err
+	}
+	return *field, nil
+}
+
+// SetSetting validates and stores a scalar setting. An empty value unsets
+// it. Markets are upper-cased and engines lower-cased on the way in.
+// cookie_store is read-only here: switching it without moving the cookies
+// would strand them, so that goes through auth migrate.
+func (p *Profile) SetSetting(key, value string) error {
+	field, err := p.setting(key)
+	if err != nil {
+		return err
+	}
+	if key == "cookie_store" {
+		return errors.New("cookie_store cannot be set directly; run spogo auth migrate --to keyring|file to move the cookies")
+	}
+	value = strings.TrimSpace(value)
+	switch key {
+	case "market":
+		value = strings.ToUpper(value)
+	case "engine":
+		value = strings.ToLower(value)
+	}
+	if err := ValidateSetting(key, value); err != nil {
+		return err
+	}
+	if key == "device" {
+		if err := p.ValidateDevice(value); err != nil {
+			return err
+		}
+	}
+	*field = value
+	return nil
+}
+
+// Validate checks every scalar setting, so a hand-edited config fails when
+// it is written rather than at the next command.
+func (p Profile) Validate() error {
+	for _, key := range SettingKeys {
+		value, _ := p.Setting(key)
+		if err := ValidateSetting(key, value); err != nil {
+			return err
+		}
+	}
+	if err := p.ValidateDevice(p.Device); err != nil {
+		return err
+	}
+	// Aliases and groups match case-insensitively, so keys that differ only
+	// in case would resolve in map order.
+	if err := uniqueFold("devices", slices.Collect(maps.Keys(p.Devices))); err != nil {
+		return err
+	}
+	return uniqueFold("device_groups", slices.Collect(maps.Keys(p.DeviceGroups)))
+}
+
+func uniqueFold(table string, keys []string) error {
+	slices.Sort(keys)
+	seen := map[string]string{}
+	for _, key := range keys {
+		folded := strings.ToLower(key)
+		if other, ok := seen[folded]; ok {
+			return fmt.Errorf("%s: %q and %q differ only in case", table, other, key)
+		}
+		seen[folded] = key
+	}
+	return nil
+}
+
+// ValidateSetting checks one value; empty values are always allowed.
+func ValidateSetting(key, value string) error {
+	if value == "" {
+		return nil
+	}
+	switch key {
+	case "market":
+		if len(value) != 2 || !isLetters(value) {
+			return fmt.Errorf("market must be 2-letter country code, got %q", value)
+		}
+	case "engine":
+		return oneOf("engine", value, Engines)
+	case "cookie_store":
+		return oneOf("cookie_store", value, CookieStores)
+	case "language":
+		if !languageTag.MatchString(value) {
+			return fmt.Errorf("language must be a BCP 47 tag like en or pt-BR, got %q", value)
+		}
+	case "wait_device":
+		if wait, err := time.ParseDuration(value); err != nil || wait < 0 {
+			return fmt.Errorf("wait_device must be a duration like 20s")
+		}
+	}
+	return nil
+}
+
+func (p *Profile) setting(key string) (*string, error) {
+	switch key {
+	case "browser":
+		return &p.Browser, nil
+	case "browser_profile":
+		return &p.BrowserProfile, nil
+	case "cookie_path":
+		return &p.CookiePath, nil
+	case "cookie_store":
+		return &p.CookieStore, nil
+	case "device":
+		return &p.Device, nil
+	case "engine":
+		return &p.Engine, nil
+	case "language":
+		return &p.Language, nil
+	case "market":
+		return &p.Market, nil
+	case "wait_device":
+		return &p.WaitDevice, nil
+	default:
+		return nil, fmt.Errorf("unknown setting %q (use %s)", key, strings.Join(SettingKeys, ", "))
+	}
+}
+
+func oneOf(key, value string, allowed []string) error {
+	for _, candidate := range allowed {
+		if strings.EqualFold(value, candidate) {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown %s %q (use %s)", key, value, strings.Join(allowed, ", "))
+}
+
+func isLetters(value string) bool {
+	for _, r := range value {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate checks the default profile name and every profile's settings.
+func (c *Config) Validate() error {
+	if err := ValidateProfileName(c.DefaultProfile); err != nil {
+		return fmt.Errorf("default_profile: %w", err)
+	}
+	for _, name := range c.ProfileNames() {
+		if err := ValidateProfileName(name); err != nil {
+			return err
+		}
+		if err := c.Profile(name).Validate(); err != nil {
+			return fmt.Errorf("profile %s: %w", name, err)
+		}
+	}
+	return nil
+}
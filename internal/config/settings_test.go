@@ -0,0 +1,90 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestProfileSettings(t *testing.T) {
+	var profile Profile
+	if err := profile.SetSetting("market", "de"); err != nil || profile.Market != "DE" {
+		t.Fatalf("market %q %v", profile.Market, err)
+	}
+	if err := profile.SetSetting("engine", " Web "); err != nil || profile.Engine != "web" {
+		t.Fatalf("engine %q %v", profile.Engine, err)
+	}
+	for _, key := range SettingKeys {
+		if _, err := profile.Setting(key); err != nil {
+			t.Fatalf("%s: %v", key, err)
+		}
+	}
+	if err := profile.SetSetting("market", ""); err != nil || profile.Market != "" {
+		t.Fatalf("unset %q %v", profile.Market, err)
+	}
+	if _, err := profile.Setting("volume"); err == nil || !strings.Contains(err.Error(), "unknown setting") {
+		t.Fatalf("expected unknown setting, got %v", err)
+	}
+	if err := profile.SetSetting("volume", "1"); err == nil {
+		t.Fatalf("expected unknown setting")
+	}
+	profile.CookieStore = "keyring"
+	for _, value := range []string{"file", ""} {
+		if err := profile.SetSetting("cookie_store", value); err == nil || !strings.Contains(err.Error(), "auth migrate") || profile.CookieStore != "keyring" {
+			t.Fatalf("cookie_store %q should be refused, got %v", value, err)
+		}
+	}
+	if value, err := profile.Setting("cookie_store"); err != nil || value != "keyring" {
+		t.Fatalf("cookie_store get %q %v", value, err)
+	}
+}
+
+func TestValidateSetting(t *testing.T) {
+	valid := map[string][]string{
+		"market":      {"US", "de"},
+		"engine":      {"auto", "web", "connect", "applescript", "Connect"},
+		"language":    {"en", "pt-BR", "zh-Hant-TW"},
+		"wait_device": {"20s", "0s"},
+		"browser":     {"firefox"},
+	}
+	for key, values := range valid {
+		for _, value := range values {
+			if err := ValidateSetting(key, value); err != nil {
+				t.Fatalf("%s=%q: %v", key, value, err)
+			}
+		}
+	}
+	invalid := map[string][]string{
+		"market":      {"USA", "1A"},
+		"engine":      {"conect"},
+		"language":    {"en_US", "english", "e"},
+		"wait_device": {"soon", "-1s"},
+	}
+	for key, values := range invalid {
+		for _, value := range values {
+			if err := ValidateSetting(key, value); err == nil {
+				t.Fatalf("%s=%q: expected error", key, value)
+			}
+		}
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	cfg := Default()
+	cfg.SetProfile("work", Profile{Engine: "web", Language: "en-GB"})
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+	cfg.SetProfile("work", Profile{Engine: "webb"})
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "profile work") {
+		t.Fatalf("expected profile error, got %v", err)
+	}
+	cfg = Default()
+	cfg.SetProfile("bad name", Profile{})
+	if err := cfg.Validate(); err == nil {
+		t.Fatalf("expected name error")
+	}
+	cfg.DefaultProfile = ".x"
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "default_profile") {
+		t.Fatalf("expected default_profile error, got %v", err)
+	}
+}
@@ -6,10 +6,12 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"os"
 	"slices"
 	"strings"
 	"time"
 
+	"github.com/steipete/spogo/internal/secrets"
 	"github.com/steipete/sweetcookie"
 )
 
@@ -43,6 +45,14 @@ type FileSource struct {
 	Path string
 }
 
+// StoreSource reads cookies saved under Key in a secrets store, falling
+// back to Fallback (typically the browser) when nothing is stored.
+type StoreSource struct {
+	Store    secrets.Store
+	Key      string
+	Fallback Source
+}
+
 func (s BrowserSource) Cookies(ctx context.Context) ([]*http.Cookie, error) {
 	result, err := readCookies(ctx, s.cookieOptions(false))
 	if err != nil {
@@ -176,3 +186,14 @@ func (s FileSource) Cookies(ctx context.Context) ([]*http.Cookie, error) {
 	_ = ctx
 	return Read(s.Path)
 }
+
+func (s StoreSource) Cookies(ctx context.Context) ([]*http.Cookie, error) {
+	data, err := s.Store.Get(s.Key)
+	if errors.Is(err, os.ErrNotExist) && s.Fallback != nil {
+		return s.Fallback.Cookies(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return Decode(data)
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"os"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/steipete/spogo/internal/secrets"
 	"github.com/steipete/sweetcookie"
 )
 
@@ -200,3 +202,34 @@ func TestFileSourceError(t *testing.T) {
 		t.Fatalf("expected error")
 	}
 }
+
+func TestStoreSource(t *testing.T) {
+	store := &secrets.Memory{}
+	fallback := FileSource{Path: "/nope/missing.json"}
+	src := StoreSource{Store: store, Key: "cookies/work", Fallback: fallback}
+	if _, err := src.Cookies(context.Background()); err == nil || !strings.Contains(err.Error(), "missing.json") {
+		t.Fatalf("expected fallback error, got %v", err)
+	}
+	data, err := Encode([]*http.Cookie{{Name: "sp_dc", Value: "token"}, nil})
+	if err != nil || strings.Contains(string(data), "\n") {
+		t.Fatalf("encode %q %v", data, err)
+	}
+	if err := store.Set("cookies/work", data); err != nil {
+		t.Fatalf("set: %v", err)
+	}
+	cookies, err := src.Cookies(context.Background())
+	if err != nil || len(cookies) != 1 || cookies[0].Value != "token" {
+		t.Fatalf("cookies %#v %v", cookies, err)
+	}
+	if err := store.Set("cookies/work", []byte("{")); err != nil {
+		t.Fatalf("set: %v", err)
+	}
+	if _, err := src.Cookies(context.Background()); err == nil {
+		t.Fatalf("expected decode error")
+	}
+	src.Store = &secrets.Memory{}
+	src.Fallback = nil
+	if _, err := src.Cookies(context.Background()); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected not exist, got %v", err)
+	}
+}
@@ -27,6 +27,31 @@ func Read(path string) ([]*http.Cookie, error) {
 	if err != nil {
 		return nil, err
 	}
+	return Decode(data)
+}
+
+func Write(path string, cookies []*http.Cookie) error {
+	if path == "" {
+		return errors.New("cookie path required")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(storedCookies(cookies), "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
+// Encode is the compact form kept in a secrets.Store; keyrings cap entry
+// sizes, so it skips the indentation Write uses.
+func Encode(cookies []*http.Cookie) ([]byte, error) {
+	return json.Marshal(storedCookies(cookies))
+}
+
+// Decode reads either form.
+func Decode(data []byte) ([]*http.Cookie, error) {
 	var stored []StoredCookie
 	if err := json.Unmarshal(data, &stored); err != nil {
 		return nil, err
@@ -46,13 +71,7 @@ func Read(path string) ([]*http.Cookie, error) {
 	return cookies, nil
 }
 
-func Write(path string, cookies []*http.Cookie) error {
-	if path == "" {
-		return errors.New("cookie path required")
-	}
-	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
-		return err
-	}
+func storedCookies(cookies []*http.Cookie) []StoredCookie {
 	stored := make([]StoredCookie, 0, len(cookies))
 	for _, c := range cookies {
 		if c == nil {
@@ -68,9 +87,5 @@ func Write(path string, cookies []*http.Cookie) error {
 			HTTPOnly: c.HttpOnly,
 		})
 	}
-	data, err := json.MarshalIndent(stored, "", "  ")
-	if err != nil {
-		return err
-	}
-	return os.WriteFile(path, data, 0o600)
+	return stored
 }
@@ -0,0 +1,221 @@
+package secrets
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/steipete/spogo/internal/config"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	fileMagic = "spogo-secret-v1\n"
+	saltSize  = 16
+)
+
+// scrypt cost; tests lower it.
+var scryptN = 1 << 15
+
+// EncryptedFile stores each secret as <Dir>/<key>.enc, sealed with
+// AES-256-GCM under a key derived from a passphrase with scrypt.
+// Passphrase is only called when a file is actually read or written, and
+// at most once per EncryptedFile; the first Set checks it against an
+// existing secret.
+type EncryptedFile struct {
+	Dir        string
+	Passphrase func() (string, error)
+
+	once       sync.Once
+	passphrase string
+	err        error
+	checkOnce  sync.Once
+	checkErr   error
+}
+
+func (f *EncryptedFile) Get(key string) ([]byte, error) {
+	path, err := f.path(key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return open(path, key, data, f.resolvePassphrase)
+}
+
+func (f *EncryptedFile) Set(key string, data []byte) error {
+	path, err := f.path(key)
+	if err != nil {
+		return err
+	}
+	if err := f.checkPassphrase(); err != nil {
+		return err
+	}
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return err
+	}
+	aead, err := f.cipher(salt)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+	out := append([]byte(fileMagic), salt...)
+	out = append(out, nonce...)
+	out = aead.Seal(out, nonce, data, []byte(key))
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	return os.WriteFile(path, out, 0o600)
+}
+
+func (f *EncryptedFile) Delete(key string) error {
+	path, err := f.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (f *EncryptedFile) Has(key string) bool {
+	path, err := f.path(key)
+	if err != nil {
+		return false
+	}
+	_, err = os.Stat(path)
+	return err == nil
+}
+
+// Empty reports whether no secret has been sealed yet, so the next
+// passphrase becomes the one every later read needs.
+func (f *EncryptedFile) Empty() bool {
+	return f.firstFile() == ""
+}
+
+func (f *EncryptedFile) firstFile() string {
+	found := ""
+	_ = filepath.WalkDir(f.Dir, func(path string, entry fs.DirEntry, err error) error {
+		if err == nil && !entry.IsDir() && strings.HasSuffix(path, ".enc") {
+			found = path
+			return fs.SkipAll
+		}
+		return nil
+	})
+	return found
+}
+
+// checkPassphrase opens one existing secret before the first Set, so a
+// mistyped passphrase is refused instead of sealing new secrets under it.
+func (f *EncryptedFile) checkPassphrase() error {
+	f.checkOnce.Do(func() {
+		var passphrase string
+		passphrase, f.checkErr = f.resolvePassphrase()
+		path := f.firstFile()
+		if f.checkErr != nil || path == "" {
+			return
+		}
+		rel, err := filepath.Rel(f.Dir, path)
+		if err != nil {
+			f.checkErr = err
+			return
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			f.checkErr = err
+			return
+		}
+		key := strings.TrimSuffix(filepath.ToSlash(rel), ".enc")
+		_, f.checkErr = open(path, key, data, func() (string, error) { return passphrase, nil })
+	})
+	return f.checkErr
+}
+
+// path maps a "<kind>/<profile>" key to its file under Dir. Both parts
+// must be valid profile names, so a key can never reach outside Dir.
+func (f *EncryptedFile) path(key string) (string, error) {
+	kind, name, ok := strings.Cut(key, "/")
+	if !ok {
+		return "", fmt.Errorf("invalid secret key %q", key)
+	}
+	for _, part := range []string{kind, name} {
+		if err := config.ValidateProfileName(part); err != nil {
+			return "", fmt.Errorf("invalid secret key %q: %w", key, err)
+		}
+	}
+	return filepath.Join(f.Dir, kind, name+".enc"), nil
+}
+
+func (f *EncryptedFile) cipher(salt []byte) (cipher.AEAD, error) {
+	passphrase, err := f.resolvePassphrase()
+	if err != nil {
+		return nil, err
+	}
+	return newCipher(passphrase, salt)
+}
+
+// open unseals data read from path for key, asking for the passphrase only
+// once the file looks like a spogo secret.
+func open(path, key string, data []byte, passphrase func() (string, error)) ([]byte, error) {
+	if len(data) < len(fileMagic)+saltSize || string(data[:len(fileMagic)]) != fileMagic {
+		return nil, fmt.Errorf("%s: not a spogo secret file", path)
+	}
+	data = data[len(fileMagic):]
+	secret, err := passphrase()
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newCipher(secret, data[:saltSize])
+	if err != nil {
+		return nil, err
+	}
+	data = data[saltSize:]
+	if len(data) < aead.NonceSize() {
+		return nil, fmt.Errorf("%s: truncated", path)
+	}
+	plain, err := aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], []byte(key))
+	if err != nil {
+		return nil, fmt.Errorf("%s: wrong passphrase or corrupted file", path)
+	}
+	return plain, nil
+}
+
+func newCipher(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, scryptN, 8, 1, 32)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func (f *EncryptedFile) resolvePassphrase() (string, error) {
+	f.once.Do(func() {
+		if f.Passphrase == nil {
+			f.err = errors.New("no passphrase for encrypted secrets")
+			return
+		}
+		f.passphrase, f.err = f.Passphrase()
+		if f.err == nil && f.passphrase == "" {
+			f.err = errors.New("empty passphrase for encrypted secrets")
+		}
+	})
+	return f.passphrase, f.err
+}
@@ -0,0 +1,49 @@
+package secrets
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/zalando/go-keyring"
+)
+
+// Keyring stores secrets in the OS secret service (macOS Keychain, Windows
+// Credential Manager, Secret Service on Linux) under one service name.
+type Keyring struct {
+	Service string
+}
+
+func (k Keyring) Get(key string) ([]byte, error) {
+	value, err := keyring.Get(k.Service, key)
+	if err != nil {
+		return nil, keyringError(key, err)
+	}
+	return []byte(value), nil
+}
+
+func (k Keyring) Set(key string, data []byte) error {
+	if err := keyring.Set(k.Service, key, string(data)); err != nil {
+		return keyringError(key, err)
+	}
+	return nil
+}
+
+func (k Keyring) Delete(key string) error {
+	if err := keyring.Delete(k.Service, key); err != nil {
+		return keyringError(key, err)
+	}
+	return nil
+}
+
+func (k Keyring) Has(key string) bool {
+	_, err := keyring.Get(k.Service, key)
+	return err == nil
+}
+
+func keyringError(key string, err error) error {
+	if errors.Is(err, keyring.ErrNotFound) {
+		return fmt.Errorf("keyring %s: %w", key, os.ErrNotExist)
+	}
+	return fmt.Errorf("keyring %s: %w", key, err)
+}
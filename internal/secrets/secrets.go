@@ -0,0 +1,115 @@
+// Package secrets keeps small per-profile secrets (cookie jars, token
+// caches) out of plaintext files: in the OS keyring when there is one, and
+// in passphrase-encrypted files when there is not.
+package secrets
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// Store holds secrets by key. Get returns an error matching os.ErrNotExist
+// when nothing is stored; Has answers without unlocking anything.
+type Store interface {
+	Get(key string) ([]byte, error)
+	Set(key string, data []byte) error
+	Delete(key string) error
+	Has(key string) bool
+}
+
+// CookieKey and CacheKey name a profile's cookie jar and Connect token
+// cache.
+func CookieKey(profile string) string {
+	return "cookies/" + profile
+}
+
+func CacheKey(profile string) string {
+	return "cache/" + profile
+}
+
+// Fallback writes to Primary and reads from it first, using Secondary when
+// Primary is unavailable (no secret service, locked keyring, value too big)
+// or does not have the key.
+type Fallback struct {
+	Primary   Store
+	Secondary Store
+}
+
+func (f Fallback) Get(key string) ([]byte, error) {
+	data, err := f.Primary.Get(key)
+	if err == nil {
+		return data, nil
+	}
+	return f.Secondary.Get(key)
+}
+
+func (f Fallback) Set(key string, data []byte) error {
+	if err := f.Primary.Set(key, data); err != nil {
+		return f.Secondary.Set(key, data)
+	}
+	// Drop a copy written while the primary was unavailable, so Get cannot
+	// return it once the primary loses the key again.
+	_ = f.Secondary.Delete(key)
+	return nil
+}
+
+func (f Fallback) Has(key string) bool {
+	return f.Primary.Has(key) || f.Secondary.Has(key)
+}
+
+func (f Fallback) Delete(key string) error {
+	err := f.Secondary.Delete(key)
+	if primaryErr := f.Primary.Delete(key); primaryErr != nil && !errors.Is(primaryErr, os.ErrNotExist) {
+		// An unavailable primary holds nothing; only report failures that
+		// leave the secret behind.
+		if _, getErr := f.Primary.Get(key); getErr == nil {
+			return primaryErr
+		}
+	}
+	return err
+}
+
+// Memory is an in-process Store, for tests.
+type Memory struct {
+	mu      sync.Mutex
+	secrets map[string][]byte
+}
+
+func (m *Memory) Get(key string) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	data, ok := m.secrets[key]
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", key, os.ErrNotExist)
+	}
+	return append([]byte(nil), data...), nil
+}
+
+func (m *Memory) Set(key string, data []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.secrets == nil {
+		m.secrets = map[string][]byte{}
+	}
+	m.secrets[key] = append([]byte(nil), data...)
+	return nil
+}
+
+func (m *Memory) Delete(key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.secrets[key]; !ok {
+		return fmt.Errorf("%s: %w", key, os.ErrNotExist)
+	}
+	delete(m.secrets, key)
+	return nil
+}
+
+func (m *Memory) Has(key string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.secrets[key]
+	return ok
+}
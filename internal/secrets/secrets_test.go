@@ -0,0 +1,206 @@
+package secrets
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/zalando/go-keyring"
+)
+
+func init() {
+	scryptN = 1 << 10
+}
+
+func TestEncryptedFileRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	asked := 0
+	store := &EncryptedFile{Dir: dir, Passphrase: func() (string, error) {
+		asked++
+		return "hunter2", nil
+	}}
+	if _, err := store.Get("cookies/work"); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected not exist, got %v", err)
+	}
+	if asked != 0 {
+		t.Fatalf("missing files should not ask for the passphrase")
+	}
+	if !store.Empty() || !(&EncryptedFile{Dir: filepath.Join(dir, "missing")}).Empty() {
+		t.Fatalf("expected empty store")
+	}
+	if err := store.Set("cookies/work", []byte(`[{"name":"sp_dc"}]`)); err != nil {
+		t.Fatalf("set: %v", err)
+	}
+	if store.Empty() {
+		t.Fatalf("expected sealed secret")
+	}
+	path := filepath.Join(dir, "cookies", "work.enc")
+	raw, err := os.ReadFile(path)
+	if err != nil || strings.Contains(string(raw), "sp_dc") {
+		t.Fatalf("file not encrypted: %q %v", raw, err)
+	}
+	if info, _ := os.Stat(path); info.Mode().Perm() != 0o600 {
+		t.Fatalf("mode %v", info.Mode())
+	}
+	data, err := store.Get("cookies/work")
+	if err != nil || string(data) != `[{"name":"sp_dc"}]` || asked != 1 {
+		t.Fatalf("get %q %v asked=%d", data, err, asked)
+	}
+
+	wrong := &EncryptedFile{Dir: dir, Passphrase: func() (string, error) { return "nope", nil }}
+	if _, err := wrong.Get("cookies/work"); err == nil || !strings.Contains(err.Error(), "wrong passphrase") {
+		t.Fatalf("expected wrong passphrase, got %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "cookies", "moved.enc"), raw, 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if _, err := store.Get("cookies/moved"); err == nil {
+		t.Fatalf("a file sealed for another key must not open")
+	}
+	if err := os.WriteFile(path, []byte("plain"), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if _, err := store.Get("cookies/work"); err == nil || !strings.Contains(err.Error(), "not a spogo secret") {
+		t.Fatalf("expected format error, got %v", err)
+	}
+	if err := store.Delete("cookies/work"); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if err := store.Delete("cookies/work"); err != nil {
+		t.Fatalf("delete missing: %v", err)
+	}
+
+	for _, passphrase := range []func() (string, error){
+		nil,
+		func() (string, error) { return "", nil },
+		func() (string, error) { return "", errors.New("no tty") },
+	} {
+		if err := (&EncryptedFile{Dir: dir, Passphrase: passphrase}).Set("cookies/work", []byte("v")); err == nil {
+			t.Fatalf("expected passphrase error")
+		}
+	}
+}
+
+func TestEncryptedFileSetChecksPassphrase(t *testing.T) {
+	dir := t.TempDir()
+	if err := (&EncryptedFile{Dir: dir, Passphrase: func() (string, error) { return "hunter2", nil }}).Set("cookies/work", []byte("v")); err != nil {
+		t.Fatalf("set: %v", err)
+	}
+	wrong := &EncryptedFile{Dir: dir, Passphrase: func() (string, error) { return "hunter3", nil }}
+	if err := wrong.Set("cache/work", []byte("v")); err == nil || !strings.Contains(err.Error(), "wrong passphrase") {
+		t.Fatalf("expected wrong passphrase, got %v", err)
+	}
+	if wrong.Has("cache/work") {
+		t.Fatalf("sealed a secret under the wrong passphrase")
+	}
+	right := &EncryptedFile{Dir: dir, Passphrase: func() (string, error) { return "hunter2", nil }}
+	if err := right.Set("cache/work", []byte("v")); err != nil {
+		t.Fatalf("set: %v", err)
+	}
+}
+
+func TestEncryptedFileRejectsEscapingKeys(t *testing.T) {
+	dir := t.TempDir()
+	store := &EncryptedFile{Dir: filepath.Join(dir, "secrets"), Passphrase: func() (string, error) { return "hunter2", nil }}
+	for _, key := range []string{"cookies/../../x", "cookies/a/b", "../cookies/x", "cookies/", "work"} {
+		if err := store.Set(key, []byte("v")); err == nil {
+			t.Fatalf("%s: expected invalid key", key)
+		}
+		if _, err := store.Get(key); err == nil || errors.Is(err, os.ErrNotExist) {
+			t.Fatalf("%s: expected invalid key, got %v", key, err)
+		}
+		if err := store.Delete(key); err == nil {
+			t.Fatalf("%s: expected invalid key", key)
+		}
+		if store.Has(key) {
+			t.Fatalf("%s: unexpected Has", key)
+		}
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Fatalf("wrote outside the store: %v", entries)
+	}
+}
+
+func TestKeyring(t *testing.T) {
+	keyring.MockInit()
+	store := Keyring{Service: "spogo-test"}
+	if _, err := store.Get("cookies/work"); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected not exist, got %v", err)
+	}
+	if err := store.Set("cookies/work", []byte("secret")); err != nil {
+		t.Fatalf("set: %v", err)
+	}
+	if data, err := store.Get("cookies/work"); err != nil || string(data) != "secret" {
+		t.Fatalf("get %q %v", data, err)
+	}
+	if err := store.Delete("cookies/work"); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if err := store.Delete("cookies/work"); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected not exist, got %v", err)
+	}
+}
+
+func TestFallback(t *testing.T) {
+	dir := t.TempDir()
+	file := &EncryptedFile{Dir: dir, Passphrase: func() (string, error) { return "pw", nil }}
+	store := Fallback{Primary: Keyring{Service: "spogo-test"}, Secondary: file}
+
+	keyring.MockInitWithError(errors.New("no secret service"))
+	if err := store.Set("cache/work", []byte("tokens")); err != nil {
+		t.Fatalf("set: %v", err)
+	}
+	if data, err := store.Get("cache/work"); err != nil || string(data) != "tokens" {
+		t.Fatalf("get %q %v", data, err)
+	}
+	if err := store.Delete("cache/work"); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "cache", "work.enc")); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected file removed, got %v", err)
+	}
+
+	keyring.MockInit()
+	if err := file.Set("cache/work", []byte("stale")); err != nil {
+		t.Fatalf("seed: %v", err)
+	}
+	if data, _ := store.Get("cache/work"); string(data) != "stale" {
+		t.Fatalf("expected secondary copy, got %q", data)
+	}
+	if err := store.Set("cache/work", []byte("fresh")); err != nil {
+		t.Fatalf("set: %v", err)
+	}
+	if _, err := file.Get("cache/work"); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected stale copy dropped, got %v", err)
+	}
+	if data, _ := store.Get("cache/work"); string(data) != "fresh" {
+		t.Fatalf("get %q", data)
+	}
+	if err := store.Delete("cache/work"); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if _, err := store.Get("cache/work"); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected not exist, got %v", err)
+	}
+}
+
+func TestMemory(t *testing.T) {
+	store := &Memory{}
+	if _, err := store.Get("k"); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected not exist, got %v", err)
+	}
+	if err := store.Set("k", []byte("v")); err != nil {
+		t.Fatalf("set: %v", err)
+	}
+	if data, err := store.Get("k"); err != nil || string(data) != "v" {
+		t.Fatalf("get %q %v", data, err)
+	}
+	if err := store.Delete("k"); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if err := store.Delete("k"); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected not exist, got %v", err)
+	}
+}
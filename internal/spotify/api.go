@@ -1,14 +1,19 @@
 package spotify
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type API interface {
 	Search(ctx context.Context, kind, query string, limit, offset int) (SearchResult, error)
 	GetTrack(ctx context.Context, id string) (Item, error)
 	GetAlbum(ctx context.Context, id string) (Item, error)
+	AlbumTracks(ctx context.Context, id string, limit, offset int) ([]Item, int, error)
 	GetArtist(ctx context.Context, id string) (Item, error)
 	GetPlaylist(ctx context.Context, id string) (Item, error)
 	GetShow(ctx context.Context, id string) (Item, error)
+	ShowEpisodes(ctx context.Context, id string, limit, offset int) ([]Item, int, error)
 	GetEpisode(ctx context.Context, id string) (Item, error)
 	Playback(ctx context.Context) (PlaybackStatus, error)
 	Play(ctx context.Context, uri string) error
@@ -25,6 +30,8 @@ type API interface {
 	Queue(ctx context.Context) (Queue, error)
 	LibraryTracks(ctx context.Context, limit, offset int) ([]Item, int, error)
 	LibraryAlbums(ctx context.Context, limit, offset int) ([]Item, int, error)
+	LibraryShows(ctx context.Context, limit, offset int) ([]Item, int, error)
+	LibraryEpisodes(ctx context.Context, limit, offset int) ([]Item, int, error)
 	LibraryModify(ctx context.Context, path string, ids []string, method string) error
 	FollowArtists(ctx context.Context, ids []string, method string) error
 	FollowedArtists(ctx context.Context, limit int, after string) ([]Item, int, string, error)
@@ -34,3 +41,87 @@ type API interface {
 	AddTracks(ctx context.Context, playlistID string, uris []string) error
 	RemoveTracks(ctx context.Context, playlistID string, uris []string) error
 }
+
+// ArtistCatalog browses an artist's catalog. It is optional because not
+// every engine can (AppleScript cannot); callers type-assert an API for it.
+type ArtistCatalog interface {
+	ArtistTopTracks(ctx context.Context, id string, limit int) ([]Item, error)
+	ArtistAlbums(ctx context.Context, id string, group AlbumGroup, limit, offset int) ([]Item, int, error)
+	RelatedArtists(ctx context.Context, id string, limit int) ([]Item, error)
+}
+
+// AudiobookCatalog reads audiobooks, their chapters, and saved audiobooks.
+// Like ArtistCatalog it is optional and type-asserted by callers.
+type AudiobookCatalog interface {
+	GetAudiobook(ctx context.Context, id string) (Item, error)
+	GetChapter(ctx context.Context, id string) (Item, error)
+	AudiobookChapters(ctx context.Context, id string, limit, offset int) ([]Item, int, error)
+	LibraryAudiobooks(ctx context.Context, limit, offset int) ([]Item, int, error)
+}
+
+// LibraryChecker reports whether items are saved (or, for artists and
+// playlists, followed) by the account. Results line up with uris.
+type LibraryChecker interface {
+	LibraryContains(ctx context.Context, uris []string) ([]bool, error)
+}
+
+// AccountInsights reads the account's profile and listening history.
+type AccountInsights interface {
+	Me(ctx context.Context) (UserProfile, error)
+	TopItems(ctx context.Context, kind string, timeRange TimeRange, limit, offset int) ([]Item, int, error)
+	// RecentlyPlayed returns plays newest first; a non-zero after keeps only
+	// plays that started later.
+	RecentlyPlayed(ctx context.Context, limit int, after time.Time) ([]Item, error)
+}
+
+// TimeRange is the window Spotify computes top items over.
+type TimeRange string
+
+const (
+	TimeRangeShort  TimeRange = "short_term"
+	TimeRangeMedium TimeRange = "medium_term"
+	TimeRangeLong   TimeRange = "long_term"
+)
+
+// RadioStarter resolves a seed (track, artist, album or playlist URI) to a
+// playable radio context. Only Connect can; callers type-assert for it.
+type RadioStarter interface {
+	Radio(ctx context.Context, seedURI string) (string, error)
+}
+
+// Recommender returns candidate tracks similar to the seeds.
+type Recommender interface {
+	Recommendations(ctx context.Context, seeds RecommendationSeeds, limit int) ([]Item, error)
+}
+
+// RecommendationSeeds are track and artist IDs plus genre names; Spotify
+// accepts at most MaxRecommendationSeeds across all three.
+type RecommendationSeeds struct {
+	Tracks  []string `json:"tracks,omitempty"`
+	Artists []string `json:"artists,omitempty"`
+	Genres  []string `json:"genres,omitempty"`
+}
+
+const MaxRecommendationSeeds = 5
+
+func (s RecommendationSeeds) Count() int {
+	return len(s.Tracks) + len(s.Artists) + len(s.Genres)
+}
+
+// Browser reads editorial content: the home feed, browse categories and
+// their pages, and new releases.
+type Browser interface {
+	BrowseHome(ctx context.Context, limit int) ([]BrowseSection, error)
+	BrowseCategories(ctx context.Context, limit, offset int) ([]Category, int, error)
+	BrowseCategory(ctx context.Context, id string, limit int) ([]BrowseSection, error)
+	NewReleases(ctx context.Context, limit, offset int) ([]Item, int, error)
+}
+
+// AlbumGroup selects one part of an artist's discography.
+type AlbumGroup string
+
+const (
+	AlbumGroupAlbum     AlbumGroup = "album"
+	AlbumGroupSingle    AlbumGroup = "single"
+	AlbumGroupAppearsOn AlbumGroup = "appears_on"
+)
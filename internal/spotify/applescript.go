@@ -243,6 +243,20 @@ func (c *AppleScriptClient) LibraryAlbums(ctx context.Context, limit, offset int
 	return nil, 0, ErrUnsupported
 }
 
+func (c *AppleScriptClient) LibraryShows(ctx context.Context, limit, offset int) ([]Item, int, error) {
+	if c.fallback != nil {
+		return c.fallback.LibraryShows(ctx, limit, offset)
+	}
+	return nil, 0, ErrUnsupported
+}
+
+func (c *AppleScriptClient) LibraryEpisodes(ctx context.Context, limit, offset int) ([]Item, int, error) {
+	if c.fallback != nil {
+		return c.fallback.LibraryEpisodes(ctx, limit, offset)
+	}
+	return nil, 0, ErrUnsupported
+}
+
 func (c *AppleScriptClient) LibraryModify(ctx context.Context, path string, ids []string, method string) error {
 	if c.fallback != nil {
 		return c.fallback.LibraryModify(ctx, path, ids, method)
@@ -271,6 +285,20 @@ func (c *AppleScriptClient) Playlists(ctx context.Context, limit, offset int) ([
 	return nil, 0, ErrUnsupported
 }
 
+func (c *AppleScriptClient) ShowEpisodes(ctx context.Context, id string, limit, offset int) ([]Item, int, error) {
+	if c.fallback != nil {
+		return c.fallback.ShowEpisodes(ctx, id, limit, offset)
+	}
+	return nil, 0, ErrUnsupported
+}
+
+func (c *AppleScriptClient) AlbumTracks(ctx context.Context, id string, limit, offset int) ([]Item, int, error) {
+	if c.fallback != nil {
+		return c.fallback.AlbumTracks(ctx, id, limit, offset)
+	}
+	return nil, 0, ErrUnsupported
+}
+
 func (c *AppleScriptClient) PlaylistTracks(ctx context.Context, id string, limit, offset int) ([]Item, int, error) {
 	if c.fallback != nil {
 		return c.fallback.PlaylistTracks(ctx, id, limit, offset)
@@ -159,6 +159,22 @@ func TestAppleScriptDevicesAndFallbacks(t *testing.T) {
 			_, _, err := apple.Playlists(context.Background(), 1, 0)
 			return err
 		},
+		func() error {
+			_, _, err := apple.LibraryShows(context.Background(), 1, 0)
+			return err
+		},
+		func() error {
+			_, _, err := apple.LibraryEpisodes(context.Background(), 1, 0)
+			return err
+		},
+		func() error {
+			_, _, err := apple.ShowEpisodes(context.Background(), "show", 1, 0)
+			return err
+		},
+		func() error {
+			_, _, err := apple.AlbumTracks(context.Background(), "album", 1, 0)
+			return err
+		},
 		func() error {
 			_, _, err := apple.PlaylistTracks(context.Background(), "playlist", 1, 0)
 			return err
@@ -193,6 +209,10 @@ func TestAppleScriptDevicesAndFallbacks(t *testing.T) {
 	_ = apple.FollowArtists(context.Background(), []string{"id"}, "put")
 	_, _, _, _ = apple.FollowedArtists(context.Background(), 1, "")
 	_, _, _ = apple.Playlists(context.Background(), 1, 0)
+	_, _, _ = apple.LibraryShows(context.Background(), 1, 0)
+	_, _, _ = apple.LibraryEpisodes(context.Background(), 1, 0)
+	_, _, _ = apple.ShowEpisodes(context.Background(), "show", 1, 0)
+	_, _, _ = apple.AlbumTracks(context.Background(), "album", 1, 0)
 	_, _, _ = apple.PlaylistTracks(context.Background(), "playlist", 1, 0)
 	_, _ = apple.CreatePlaylist(context.Background(), "mix", false, false)
 	_ = apple.AddTracks(context.Background(), "playlist", []string{"track"})
@@ -201,7 +221,7 @@ func TestAppleScriptDevicesAndFallbacks(t *testing.T) {
 	for _, want := range []string{
 		"QueueAdd", "Queue", "Search", "GetTrack", "GetAlbum", "GetArtist", "GetPlaylist", "GetShow", "GetEpisode",
 		"LibraryTracks", "LibraryAlbums", "LibraryModify", "FollowArtists", "FollowedArtists", "Playlists", "PlaylistTracks",
-		"CreatePlaylist", "AddTracks", "RemoveTracks",
+		"AlbumTracks", "ShowEpisodes", "LibraryShows", "LibraryEpisodes", "CreatePlaylist", "AddTracks", "RemoveTracks",
 	} {
 		if calls[want] != 1 {
 			t.Fatalf("fallback %s calls=%d", want, calls[want])
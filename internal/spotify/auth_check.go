@@ -0,0 +1,56 @@
+package spotify
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/steipete/spogo/internal/cookies"
+)
+
+// AuthCheck is what a fresh cookie exchange yields. Account and the client
+// token are best effort; their errors are reported rather than returned.
+type AuthCheck struct {
+	Token                Token
+	ClientTokenExpiresAt time.Time
+	ClientTokenErr       error
+	Account              UserProfile
+	AccountErr           error
+}
+
+// CheckAuth exchanges the cookies for an access token and a client token
+// without touching the session cache, so cookies that stopped working show
+// up straight away instead of after the cached token runs out. The account
+// is looked up only for logged-in tokens.
+func CheckAuth(ctx context.Context, source cookies.Source, client *http.Client) (AuthCheck, error) {
+	session := &connectSession{source: source, client: client}
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if err := session.ensureTokenLocked(ctx); err != nil {
+		return AuthCheck{}, err
+	}
+	check := AuthCheck{Token: session.token}
+	check.ClientTokenErr = session.ensureAppConfigLocked(ctx)
+	if check.ClientTokenErr == nil {
+		check.ClientTokenErr = session.ensureClientTokenLocked(ctx)
+	}
+	if check.ClientTokenErr == nil {
+		check.ClientTokenExpiresAt = session.clientTokenT
+	}
+	if check.Token.Anonymous {
+		return check, nil
+	}
+	web, err := NewClient(Options{TokenProvider: staticToken(check.Token), HTTPClient: client})
+	if err != nil {
+		check.AccountErr = err
+		return check, nil
+	}
+	check.Account, check.AccountErr = web.Me(ctx)
+	return check, nil
+}
+
+type staticToken Token
+
+func (t staticToken) Token(context.Context) (Token, error) {
+	return Token(t), nil
+}
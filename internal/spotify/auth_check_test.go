@@ -0,0 +1,75 @@
+package spotify
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func authCheckTransport(anonymous bool, clientTokenStatus int) roundTripperFunc {
+	return func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.URL.Host == "open.spotify.com" && req.URL.Path == "/api/token":
+			return jsonResponse(http.StatusOK, tokenResponse{
+				AccessToken: "access",
+				ExpiresIn:   3600,
+				ClientID:    "client",
+				IsAnonymous: anonymous,
+			}), nil
+		case req.URL.Host == "open.spotify.com" && req.URL.Path == "/":
+			raw, _ := json.Marshal(map[string]any{"clientVersion": "1.2.3"})
+			html := fmt.Sprintf(`<script id="appServerConfig" type="text/plain">%s</script>`, base64.StdEncoding.EncodeToString(raw))
+			return textResponse(http.StatusOK, html), nil
+		case req.URL.Host == "clienttoken.spotify.com":
+			return jsonResponse(clientTokenStatus, map[string]any{
+				"granted_token": map[string]any{"token": "client-token", "expires_in": 600},
+			}), nil
+		case req.URL.Host == "api.spotify.com" && req.URL.Path == "/v1/me":
+			if req.Header.Get("Authorization") != "Bearer access" {
+				return textResponse(http.StatusUnauthorized, "no"), nil
+			}
+			resp := jsonResponse(http.StatusOK, map[string]any{"id": "steipete", "display_name": "Peter"})
+			resp.ContentLength = -1
+			return resp, nil
+		default:
+			return textResponse(http.StatusNotFound, "missing"), nil
+		}
+	}
+}
+
+func TestCheckAuth(t *testing.T) {
+	restore := SetTotpSecretFetcher(func(ctx context.Context) (int, []byte, error) {
+		return 1, []byte{1, 2, 3, 4}, nil
+	})
+	t.Cleanup(restore)
+	source := cookieSourceStub{cookies: []*http.Cookie{
+		{Name: "sp_dc", Value: "token", Domain: ".spotify.com"},
+		{Name: "sp_t", Value: "device", Domain: ".spotify.com"},
+	}}
+
+	check, err := CheckAuth(context.Background(), source, &http.Client{Transport: authCheckTransport(false, http.StatusOK)})
+	if err != nil {
+		t.Fatalf("check: %v", err)
+	}
+	if check.Token.Anonymous || check.ClientTokenErr != nil || check.ClientTokenExpiresAt.IsZero() {
+		t.Fatalf("unexpected check: %#v", check)
+	}
+	if check.AccountErr != nil || check.Account.ID != "steipete" {
+		t.Fatalf("unexpected account: %#v %v", check.Account, check.AccountErr)
+	}
+
+	check, err = CheckAuth(context.Background(), source, &http.Client{Transport: authCheckTransport(true, http.StatusBadRequest)})
+	if err != nil {
+		t.Fatalf("check anonymous: %v", err)
+	}
+	if !check.Token.Anonymous || check.ClientTokenErr == nil || check.Account.ID != "" {
+		t.Fatalf("unexpected anonymous check: %#v", check)
+	}
+
+	if _, err := CheckAuth(context.Background(), cookieSourceStub{err: fmt.Errorf("locked")}, &http.Client{}); err == nil {
+		t.Fatalf("expected cookie error")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"time"
 )
 
 type autoClient struct {
@@ -62,18 +63,178 @@ func (c *autoClient) Search(ctx context.Context, kind, query string, limit, offs
 }
 
 func (c *autoClient) ArtistTopTracks(ctx context.Context, id string, limit int) ([]Item, error) {
-	if primary, ok := c.primary.(artistTopTracksAPI); ok {
+	if primary, ok := c.primary.(ArtistCatalog); ok {
 		items, err := primary.ArtistTopTracks(ctx, id, limit)
 		if err == nil || c.secondary == nil || !c.shouldFallback(err) {
 			return items, err
 		}
 	}
-	if secondary, ok := c.secondary.(artistTopTracksAPI); ok {
+	if secondary, ok := c.secondary.(ArtistCatalog); ok {
 		return secondary.ArtistTopTracks(ctx, id, limit)
 	}
 	return nil, ErrUnsupported
 }
 
+func (c *autoClient) ArtistAlbums(ctx context.Context, id string, group AlbumGroup, limit, offset int) ([]Item, int, error) {
+	return autoCall2(c, func(api API) ([]Item, int, error) {
+		catalog, ok := api.(ArtistCatalog)
+		if !ok {
+			return nil, 0, ErrUnsupported
+		}
+		return catalog.ArtistAlbums(ctx, id, group, limit, offset)
+	})
+}
+
+func (c *autoClient) RelatedArtists(ctx context.Context, id string, limit int) ([]Item, error) {
+	return autoCall(c, func(api API) ([]Item, error) {
+		catalog, ok := api.(ArtistCatalog)
+		if !ok {
+			return nil, ErrUnsupported
+		}
+		return catalog.RelatedArtists(ctx, id, limit)
+	})
+}
+
+func (c *autoClient) GetAudiobook(ctx context.Context, id string) (Item, error) {
+	return autoCall(c, func(api API) (Item, error) {
+		catalog, ok := api.(AudiobookCatalog)
+		if !ok {
+			return Item{}, ErrUnsupported
+		}
+		return catalog.GetAudiobook(ctx, id)
+	})
+}
+
+func (c *autoClient) GetChapter(ctx context.Context, id string) (Item, error) {
+	return autoCall(c, func(api API) (Item, error) {
+		catalog, ok := api.(AudiobookCatalog)
+		if !ok {
+			return Item{}, ErrUnsupported
+		}
+		return catalog.GetChapter(ctx, id)
+	})
+}
+
+func (c *autoClient) AudiobookChapters(ctx context.Context, id string, limit, offset int) ([]Item, int, error) {
+	return autoCall2(c, func(api API) ([]Item, int, error) {
+		catalog, ok := api.(AudiobookCatalog)
+		if !ok {
+			return nil, 0, ErrUnsupported
+		}
+		return catalog.AudiobookChapters(ctx, id, limit, offset)
+	})
+}
+
+func (c *autoClient) LibraryAudiobooks(ctx context.Context, limit, offset int) ([]Item, int, error) {
+	return autoCall2(c, func(api API) ([]Item, int, error) {
+		catalog, ok := api.(AudiobookCatalog)
+		if !ok {
+			return nil, 0, ErrUnsupported
+		}
+		return catalog.LibraryAudiobooks(ctx, limit, offset)
+	})
+}
+
+func (c *autoClient) LibraryContains(ctx context.Context, uris []string) ([]bool, error) {
+	return autoCall(c, func(api API) ([]bool, error) {
+		checker, ok := api.(LibraryChecker)
+		if !ok {
+			return nil, ErrUnsupported
+		}
+		return checker.LibraryContains(ctx, uris)
+	})
+}
+
+func (c *autoClient) Me(ctx context.Context) (UserProfile, error) {
+	return autoCall(c, func(api API) (UserProfile, error) {
+		insights, ok := api.(AccountInsights)
+		if !ok {
+			return UserProfile{}, ErrUnsupported
+		}
+		return insights.Me(ctx)
+	})
+}
+
+func (c *autoClient) TopItems(ctx context.Context, kind string, timeRange TimeRange, limit, offset int) ([]Item, int, error) {
+	return autoCall2(c, func(api API) ([]Item, int, error) {
+		insights, ok := api.(AccountInsights)
+		if !ok {
+			return nil, 0, ErrUnsupported
+		}
+		return insights.TopItems(ctx, kind, timeRange, limit, offset)
+	})
+}
+
+func (c *autoClient) RecentlyPlayed(ctx context.Context, limit int, after time.Time) ([]Item, error) {
+	return autoCall(c, func(api API) ([]Item, error) {
+		insights, ok := api.(AccountInsights)
+		if !ok {
+			return nil, ErrUnsupported
+		}
+		return insights.RecentlyPlayed(ctx, limit, after)
+	})
+}
+
+func (c *autoClient) Radio(ctx context.Context, seedURI string) (string, error) {
+	return autoCall(c, func(api API) (string, error) {
+		starter, ok := api.(RadioStarter)
+		if !ok {
+			return "", ErrUnsupported
+		}
+		return starter.Radio(ctx, seedURI)
+	})
+}
+
+func (c *autoClient) Recommendations(ctx context.Context, seeds RecommendationSeeds, limit int) ([]Item, error) {
+	return autoCall(c, func(api API) ([]Item, error) {
+		recommender, ok := api.(Recommender)
+		if !ok {
+			return nil, ErrUnsupported
+		}
+		return recommender.Recommendations(ctx, seeds, limit)
+	})
+}
+
+func (c *autoClient) BrowseHome(ctx context.Context, limit int) ([]BrowseSection, error) {
+	return autoCall(c, func(api API) ([]BrowseSection, error) {
+		browser, ok := api.(Browser)
+		if !ok {
+			return nil, ErrUnsupported
+		}
+		return browser.BrowseHome(ctx, limit)
+	})
+}
+
+func (c *autoClient) BrowseCategories(ctx context.Context, limit, offset int) ([]Category, int, error) {
+	return autoCall2(c, func(api API) ([]Category, int, error) {
+		browser, ok := api.(Browser)
+		if !ok {
+			return nil, 0, ErrUnsupported
+		}
+		return browser.BrowseCategories(ctx, limit, offset)
+	})
+}
+
+func (c *autoClient) BrowseCategory(ctx context.Context, id string, limit int) ([]BrowseSection, error) {
+	return autoCall(c, func(api API) ([]BrowseSection, error) {
+		browser, ok := api.(Browser)
+		if !ok {
+			return nil, ErrUnsupported
+		}
+		return browser.BrowseCategory(ctx, id, limit)
+	})
+}
+
+func (c *autoClient) NewReleases(ctx context.Context, limit, offset int) ([]Item, int, error) {
+	return autoCall2(c, func(api API) ([]Item, int, error) {
+		browser, ok := api.(Browser)
+		if !ok {
+			return nil, 0, ErrUnsupported
+		}
+		return browser.NewReleases(ctx, limit, offset)
+	})
+}
+
 func (c *autoClient) GetTrack(ctx context.Context, id string) (Item, error) {
 	return autoCall(c, func(api API) (Item, error) {
 		return api.GetTrack(ctx, id)
@@ -122,6 +283,36 @@ func (c *autoClient) Play(ctx context.Context, uri string) error {
 	})
 }
 
+func (c *autoClient) PlayContext(ctx context.Context, contextURI, trackURI string) error {
+	return autoVoid(c, func(api API) error {
+		player, ok := api.(contextPlayerAPI)
+		if !ok {
+			return ErrUnsupported
+		}
+		return player.PlayContext(ctx, contextURI, trackURI)
+	})
+}
+
+func (c *autoClient) DeviceVolume(ctx context.Context, deviceID string, volume int) error {
+	return autoVoid(c, func(api API) error {
+		setter, ok := api.(deviceVolumeAPI)
+		if !ok {
+			return ErrUnsupported
+		}
+		return setter.DeviceVolume(ctx, deviceID, volume)
+	})
+}
+
+func (c *autoClient) Lyrics(ctx context.Context, trackID string) (Lyrics, error) {
+	return autoCall(c, func(api API) (Lyrics, error) {
+		fetcher, ok := api.(lyricsAPI)
+		if !ok {
+			return Lyrics{}, ErrUnsupported
+		}
+		return fetcher.Lyrics(ctx, trackID)
+	})
+}
+
 func (c *autoClient) Pause(ctx context.Context) error {
 	return autoVoid(c, func(api API) error {
 		return api.Pause(ctx)
@@ -200,6 +391,18 @@ func (c *autoClient) LibraryAlbums(ctx context.Context, limit, offset int) ([]It
 	})
 }
 
+func (c *autoClient) LibraryShows(ctx context.Context, limit, offset int) ([]Item, int, error) {
+	return autoCall2(c, func(api API) ([]Item, int, error) {
+		return api.LibraryShows(ctx, limit, offset)
+	})
+}
+
+func (c *autoClient) LibraryEpisodes(ctx context.Context, limit, offset int) ([]Item, int, error) {
+	return autoCall2(c, func(api API) ([]Item, int, error) {
+		return api.LibraryEpisodes(ctx, limit, offset)
+	})
+}
+
 func (c *autoClient) LibraryModify(ctx context.Context, path string, ids []string, method string) error {
 	return autoVoid(c, func(api API) error {
 		return api.LibraryModify(ctx, path, ids, method)
@@ -224,6 +427,18 @@ func (c *autoClient) Playlists(ctx context.Context, limit, offset int) ([]Item,
 	})
 }
 
+func (c *autoClient) ShowEpisodes(ctx context.Context, id string, limit, offset int) ([]Item, int, error) {
+	return autoCall2(c, func(api API) ([]Item, int, error) {
+		return api.ShowEpisodes(ctx, id, limit, offset)
+	})
+}
+
+func (c *autoClient) AlbumTracks(ctx context.Context, id string, limit, offset int) ([]Item, int, error) {
+	return autoCall2(c, func(api API) ([]Item, int, error) {
+		return api.AlbumTracks(ctx, id, limit, offset)
+	})
+}
+
 func (c *autoClient) PlaylistTracks(ctx context.Context, id string, limit, offset int) ([]Item, int, error) {
 	return autoCall2(c, func(api API) ([]Item, int, error) {
 		return api.PlaylistTracks(ctx, id, limit, offset)
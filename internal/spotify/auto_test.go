@@ -3,7 +3,9 @@ package spotify
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 )
 
 func TestAutoFallbackOnUnsupported(t *testing.T) {
@@ -146,7 +148,7 @@ func TestAutoArtistTopTracksFallback(t *testing.T) {
 		},
 	}
 	client := NewAutoClient(connect, web)
-	auto, ok := client.(artistTopTracksAPI)
+	auto, ok := client.(ArtistCatalog)
 	if !ok {
 		t.Fatalf("expected artist top tracks support")
 	}
@@ -159,6 +161,264 @@ func TestAutoArtistTopTracksFallback(t *testing.T) {
 	}
 }
 
+func TestAutoArtistAlbumsFallback(t *testing.T) {
+	ctx := context.Background()
+	calls := map[string]int{}
+	connect := apiStub{
+		calls: calls,
+		artistAlbumsFn: func(context.Context, string, AlbumGroup, int, int) ([]Item, int, error) {
+			return nil, 0, ErrUnsupported
+		},
+		relatedArtistsFn: func(context.Context, string, int) ([]Item, error) {
+			return []Item{{URI: "spotify:artist:r1"}}, nil
+		},
+	}
+	web := apiStub{
+		calls: calls,
+		artistAlbumsFn: func(context.Context, string, AlbumGroup, int, int) ([]Item, int, error) {
+			return []Item{{URI: "spotify:album:1"}}, 4, nil
+		},
+	}
+	catalog, ok := NewAutoClient(connect, web).(ArtistCatalog)
+	if !ok {
+		t.Fatalf("expected artist catalog support")
+	}
+	items, total, err := catalog.ArtistAlbums(ctx, "abc", AlbumGroupAlbum, 1, 0)
+	if err != nil || total != 4 || len(items) != 1 {
+		t.Fatalf("artist albums: %v %#v", err, items)
+	}
+	if calls["ArtistAlbums"] != 2 {
+		t.Fatalf("expected fallback calls, got %d", calls["ArtistAlbums"])
+	}
+	if related, err := catalog.RelatedArtists(ctx, "abc", 1); err != nil || len(related) != 1 {
+		t.Fatalf("related artists: %v %#v", err, related)
+	}
+}
+
+func TestAutoAudiobookCatalogFallback(t *testing.T) {
+	ctx := context.Background()
+	calls := map[string]int{}
+	connect := apiStub{
+		calls: calls,
+		audiobookChaptersFn: func(context.Context, string, int, int) ([]Item, int, error) {
+			return nil, 0, ErrUnsupported
+		},
+	}
+	web := apiStub{
+		calls: calls,
+		audiobookChaptersFn: func(context.Context, string, int, int) ([]Item, int, error) {
+			return []Item{{URI: "spotify:episode:c1", Type: "chapter"}}, 7, nil
+		},
+	}
+	catalog, ok := NewAutoClient(connect, web).(AudiobookCatalog)
+	if !ok {
+		t.Fatalf("expected audiobook catalog support")
+	}
+	items, total, err := catalog.AudiobookChapters(ctx, "b1", 1, 0)
+	if err != nil || total != 7 || len(items) != 1 {
+		t.Fatalf("chapters: %v %#v", err, items)
+	}
+	if calls["AudiobookChapters"] != 2 {
+		t.Fatalf("expected fallback calls, got %d", calls["AudiobookChapters"])
+	}
+	if item, err := catalog.GetAudiobook(ctx, "b1"); err != nil || item.ID != "b1" {
+		t.Fatalf("audiobook: %v %#v", err, item)
+	}
+	if item, err := catalog.GetChapter(ctx, "c1"); err != nil || item.ID != "c1" {
+		t.Fatalf("chapter: %v %#v", err, item)
+	}
+	if _, _, err := catalog.LibraryAudiobooks(ctx, 1, 0); err != nil {
+		t.Fatalf("library audiobooks: %v", err)
+	}
+	unsupported := NewAutoClient(struct{ API }{apiStub{}}, nil).(AudiobookCatalog)
+	if _, err := unsupported.GetAudiobook(ctx, "b1"); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+	if _, err := unsupported.GetChapter(ctx, "c1"); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+	if _, _, err := unsupported.LibraryAudiobooks(ctx, 1, 0); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+}
+
+func TestAutoLibraryContainsFallback(t *testing.T) {
+	calls := map[string]int{}
+	connect := apiStub{
+		calls: calls,
+		libraryContainsFn: func(context.Context, []string) ([]bool, error) {
+			return nil, APIError{Status: 429, Message: "rate limit"}
+		},
+	}
+	web := apiStub{
+		calls: calls,
+		libraryContainsFn: func(_ context.Context, uris []string) ([]bool, error) {
+			return []bool{true}, nil
+		},
+	}
+	checker, ok := NewAutoClient(connect, web).(LibraryChecker)
+	if !ok {
+		t.Fatalf("expected library checker support")
+	}
+	saved, err := checker.LibraryContains(context.Background(), []string{"spotify:track:t1"})
+	if err != nil || len(saved) != 1 || !saved[0] || calls["LibraryContains"] != 2 {
+		t.Fatalf("contains: %v %#v %#v", err, saved, calls)
+	}
+}
+
+func TestAutoAccountInsightsFallback(t *testing.T) {
+	calls := map[string]int{}
+	connect := apiStub{
+		calls: calls,
+		meFn: func(context.Context) (UserProfile, error) {
+			return UserProfile{}, ErrUnsupported
+		},
+	}
+	web := apiStub{calls: calls}
+	insights, ok := NewAutoClient(connect, web).(AccountInsights)
+	if !ok {
+		t.Fatalf("expected account insights support")
+	}
+	ctx := context.Background()
+	if profile, err := insights.Me(ctx); err != nil || profile.ID != "u1" || calls["Me"] != 2 {
+		t.Fatalf("me: %v %#v %#v", err, profile, calls)
+	}
+	if items, total, err := insights.TopItems(ctx, "artist", TimeRangeLong, 5, 0); err != nil || len(items) != 1 || total != 1 {
+		t.Fatalf("top: %v %#v %d", err, items, total)
+	}
+	if items, err := insights.RecentlyPlayed(ctx, 5, time.Now()); err != nil || len(items) != 1 {
+		t.Fatalf("recent: %v %#v", err, items)
+	}
+	unsupported := NewAutoClient(struct{ API }{apiStub{}}, nil).(AccountInsights)
+	if _, err := unsupported.Me(ctx); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+	if _, _, err := unsupported.TopItems(ctx, "track", TimeRangeShort, 1, 0); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+	if _, err := unsupported.RecentlyPlayed(ctx, 1, time.Time{}); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+}
+
+func TestAutoRadioAndRecommendations(t *testing.T) {
+	calls := map[string]int{}
+	ctx := context.Background()
+	connect := radioStub{apiStub{
+		calls: calls,
+		radioFn: func(context.Context, string) (string, error) {
+			return "", APIError{Status: 429, Message: "rate limit"}
+		},
+	}}
+	web := radioStub{apiStub{calls: calls}}
+	client := NewAutoClient(connect, web)
+	radio, err := client.(RadioStarter).Radio(ctx, "spotify:artist:a1")
+	if err != nil || radio != "spotify:playlist:radio" || calls["Radio"] != 2 {
+		t.Fatalf("radio: %v %q %#v", err, radio, calls)
+	}
+	items, err := client.(Recommender).Recommendations(ctx, RecommendationSeeds{Artists: []string{"a1"}}, 5)
+	if err != nil || len(items) != 1 {
+		t.Fatalf("recommendations: %v %#v", err, items)
+	}
+	unsupported := NewAutoClient(struct{ API }{apiStub{}}, nil)
+	if _, err := unsupported.(RadioStarter).Radio(ctx, "spotify:track:t1"); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+	if _, err := unsupported.(Recommender).Recommendations(ctx, RecommendationSeeds{}, 5); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+}
+
+func TestAutoBrowserFallback(t *testing.T) {
+	calls := map[string]int{}
+	ctx := context.Background()
+	browser, ok := NewAutoClient(apiStub{calls: calls}, apiStub{calls: calls}).(Browser)
+	if !ok {
+		t.Fatalf("expected browser support")
+	}
+	if sections, err := browser.BrowseHome(ctx, 5); err != nil || len(sections) != 1 {
+		t.Fatalf("home: %v %#v", err, sections)
+	}
+	if categories, _, err := browser.BrowseCategories(ctx, 5, 0); err != nil || len(categories) != 1 {
+		t.Fatalf("categories: %v %#v", err, categories)
+	}
+	if sections, err := browser.BrowseCategory(ctx, "c1", 5); err != nil || len(sections) != 1 {
+		t.Fatalf("category: %v %#v", err, sections)
+	}
+	if items, _, err := browser.NewReleases(ctx, 5, 0); err != nil || len(items) != 1 {
+		t.Fatalf("new releases: %v %#v", err, items)
+	}
+	unsupported := NewAutoClient(struct{ API }{apiStub{}}, nil).(Browser)
+	if _, err := unsupported.BrowseHome(ctx, 5); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+	if _, _, err := unsupported.BrowseCategories(ctx, 5, 0); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+	if _, err := unsupported.BrowseCategory(ctx, "c1", 5); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+	if _, _, err := unsupported.NewReleases(ctx, 5, 0); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+}
+
+func TestAutoPlayContext(t *testing.T) {
+	calls := map[string]int{}
+	connect := apiStub{
+		calls: calls,
+		playContextFn: func(context.Context, string, string) error {
+			return ErrUnsupported
+		},
+	}
+	web := apiStub{calls: calls}
+	client := NewAutoClient(connect, web).(contextPlayerAPI)
+	if err := client.PlayContext(context.Background(), "spotify:album:a1", "spotify:track:t2"); err != nil {
+		t.Fatalf("play context: %v", err)
+	}
+	if calls["PlayContext"] != 2 {
+		t.Fatalf("expected fallback call, got %d", calls["PlayContext"])
+	}
+	noContext := NewAutoClient(struct{ API }{apiStub{}}, nil).(contextPlayerAPI)
+	if err := noContext.PlayContext(context.Background(), "spotify:album:a1", ""); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+}
+
+func TestAutoDeviceVolume(t *testing.T) {
+	var got string
+	connect := apiStub{
+		deviceVolumeFn: func(_ context.Context, deviceID string, volume int) error {
+			got = fmt.Sprintf("%s=%d", deviceID, volume)
+			return nil
+		},
+	}
+	client := NewAutoClient(connect, apiStub{}).(deviceVolumeAPI)
+	if err := client.DeviceVolume(context.Background(), "d1", 30); err != nil || got != "d1=30" {
+		t.Fatalf("device volume: %v %q", err, got)
+	}
+	noVolume := NewAutoClient(struct{ API }{apiStub{}}, nil).(deviceVolumeAPI)
+	if err := noVolume.DeviceVolume(context.Background(), "d1", 30); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+}
+
+func TestAutoLyrics(t *testing.T) {
+	connect := apiStub{
+		lyricsFn: func(_ context.Context, trackID string) (Lyrics, error) {
+			return Lyrics{TrackID: trackID}, nil
+		},
+	}
+	client := NewAutoClient(connect, apiStub{}).(lyricsAPI)
+	if lyrics, err := client.Lyrics(context.Background(), "t1"); err != nil || lyrics.TrackID != "t1" {
+		t.Fatalf("lyrics: %v %#v", err, lyrics)
+	}
+	noLyrics := NewAutoClient(struct{ API }{apiStub{}}, nil).(lyricsAPI)
+	if _, err := noLyrics.Lyrics(context.Background(), "t1"); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+}
+
 func TestAutoPassThrough(t *testing.T) {
 	ctx := context.Background()
 	connectCalls := map[string]int{}
@@ -193,6 +453,10 @@ func TestAutoPassThrough(t *testing.T) {
 	_ = client.FollowArtists(ctx, []string{"1"}, "put")
 	_, _, _, _ = client.FollowedArtists(ctx, 1, "")
 	_, _, _ = client.Playlists(ctx, 1, 0)
+	_, _, _ = client.LibraryShows(ctx, 1, 0)
+	_, _, _ = client.LibraryEpisodes(ctx, 1, 0)
+	_, _, _ = client.ShowEpisodes(ctx, "1", 1, 0)
+	_, _, _ = client.AlbumTracks(ctx, "1", 1, 0)
 	_, _, _ = client.PlaylistTracks(ctx, "1", 1, 0)
 	_, _ = client.CreatePlaylist(ctx, "name", false, false)
 	_ = client.AddTracks(ctx, "1", []string{"spotify:track:1"})
@@ -69,6 +69,10 @@ func (c *Client) Search(ctx context.Context, kind, query string, limit, offset i
 		return SearchResult{}, err
 	}
 	container, ok := response[kind]
+	if !ok {
+		// The Web API keys results by the plural type ("audiobooks").
+		container, ok = response[kind+"s"]
+	}
 	if !ok {
 		return SearchResult{}, fmt.Errorf("missing %s result", kind)
 	}
@@ -137,6 +141,57 @@ func (c *Client) GetEpisode(ctx context.Context, id string) (Item, error) {
 	return mapEpisode(raw), nil
 }
 
+// ShowEpisodes lists a show's episodes newest first, including the
+// account's resume point for each.
+func (c *Client) ShowEpisodes(ctx context.Context, id string, limit, offset int) ([]Item, int, error) {
+	params := url.Values{}
+	params.Set("limit", fmt.Sprint(limit))
+	params.Set("offset", fmt.Sprint(offset))
+	market := c.market
+	if market == "" {
+		market = "from_token"
+	}
+	params.Set("market", market)
+	var raw showEpisodesResponse
+	if err := c.get(ctx, "/shows/"+id+"/episodes", params, &raw); err != nil {
+		return nil, 0, err
+	}
+	items := make([]Item, 0, len(raw.Items))
+	for _, episode := range raw.Items {
+		if episode.ID == "" {
+			continue
+		}
+		items = append(items, mapEpisode(episode))
+	}
+	return items, raw.Total, nil
+}
+
+// AlbumTracks lists an album's tracks in disc/track order. The Web API
+// returns simplified tracks, so Album is left empty.
+func (c *Client) AlbumTracks(ctx context.Context, id string, limit, offset int) ([]Item, int, error) {
+	params := url.Values{}
+	params.Set("limit", fmt.Sprint(limit))
+	params.Set("offset", fmt.Sprint(offset))
+	// A market is required for is_playable; from_token uses the account's.
+	market := c.market
+	if market == "" {
+		market = "from_token"
+	}
+	params.Set("market", market)
+	var raw albumTracksResponse
+	if err := c.get(ctx, "/albums/"+id+"/tracks", params, &raw); err != nil {
+		return nil, 0, err
+	}
+	items := make([]Item, 0, len(raw.Items))
+	for _, track := range raw.Items {
+		if track.ID == "" {
+			continue
+		}
+		items = append(items, mapTrack(track))
+	}
+	return items, raw.Total, nil
+}
+
 func (c *Client) ArtistTopTracks(ctx context.Context, id string, limit int) ([]Item, error) {
 	params := url.Values{}
 	market := c.market
@@ -158,6 +213,40 @@ func (c *Client) ArtistTopTracks(ctx context.Context, id string, limit int) ([]I
 	return items, nil
 }
 
+func (c *Client) ArtistAlbums(ctx context.Context, id string, group AlbumGroup, limit, offset int) ([]Item, int, error) {
+	params := url.Values{}
+	params.Set("include_groups", string(group))
+	params.Set("limit", fmt.Sprint(limit))
+	params.Set("offset", fmt.Sprint(offset))
+	if c.market != "" {
+		params.Set("market", c.market)
+	}
+	var raw artistAlbumsResponse
+	if err := c.get(ctx, "/artists/"+id+"/albums", params, &raw); err != nil {
+		return nil, 0, err
+	}
+	items := make([]Item, 0, len(raw.Items))
+	for _, album := range raw.Items {
+		items = append(items, mapAlbum(album))
+	}
+	return items, raw.Total, nil
+}
+
+func (c *Client) RelatedArtists(ctx context.Context, id string, limit int) ([]Item, error) {
+	var raw relatedArtistsResponse
+	if err := c.get(ctx, "/artists/"+id+"/related-artists", url.Values{}, &raw); err != nil {
+		return nil, err
+	}
+	if limit <= 0 || limit > len(raw.Artists) {
+		limit = len(raw.Artists)
+	}
+	items := make([]Item, 0, limit)
+	for _, artist := range raw.Artists[:limit] {
+		items = append(items, mapArtist(artist))
+	}
+	return items, nil
+}
+
 func (c *Client) Playback(ctx context.Context) (PlaybackStatus, error) {
 	var raw playbackResponse
 	if err := c.get(ctx, "/me/player", nil, &raw); err != nil {
@@ -173,6 +262,9 @@ func (c *Client) Playback(ctx context.Context) (PlaybackStatus, error) {
 		Repeat:     raw.RepeatState,
 		Device:     mapDevice(raw.Device),
 	}
+	if raw.Context != nil {
+		status.ContextURI = raw.Context.URI
+	}
 	if raw.Item.ID != "" {
 		item := mapTrack(raw.Item)
 		status.Item = &item
@@ -197,6 +289,16 @@ func (c *Client) Play(ctx context.Context, uri string) error {
 	return c.put(ctx, "/me/player/play", payload)
 }
 
+// PlayContext starts a context (album, playlist, show, ...) at one of its
+// tracks instead of its first item.
+func (c *Client) PlayContext(ctx context.Context, contextURI, trackURI string) error {
+	payload := map[string]any{"context_uri": contextURI}
+	if trackURI != "" {
+		payload["offset"] = map[string]any{"uri": trackURI}
+	}
+	return c.put(ctx, "/me/player/play", payload)
+}
+
 func (c *Client) Pause(ctx context.Context) error {
 	return c.put(ctx, "/me/player/pause", nil)
 }
@@ -221,6 +323,15 @@ func (c *Client) Volume(ctx context.Context, volume int) error {
 	return c.putParams(ctx, "/me/player/volume", params)
 }
 
+// DeviceVolume sets the volume of a device other than the active one without
+// transferring playback to it.
+func (c *Client) DeviceVolume(ctx context.Context, deviceID string, volume int) error {
+	params := url.Values{}
+	params.Set("volume_percent", fmt.Sprint(volume))
+	params.Set("device_id", deviceID)
+	return c.putParams(ctx, "/me/player/volume", params)
+}
+
 func (c *Client) Shuffle(ctx context.Context, enabled bool) error {
 	params := url.Values{}
 	params.Set("state", fmt.Sprint(enabled))
@@ -283,6 +394,14 @@ func (c *Client) LibraryAlbums(ctx context.Context, limit, offset int) ([]Item,
 	return c.libraryTracks(ctx, "/me/albums", limit, offset)
 }
 
+func (c *Client) LibraryShows(ctx context.Context, limit, offset int) ([]Item, int, error) {
+	return c.libraryTracks(ctx, "/me/shows", limit, offset)
+}
+
+func (c *Client) LibraryEpisodes(ctx context.Context, limit, offset int) ([]Item, int, error) {
+	return c.libraryTracks(ctx, "/me/episodes", limit, offset)
+}
+
 func (c *Client) libraryTracks(ctx context.Context, path string, limit, offset int) ([]Item, int, error) {
 	params := url.Values{}
 	params.Set("limit", fmt.Sprint(limit))
@@ -299,6 +418,12 @@ func (c *Client) libraryTracks(ctx context.Context, path string, limit, offset i
 		if item.Album.ID != "" {
 			items = append(items, mapAlbum(item.Album))
 		}
+		if item.Show.ID != "" {
+			items = append(items, mapShow(item.Show))
+		}
+		if item.Episode.ID != "" {
+			items = append(items, mapEpisode(item.Episode))
+		}
 	}
 	return items, raw.Total, nil
 }
@@ -0,0 +1,71 @@
+package spotify
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+func (c *Client) GetAudiobook(ctx context.Context, id string) (Item, error) {
+	var raw audiobookItem
+	if err := c.get(ctx, "/audiobooks/"+id, c.marketParams(), &raw); err != nil {
+		return Item{}, err
+	}
+	return mapAudiobook(raw), nil
+}
+
+func (c *Client) GetChapter(ctx context.Context, id string) (Item, error) {
+	var raw chapterItem
+	if err := c.get(ctx, "/chapters/"+id, c.marketParams(), &raw); err != nil {
+		return Item{}, err
+	}
+	return mapChapter(raw), nil
+}
+
+func (c *Client) AudiobookChapters(ctx context.Context, id string, limit, offset int) ([]Item, int, error) {
+	params := c.marketParams()
+	params.Set("limit", fmt.Sprint(limit))
+	params.Set("offset", fmt.Sprint(offset))
+	var raw audiobookChaptersResponse
+	if err := c.get(ctx, "/audiobooks/"+id+"/chapters", params, &raw); err != nil {
+		return nil, 0, err
+	}
+	items := make([]Item, 0, len(raw.Items))
+	for _, chapter := range raw.Items {
+		if chapter.ID == "" {
+			continue
+		}
+		items = append(items, mapChapter(chapter))
+	}
+	return items, raw.Total, nil
+}
+
+func (c *Client) LibraryAudiobooks(ctx context.Context, limit, offset int) ([]Item, int, error) {
+	params := url.Values{}
+	params.Set("limit", fmt.Sprint(limit))
+	params.Set("offset", fmt.Sprint(offset))
+	var raw libraryAudiobooksResponse
+	if err := c.get(ctx, "/me/audiobooks", params, &raw); err != nil {
+		return nil, 0, err
+	}
+	items := make([]Item, 0, len(raw.Items))
+	for _, audiobook := range raw.Items {
+		if audiobook.ID == "" {
+			continue
+		}
+		items = append(items, mapAudiobook(audiobook))
+	}
+	return items, raw.Total, nil
+}
+
+// marketParams sets the market audiobook endpoints require; from_token
+// uses the account's own.
+func (c *Client) marketParams() url.Values {
+	params := url.Values{}
+	market := c.market
+	if market == "" {
+		market = "from_token"
+	}
+	params.Set("market", market)
+	return params
+}
@@ -0,0 +1,79 @@
+package spotify
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// BrowseHome has no Web API equivalent of the personalized home feed;
+// featured playlists are the closest editorial shelf.
+func (c *Client) BrowseHome(ctx context.Context, limit int) ([]BrowseSection, error) {
+	items, message, err := c.playlistShelf(ctx, "/browse/featured-playlists", limit)
+	if err != nil {
+		return nil, err
+	}
+	if message == "" {
+		message = "Featured"
+	}
+	return []BrowseSection{{Title: message, Items: items}}, nil
+}
+
+func (c *Client) BrowseCategories(ctx context.Context, limit, offset int) ([]Category, int, error) {
+	params := url.Values{}
+	params.Set("limit", fmt.Sprint(limit))
+	params.Set("offset", fmt.Sprint(offset))
+	var raw categoriesResponse
+	if err := c.get(ctx, "/browse/categories", params, &raw); err != nil {
+		return nil, 0, err
+	}
+	categories := make([]Category, 0, len(raw.Categories.Items))
+	for _, entry := range raw.Categories.Items {
+		categories = append(categories, Category{ID: entry.ID, Name: entry.Name, URI: "spotify:page:" + entry.ID})
+	}
+	return categories, raw.Categories.Total, nil
+}
+
+func (c *Client) BrowseCategory(ctx context.Context, id string, limit int) ([]BrowseSection, error) {
+	items, message, err := c.playlistShelf(ctx, "/browse/categories/"+url.PathEscape(id)+"/playlists", limit)
+	if err != nil {
+		return nil, err
+	}
+	if message == "" {
+		message = "Playlists"
+	}
+	return []BrowseSection{{URI: "spotify:page:" + id, Title: message, Items: items}}, nil
+}
+
+func (c *Client) NewReleases(ctx context.Context, limit, offset int) ([]Item, int, error) {
+	params := url.Values{}
+	params.Set("limit", fmt.Sprint(limit))
+	params.Set("offset", fmt.Sprint(offset))
+	var raw newReleasesResponse
+	if err := c.get(ctx, "/browse/new-releases", params, &raw); err != nil {
+		return nil, 0, err
+	}
+	items := make([]Item, 0, len(raw.Albums.Items))
+	for _, album := range raw.Albums.Items {
+		if album.ID != "" {
+			items = append(items, mapAlbum(album))
+		}
+	}
+	return items, raw.Albums.Total, nil
+}
+
+func (c *Client) playlistShelf(ctx context.Context, path string, limit int) ([]Item, string, error) {
+	params := url.Values{}
+	params.Set("limit", fmt.Sprint(limit))
+	var raw featuredPlaylistsResponse
+	if err := c.get(ctx, path, params, &raw); err != nil {
+		return nil, "", err
+	}
+	items := make([]Item, 0, len(raw.Playlists.Items))
+	for _, playlist := range raw.Playlists.Items {
+		if playlist.ID != "" {
+			items = append(items, mapPlaylist(playlist))
+		}
+	}
+	return items, raw.Message, nil
+}
@@ -0,0 +1,106 @@
+package spotify
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// containsPaths maps a URI type to its Web API "check saved" endpoint.
+// Playlists are handled separately: their endpoint is per playlist.
+var containsPaths = map[string]string{
+	"track":     "/me/tracks/contains",
+	"album":     "/me/albums/contains",
+	"show":      "/me/shows/contains",
+	"episode":   "/me/episodes/contains",
+	"audiobook": "/me/audiobooks/contains",
+	"artist":    "/me/following/contains",
+}
+
+const containsBatchSize = 50
+
+func (c *Client) LibraryContains(ctx context.Context, uris []string) ([]bool, error) {
+	saved := make([]bool, len(uris))
+	resources, groups, order, err := groupURIsByType(uris)
+	if err != nil {
+		return nil, err
+	}
+	for _, kind := range order {
+		indexes := groups[kind]
+		if kind == "playlist" {
+			if err := c.playlistsFollowed(ctx, resources, indexes, saved); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		path, ok := containsPaths[kind]
+		if !ok {
+			return nil, fmt.Errorf("cannot check library for %s", kind)
+		}
+		for start := 0; start < len(indexes); start += containsBatchSize {
+			batch := indexes[start:min(start+containsBatchSize, len(indexes))]
+			ids := make([]string, 0, len(batch))
+			for _, index := range batch {
+				ids = append(ids, resources[index].ID)
+			}
+			params := url.Values{}
+			params.Set("ids", strings.Join(ids, ","))
+			if kind == "artist" {
+				params.Set("type", "artist")
+			}
+			var raw []bool
+			if err := c.get(ctx, path, params, &raw); err != nil {
+				return nil, err
+			}
+			if len(raw) != len(batch) {
+				return nil, fmt.Errorf("%s contains: got %d results for %d ids", kind, len(raw), len(batch))
+			}
+			for i, index := range batch {
+				saved[index] = raw[i]
+			}
+		}
+	}
+	return saved, nil
+}
+
+func (c *Client) playlistsFollowed(ctx context.Context, resources []Resource, indexes []int, saved []bool) error {
+	userID, err := c.currentUserID(ctx)
+	if err != nil {
+		return err
+	}
+	params := url.Values{}
+	params.Set("ids", userID)
+	for _, index := range indexes {
+		var raw []bool
+		if err := c.get(ctx, "/playlists/"+resources[index].ID+"/followers/contains", params, &raw); err != nil {
+			return err
+		}
+		saved[index] = len(raw) > 0 && raw[0]
+	}
+	return nil
+}
+
+// groupURIsByType parses uris (URLs are accepted too) and buckets their
+// indexes by type, keeping first-seen type order so requests go out
+// deterministically.
+func groupURIsByType(uris []string) ([]Resource, map[string][]int, []string, error) {
+	resources := make([]Resource, 0, len(uris))
+	groups := map[string][]int{}
+	order := []string{}
+	for i, uri := range uris {
+		res, err := ParseResource(uri)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if res.Type == "" {
+			return nil, nil, nil, fmt.Errorf("spotify uri required: %s", uri)
+		}
+		if _, ok := groups[res.Type]; !ok {
+			order = append(order, res.Type)
+		}
+		groups[res.Type] = append(groups[res.Type], i)
+		resources = append(resources, res)
+	}
+	return resources, groups, order, nil
+}
@@ -0,0 +1,64 @@
+package spotify
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+func (c *Client) Me(ctx context.Context) (UserProfile, error) {
+	var raw userProfile
+	if err := c.get(ctx, "/me", nil, &raw); err != nil {
+		return UserProfile{}, err
+	}
+	return mapUserProfile(raw), nil
+}
+
+// TopItems lists the account's top "track" or "artist" items over timeRange.
+func (c *Client) TopItems(ctx context.Context, kind string, timeRange TimeRange, limit, offset int) ([]Item, int, error) {
+	if kind != "track" && kind != "artist" {
+		return nil, 0, fmt.Errorf("top items: unsupported type %q", kind)
+	}
+	params := url.Values{}
+	params.Set("time_range", string(timeRange))
+	params.Set("limit", fmt.Sprint(limit))
+	params.Set("offset", fmt.Sprint(offset))
+	var raw topItemsResponse
+	if err := c.get(ctx, "/me/top/"+kind+"s", params, &raw); err != nil {
+		return nil, 0, err
+	}
+	items := make([]Item, 0, len(raw.Items))
+	for _, entry := range raw.Items {
+		item, err := mapSearchItem(kind, entry)
+		if err != nil {
+			return nil, 0, err
+		}
+		if item.ID != "" {
+			items = append(items, item)
+		}
+	}
+	return items, raw.Total, nil
+}
+
+func (c *Client) RecentlyPlayed(ctx context.Context, limit int, after time.Time) ([]Item, error) {
+	params := url.Values{}
+	params.Set("limit", fmt.Sprint(limit))
+	if !after.IsZero() {
+		params.Set("after", fmt.Sprint(after.UnixMilli()))
+	}
+	var raw recentlyPlayedResponse
+	if err := c.get(ctx, "/me/player/recently-played", params, &raw); err != nil {
+		return nil, err
+	}
+	items := make([]Item, 0, len(raw.Items))
+	for _, play := range raw.Items {
+		if play.Track.ID == "" {
+			continue
+		}
+		item := mapTrack(play.Track)
+		item.PlayedAt = play.PlayedAt
+		items = append(items, item)
+	}
+	return items, nil
+}
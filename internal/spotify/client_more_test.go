@@ -17,6 +17,16 @@ func TestClientEndpoints(t *testing.T) {
 	mux.HandleFunc("/albums/a1", func(w http.ResponseWriter, r *http.Request) {
 		_ = json.NewEncoder(w).Encode(albumItem{ID: "a1", URI: "spotify:album:a1", Name: "Album", Artists: []artistRef{{Name: "Artist"}}})
 	})
+	mux.HandleFunc("/albums/a1/tracks", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("market") != "US" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(albumTracksResponse{Items: []trackItem{
+			{ID: "t1", URI: "spotify:track:t1", Name: "Intro", TrackNumber: 1, DiscNumber: 1, IsPlayable: true},
+			{Name: "local"},
+		}, Total: 2})
+	})
 	mux.HandleFunc("/artists/ar1", func(w http.ResponseWriter, r *http.Request) {
 		_ = json.NewEncoder(w).Encode(artistItem{ID: "ar1", URI: "spotify:artist:ar1", Name: "Artist"})
 	})
@@ -25,6 +35,16 @@ func TestClientEndpoints(t *testing.T) {
 			Tracks: []trackItem{{ID: "t1", URI: "spotify:track:t1", Name: "Track", Album: albumRef{Name: "Album"}}},
 		})
 	})
+	mux.HandleFunc("/artists/ar1/albums", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("include_groups") != "single" || r.URL.Query().Get("offset") != "2" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(artistAlbumsResponse{Items: []albumItem{{ID: "a2", URI: "spotify:album:a2", Name: "Single"}}, Total: 3})
+	})
+	mux.HandleFunc("/artists/ar1/related-artists", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(relatedArtistsResponse{Artists: []artistItem{{ID: "ar2", Name: "Friend"}, {ID: "ar3", Name: "Other"}}})
+	})
 	mux.HandleFunc("/playlists/p1", func(w http.ResponseWriter, r *http.Request) {
 		_ = json.NewEncoder(w).Encode(playlistItem{ID: "p1", URI: "spotify:playlist:p1", Name: "Playlist"})
 	})
@@ -32,7 +52,13 @@ func TestClientEndpoints(t *testing.T) {
 		_ = json.NewEncoder(w).Encode(showItem{ID: "s1", URI: "spotify:show:s1", Name: "Show"})
 	})
 	mux.HandleFunc("/episodes/e1", func(w http.ResponseWriter, r *http.Request) {
-		_ = json.NewEncoder(w).Encode(episodeItem{ID: "e1", URI: "spotify:episode:e1", Name: "Episode"})
+		_ = json.NewEncoder(w).Encode(episodeItem{ID: "e1", URI: "spotify:episode:e1", Name: "Episode", ResumePoint: resumePoint{ResumePositionMS: 90000}})
+	})
+	mux.HandleFunc("/shows/s1/episodes", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(showEpisodesResponse{Items: []episodeItem{
+			{ID: "e2", URI: "spotify:episode:e2", Name: "New", ReleaseDate: "2026-05-01", ResumePoint: resumePoint{FullyPlayed: true}},
+			{},
+		}, Total: 40})
 	})
 	mux.HandleFunc("/me/player/devices", func(w http.ResponseWriter, r *http.Request) {
 		_ = json.NewEncoder(w).Encode(deviceResponse{Devices: []deviceItem{{ID: "d1", Name: "Desk", Type: "speaker", Volume: 30}}})
@@ -64,16 +90,16 @@ func TestClientEndpoints(t *testing.T) {
 		_ = json.NewEncoder(w).Encode(queueResponse{})
 	})
 	mux.HandleFunc("/me/tracks", func(w http.ResponseWriter, r *http.Request) {
-		_ = json.NewEncoder(w).Encode(libraryResponse{Items: []struct {
-			Track trackItem `json:"track"`
-			Album albumItem `json:"album"`
-		}{{Track: trackItem{ID: "t1", Name: "Track"}}}, Total: 1})
+		_ = json.NewEncoder(w).Encode(libraryResponse{Items: []libraryItem{{Track: trackItem{ID: "t1", Name: "Track"}}}, Total: 1})
 	})
 	mux.HandleFunc("/me/albums", func(w http.ResponseWriter, r *http.Request) {
-		_ = json.NewEncoder(w).Encode(libraryResponse{Items: []struct {
-			Track trackItem `json:"track"`
-			Album albumItem `json:"album"`
-		}{{Album: albumItem{ID: "a1", Name: "Album"}}}, Total: 1})
+		_ = json.NewEncoder(w).Encode(libraryResponse{Items: []libraryItem{{Album: albumItem{ID: "a1", Name: "Album"}}}, Total: 1})
+	})
+	mux.HandleFunc("/me/shows", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(libraryResponse{Items: []libraryItem{{Show: showItem{ID: "s1", Name: "Show"}}}, Total: 1})
+	})
+	mux.HandleFunc("/me/episodes", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(libraryResponse{Items: []libraryItem{{Episode: episodeItem{ID: "e1", Name: "Episode"}}}, Total: 1})
 	})
 	mux.HandleFunc("/me/following", func(w http.ResponseWriter, r *http.Request) {
 		if r.Method == http.MethodGet {
@@ -120,20 +146,33 @@ func TestClientEndpoints(t *testing.T) {
 	if _, err := client.GetAlbum(context.Background(), "a1"); err != nil {
 		t.Fatalf("album: %v", err)
 	}
+	if tracks, total, err := client.AlbumTracks(context.Background(), "a1", 50, 0); err != nil || total != 2 || len(tracks) != 1 || tracks[0].TrackNumber != 1 || tracks[0].DiscNumber != 1 {
+		t.Fatalf("album tracks: %v %d %#v", err, total, tracks)
+	}
 	if _, err := client.GetArtist(context.Background(), "ar1"); err != nil {
 		t.Fatalf("artist: %v", err)
 	}
 	if _, err := client.ArtistTopTracks(context.Background(), "ar1", 1); err != nil {
 		t.Fatalf("artist top tracks: %v", err)
 	}
+	if albums, total, err := client.ArtistAlbums(context.Background(), "ar1", AlbumGroupSingle, 1, 2); err != nil || total != 3 || len(albums) != 1 {
+		t.Fatalf("artist albums: %v %d %#v", err, total, albums)
+	}
+	if related, err := client.RelatedArtists(context.Background(), "ar1", 1); err != nil || len(related) != 1 {
+		t.Fatalf("related artists: %v %#v", err, related)
+	}
 	if _, err := client.GetPlaylist(context.Background(), "p1"); err != nil {
 		t.Fatalf("playlist: %v", err)
 	}
 	if _, err := client.GetShow(context.Background(), "s1"); err != nil {
 		t.Fatalf("show: %v", err)
 	}
-	if _, err := client.GetEpisode(context.Background(), "e1"); err != nil {
-		t.Fatalf("episode: %v", err)
+	if episode, err := client.GetEpisode(context.Background(), "e1"); err != nil || episode.ResumePositionMS != 90000 {
+		t.Fatalf("episode: %v %#v", err, episode)
+	}
+	if episodes, total, err := client.ShowEpisodes(context.Background(), "s1", 20, 0); err != nil || total != 40 || len(episodes) != 1 ||
+		!episodes[0].FullyPlayed || episodes[0].ReleaseDate != "2026-05-01" {
+		t.Fatalf("show episodes: %v %d %#v", err, total, episodes)
 	}
 	if _, err := client.Devices(context.Background()); err != nil {
 		t.Fatalf("devices: %v", err)
@@ -165,6 +204,12 @@ func TestClientEndpoints(t *testing.T) {
 	if _, _, err := client.LibraryAlbums(context.Background(), 1, 0); err != nil {
 		t.Fatalf("library albums: %v", err)
 	}
+	if shows, _, err := client.LibraryShows(context.Background(), 1, 0); err != nil || len(shows) != 1 || shows[0].Type != "show" {
+		t.Fatalf("library shows: %v %#v", err, shows)
+	}
+	if episodes, _, err := client.LibraryEpisodes(context.Background(), 1, 0); err != nil || len(episodes) != 1 || episodes[0].Type != "episode" {
+		t.Fatalf("library episodes: %v %#v", err, episodes)
+	}
 	if err := client.LibraryModify(context.Background(), "/me/tracks", []string{"t1"}, http.MethodPut); err != nil {
 		t.Fatalf("library modify: %v", err)
 	}
@@ -0,0 +1,42 @@
+package spotify
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+func (c *Client) Recommendations(ctx context.Context, seeds RecommendationSeeds, limit int) ([]Item, error) {
+	if err := validateSeeds(seeds); err != nil {
+		return nil, err
+	}
+	params := c.marketParams()
+	params.Set("limit", fmt.Sprint(limit))
+	for key, values := range map[string][]string{
+		"seed_tracks":  seeds.Tracks,
+		"seed_artists": seeds.Artists,
+		"seed_genres":  seeds.Genres,
+	} {
+		if len(values) > 0 {
+			params.Set(key, strings.Join(values, ","))
+		}
+	}
+	var raw recommendationsResponse
+	if err := c.get(ctx, "/recommendations", params, &raw); err != nil {
+		return nil, err
+	}
+	items := make([]Item, 0, len(raw.Tracks))
+	for _, track := range raw.Tracks {
+		if track.ID != "" {
+			items = append(items, mapTrack(track))
+		}
+	}
+	return items, nil
+}
+
+func validateSeeds(seeds RecommendationSeeds) error {
+	if count := seeds.Count(); count == 0 || count > MaxRecommendationSeeds {
+		return fmt.Errorf("recommendations need 1-%d seeds, got %d", MaxRecommendationSeeds, count)
+	}
+	return nil
+}
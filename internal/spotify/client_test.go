@@ -67,6 +67,29 @@ func TestSearchTrack(t *testing.T) {
 	}
 }
 
+func TestSearchAudiobookPluralKey(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("type") != "audiobook" {
+			t.Errorf("type = %q", r.URL.Query().Get("type"))
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"audiobooks": map[string]any{
+				"items": []map[string]any{{"id": "b1", "name": "Dune", "authors": []map[string]any{{"name": "Frank Herbert"}}}},
+				"total": 1,
+			},
+		})
+	})
+	client, closeFn := newTestClient(t, handler)
+	defer closeFn()
+	res, err := client.Search(context.Background(), "audiobook", "dune", 1, 0)
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	if len(res.Items) != 1 || res.Items[0].Type != "audiobook" || res.Items[0].Authors[0] != "Frank Herbert" {
+		t.Fatalf("unexpected items: %#v", res.Items)
+	}
+}
+
 func TestPlaybackNoContent(t *testing.T) {
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusNoContent)
@@ -91,6 +114,7 @@ func TestPlaybackWithItem(t *testing.T) {
 				ProgressMS: 1000,
 				Device:     deviceItem{Name: "Desk"},
 				Item:       trackItem{ID: "t1", Name: "Song", Artists: []artistRef{{Name: "Artist"}}},
+				Context:    &contextRef{URI: "spotify:album:a1"},
 			}
 			_ = json.NewEncoder(w).Encode(payload)
 		default:
@@ -106,6 +130,9 @@ func TestPlaybackWithItem(t *testing.T) {
 	if status.Item == nil || status.Item.Name != "Song" {
 		t.Fatalf("expected item")
 	}
+	if status.ContextURI != "spotify:album:a1" {
+		t.Fatalf("context %q", status.ContextURI)
+	}
 }
 
 func TestPlaybackHydratesSparseItem(t *testing.T) {
@@ -214,6 +241,26 @@ func TestPlayContextURI(t *testing.T) {
 	}
 }
 
+func TestPlayContextOffset(t *testing.T) {
+	var body string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		body = string(data)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	client, closeFn := newTestClient(t, handler)
+	defer closeFn()
+	if err := client.PlayContext(context.Background(), "spotify:album:a1", "spotify:track:t2"); err != nil {
+		t.Fatalf("play: %v", err)
+	}
+	if !strings.Contains(body, `"context_uri":"spotify:album:a1"`) || !strings.Contains(body, `"offset":{"uri":"spotify:track:t2"}`) {
+		t.Fatalf("body %s", body)
+	}
+	if err := client.PlayContext(context.Background(), "spotify:album:a1", ""); err != nil || strings.Contains(body, "offset") {
+		t.Fatalf("no offset: %v %s", err, body)
+	}
+}
+
 func TestQueueNoContent(t *testing.T) {
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusNoContent)
@@ -259,6 +306,22 @@ func TestVolumeQuery(t *testing.T) {
 	}
 }
 
+func TestDeviceVolumeQuery(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("volume_percent") != "30" || query.Get("device_id") != "d1" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	client, closeFn := newTestClient(t, handler)
+	defer closeFn()
+	if err := client.DeviceVolume(context.Background(), "d1", 30); err != nil {
+		t.Fatalf("device volume: %v", err)
+	}
+}
+
 func TestShuffleQuery(t *testing.T) {
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Query().Get("state") != "true" {
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/steipete/spogo/internal/cookies"
+	"github.com/steipete/spogo/internal/secrets"
 )
 
 type ConnectOptions struct {
@@ -17,6 +18,13 @@ type ConnectOptions struct {
 	Device    string
 	Timeout   time.Duration
 	CachePath string
+	// CacheStore, when set, keeps the cache under CacheKey in a secrets
+	// store instead of the plaintext file at CachePath.
+	CacheStore secrets.Store
+	CacheKey   string
+	// WaitDevice is how long play waits for Device to appear when nothing
+	// is active. Zero falls back to the Web API straight away.
+	WaitDevice time.Duration
 }
 
 type ConnectClient struct {
@@ -24,6 +32,7 @@ type ConnectClient struct {
 	market       string
 	language     string
 	device       string
+	waitDevice   time.Duration
 	client       *http.Client
 	session      *connectSession
 	hashes       *hashResolver
@@ -50,20 +59,24 @@ func NewConnectClient(opts ConnectOptions) (*ConnectClient, error) {
 	}
 	httpClient := &http.Client{Timeout: timeout}
 	cache := newConnectCacheStore(opts.CachePath)
+	if opts.CacheStore != nil && opts.CacheKey != "" {
+		cache = &connectCacheStore{secrets: opts.CacheStore, key: opts.CacheKey}
+	}
 	session := &connectSession{
 		source: opts.Source,
 		client: httpClient,
 		cache:  cache,
 	}
 	return &ConnectClient{
-		source:   opts.Source,
-		market:   opts.Market,
-		language: opts.Language,
-		device:   opts.Device,
-		client:   httpClient,
-		session:  session,
-		hashes:   newHashResolver(httpClient, session),
-		cache:    cache,
+		source:     opts.Source,
+		market:     opts.Market,
+		language:   opts.Language,
+		device:     opts.Device,
+		waitDevice: opts.WaitDevice,
+		client:     httpClient,
+		session:    session,
+		hashes:     newHashResolver(httpClient, session),
+		cache:      cache,
 	}, nil
 }
 
@@ -103,6 +116,10 @@ func (c *ConnectClient) Play(ctx context.Context, uri string) error {
 	return c.play(ctx, uri)
 }
 
+func (c *ConnectClient) PlayContext(ctx context.Context, contextURI, trackURI string) error {
+	return c.playContext(ctx, contextURI, trackURI)
+}
+
 func (c *ConnectClient) Pause(ctx context.Context) error {
 	return c.pause(ctx)
 }
@@ -123,6 +140,10 @@ func (c *ConnectClient) Volume(ctx context.Context, volume int) error {
 	return c.volume(ctx, volume)
 }
 
+func (c *ConnectClient) DeviceVolume(ctx context.Context, deviceID string, volume int) error {
+	return c.deviceVolume(ctx, deviceID, volume)
+}
+
 func (c *ConnectClient) Shuffle(ctx context.Context, enabled bool) error {
 	return c.shuffle(ctx, enabled)
 }
@@ -163,6 +184,22 @@ func (c *ConnectClient) LibraryAlbums(ctx context.Context, limit, offset int) ([
 	})
 }
 
+func (c *ConnectClient) LibraryShows(ctx context.Context, limit, offset int) ([]Item, int, error) {
+	return withWebCollectionFallback(c, func() ([]Item, int, error) {
+		return c.libraryShows(ctx, limit, offset)
+	}, func(web *Client) ([]Item, int, error) {
+		return web.LibraryShows(ctx, limit, offset)
+	})
+}
+
+func (c *ConnectClient) LibraryEpisodes(ctx context.Context, limit, offset int) ([]Item, int, error) {
+	return withWebCollectionFallback(c, func() ([]Item, int, error) {
+		return c.libraryEpisodes(ctx, limit, offset)
+	}, func(web *Client) ([]Item, int, error) {
+		return web.LibraryEpisodes(ctx, limit, offset)
+	})
+}
+
 func (c *ConnectClient) LibraryModify(ctx context.Context, path string, ids []string, method string) error {
 	return withWebFallback(c, func(web *Client) error {
 		return web.LibraryModify(ctx, path, ids, method)
@@ -189,6 +226,22 @@ func (c *ConnectClient) Playlists(ctx context.Context, limit, offset int) ([]Ite
 	})
 }
 
+func (c *ConnectClient) ShowEpisodes(ctx context.Context, id string, limit, offset int) ([]Item, int, error) {
+	return withWebCollectionFallback(c, func() ([]Item, int, error) {
+		return c.showEpisodes(ctx, id, limit, offset)
+	}, func(web *Client) ([]Item, int, error) {
+		return web.ShowEpisodes(ctx, id, limit, offset)
+	})
+}
+
+func (c *ConnectClient) AlbumTracks(ctx context.Context, id string, limit, offset int) ([]Item, int, error) {
+	return withWebCollectionFallback(c, func() ([]Item, int, error) {
+		return c.albumTracks(ctx, id, limit, offset)
+	}, func(web *Client) ([]Item, int, error) {
+		return web.AlbumTracks(ctx, id, limit, offset)
+	})
+}
+
 func (c *ConnectClient) PlaylistTracks(ctx context.Context, id string, limit, offset int) ([]Item, int, error) {
 	return withWebCollectionFallback(c, func() ([]Item, int, error) {
 		return c.playlistTracks(ctx, id, limit, offset)
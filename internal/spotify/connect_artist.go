@@ -0,0 +1,153 @@
+package spotify
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// artistAlbumOperations maps discography groups to their pathfinder
+// operation and the path to the paged container in the response.
+var artistAlbumOperations = map[AlbumGroup]struct {
+	operation string
+	path      []string
+}{
+	AlbumGroupAlbum:     {"queryArtistDiscographyAlbums", []string{"data", "artistUnion", "discography", "albums"}},
+	AlbumGroupSingle:    {"queryArtistDiscographySingles", []string{"data", "artistUnion", "discography", "singles"}},
+	AlbumGroupAppearsOn: {"queryArtistAppearsOn", []string{"data", "artistUnion", "relatedContent", "appearsOn"}},
+}
+
+func (c *ConnectClient) ArtistTopTracks(ctx context.Context, id string, limit int) ([]Item, error) {
+	items, err := c.artistList(ctx, "queryArtistOverview", id, "track", "discography", "topTracks")
+	if err != nil || len(items) == 0 {
+		web, werr := c.webClient()
+		if werr != nil {
+			if err == nil {
+				err = werr
+			}
+			return nil, err
+		}
+		return web.ArtistTopTracks(ctx, id, limit)
+	}
+	return limitItems(items, limit), nil
+}
+
+func (c *ConnectClient) ArtistAlbums(ctx context.Context, id string, group AlbumGroup, limit, offset int) ([]Item, int, error) {
+	op, ok := artistAlbumOperations[group]
+	if !ok {
+		return nil, 0, fmt.Errorf("unknown album group %q", group)
+	}
+	vars := map[string]any{
+		"uri":    "spotify:artist:" + id,
+		"offset": offset,
+		"limit":  normalizeLibraryLimit(limit),
+		"order":  "DATE_DESC",
+	}
+	payload, err := c.graphQL(ctx, op.operation, vars)
+	if err == nil {
+		if container, ok := getMap(payload, op.path...); ok {
+			items := extractListItems(container, "album")
+			total := getInt(container, "totalCount")
+			if total == 0 {
+				total = offset + len(items)
+			}
+			return items, total, nil
+		}
+		err = fmt.Errorf("%s payload missing %s", op.operation, strings.Join(op.path, "."))
+	}
+	web, werr := c.webClient()
+	if werr != nil {
+		return nil, 0, err
+	}
+	return web.ArtistAlbums(ctx, id, group, limit, offset)
+}
+
+func (c *ConnectClient) RelatedArtists(ctx context.Context, id string, limit int) ([]Item, error) {
+	items, err := c.artistList(ctx, "queryArtistRelated", id, "artist", "relatedContent", "relatedArtists")
+	if err != nil {
+		web, werr := c.webClient()
+		if werr != nil {
+			return nil, err
+		}
+		return web.RelatedArtists(ctx, id, limit)
+	}
+	return limitItems(items, limit), nil
+}
+
+// artistList runs an artist operation and extracts the list found at
+// data.artistUnion.<path>.
+func (c *ConnectClient) artistList(ctx context.Context, operation, id, kind string, path ...string) ([]Item, error) {
+	vars := map[string]any{
+		"uri":    "spotify:artist:" + id,
+		"locale": c.language,
+	}
+	payload, err := c.graphQL(ctx, operation, vars)
+	if err != nil {
+		return nil, err
+	}
+	container, ok := getMap(payload, append([]string{"data", "artistUnion"}, path...)...)
+	if !ok {
+		return nil, fmt.Errorf("%s payload missing artistUnion.%s", operation, strings.Join(path, "."))
+	}
+	return extractListItems(container, kind), nil
+}
+
+// extractListItems pulls one item of kind out of each element of
+// container.items. Pathfinder wraps list entries at varying depths
+// (releases.items[0], track, data), so each element is searched
+// breadth-first for the nearest map whose own uri has the right kind;
+// a recursive walk would also pick up nested artists and albums.
+func extractListItems(container map[string]any, kind string) []Item {
+	rawItems, _ := container["items"].([]any)
+	items := make([]Item, 0, len(rawItems))
+	seen := map[string]struct{}{}
+	for _, raw := range rawItems {
+		m, ok := nearestOfKind(raw, kind)
+		if !ok {
+			continue
+		}
+		item, ok := extractItem(m, kind)
+		if !ok {
+			continue
+		}
+		if _, dup := seen[item.URI]; dup {
+			continue
+		}
+		seen[item.URI] = struct{}{}
+		items = append(items, item)
+	}
+	return items
+}
+
+func nearestOfKind(value any, kind string) (map[string]any, bool) {
+	queue := []any{value}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		switch typed := current.(type) {
+		case map[string]any:
+			if strings.HasPrefix(getString(typed, "uri"), "spotify:"+kind+":") {
+				return typed, true
+			}
+			keys := make([]string, 0, len(typed))
+			for key := range typed {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				queue = append(queue, typed[key])
+			}
+		case []any:
+			queue = append(queue, typed...)
+		}
+	}
+	return nil, false
+}
+
+func limitItems(items []Item, limit int) []Item {
+	if limit > 0 && limit < len(items) {
+		return items[:limit]
+	}
+	return items
+}
@@ -0,0 +1,121 @@
+package spotify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func artistPayload(path []string, container map[string]any) map[string]any {
+	payload := container
+	for i := len(path) - 1; i >= 0; i-- {
+		payload = map[string]any{path[i]: payload}
+	}
+	return payload
+}
+
+func TestConnectArtistCatalog(t *testing.T) {
+	release := func(id, name string) map[string]any {
+		return map[string]any{"releases": map[string]any{"items": []any{map[string]any{
+			"uri":     "spotify:album:" + id,
+			"name":    name,
+			"artists": map[string]any{"items": []any{map[string]any{"uri": "spotify:artist:x", "profile": map[string]any{"name": "Other"}}}},
+		}}}}
+	}
+	var albumVars map[string]any
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		switch req.URL.Query().Get("operationName") {
+		case "queryArtistOverview":
+			return jsonResponse(http.StatusOK, artistPayload([]string{"data", "artistUnion", "discography", "topTracks"}, map[string]any{
+				"items": []any{
+					map[string]any{"uid": "1", "track": map[string]any{"uri": "spotify:track:t1", "name": "One"}},
+					map[string]any{"uid": "2", "track": map[string]any{"uri": "spotify:track:t2", "name": "Two"}},
+				},
+			})), nil
+		case "queryArtistDiscographySingles":
+			if err := json.Unmarshal([]byte(req.URL.Query().Get("variables")), &albumVars); err != nil {
+				t.Fatalf("variables: %v", err)
+			}
+			return jsonResponse(http.StatusOK, artistPayload(artistAlbumOperations[AlbumGroupSingle].path, map[string]any{
+				"totalCount": 7,
+				"items":      []any{release("s1", "Single"), release("s1", "Single"), release("s2", "Other Single")},
+			})), nil
+		case "queryArtistRelated":
+			return jsonResponse(http.StatusOK, artistPayload([]string{"data", "artistUnion", "relatedContent", "relatedArtists"}, map[string]any{
+				"items": []any{map[string]any{"uri": "spotify:artist:r1", "profile": map[string]any{"name": "Friend"}}},
+			})), nil
+		}
+		return textResponse(http.StatusNotFound, "missing"), nil
+	})
+	client := newConnectClientForTests(transport)
+	for _, op := range []string{"queryArtistOverview", "queryArtistDiscographySingles", "queryArtistRelated"} {
+		client.hashes.hashes[op] = "hash"
+	}
+	ctx := context.Background()
+
+	top, err := client.ArtistTopTracks(ctx, "a1", 1)
+	if err != nil || len(top) != 1 || top[0].ID != "t1" || top[0].Type != "track" {
+		t.Fatalf("top tracks: %#v %v", top, err)
+	}
+	singles, total, err := client.ArtistAlbums(ctx, "a1", AlbumGroupSingle, 10, 4)
+	if err != nil || total != 7 || len(singles) != 2 || singles[0].ID != "s1" || singles[1].Name != "Other Single" {
+		t.Fatalf("singles: %#v total=%d err=%v", singles, total, err)
+	}
+	if getString(albumVars, "uri") != "spotify:artist:a1" || getInt(albumVars, "offset") != 4 || getInt(albumVars, "limit") != 10 {
+		t.Fatalf("unexpected variables: %#v", albumVars)
+	}
+	related, err := client.RelatedArtists(ctx, "a1", 5)
+	if err != nil || len(related) != 1 || related[0].Name != "Friend" || related[0].Type != "artist" {
+		t.Fatalf("related: %#v %v", related, err)
+	}
+	if _, _, err := client.ArtistAlbums(ctx, "a1", AlbumGroup("compilation"), 10, 0); err == nil {
+		t.Fatalf("expected unknown group error")
+	}
+}
+
+func TestConnectArtistCatalogFallsBackToWeb(t *testing.T) {
+	webServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/artists/a1/albums":
+			if r.URL.Query().Get("include_groups") != "appears_on" {
+				t.Errorf("include_groups = %q", r.URL.Query().Get("include_groups"))
+			}
+			_ = json.NewEncoder(w).Encode(artistAlbumsResponse{Items: []albumItem{{ID: "c1", URI: "spotify:album:c1", Name: "Comp"}}, Total: 1})
+		case "/artists/a1/related-artists":
+			_ = json.NewEncoder(w).Encode(relatedArtistsResponse{Artists: []artistItem{{ID: "r1", URI: "spotify:artist:r1", Name: "Friend"}}})
+		case "/artists/a1/top-tracks":
+			_ = json.NewEncoder(w).Encode(artistTopTracksResponse{Tracks: []trackItem{{ID: "t1", URI: "spotify:track:t1", Name: "One"}}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(webServer.Close)
+	webClient, err := NewClient(Options{TokenProvider: staticTokenProvider{}, BaseURL: webServer.URL, HTTPClient: webServer.Client()})
+	if err != nil {
+		t.Fatalf("web client: %v", err)
+	}
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return jsonResponse(http.StatusOK, map[string]any{"data": map[string]any{"artistUnion": map[string]any{}}}), nil
+	})
+	client := newConnectClientForTests(transport)
+	client.web = webClient
+	for _, op := range []string{"queryArtistOverview", "queryArtistAppearsOn", "queryArtistRelated"} {
+		client.hashes.hashes[op] = "hash"
+	}
+	ctx := context.Background()
+
+	albums, total, err := client.ArtistAlbums(ctx, "a1", AlbumGroupAppearsOn, 10, 0)
+	if err != nil || total != 1 || len(albums) != 1 || albums[0].ID != "c1" {
+		t.Fatalf("appears on: %#v total=%d err=%v", albums, total, err)
+	}
+	related, err := client.RelatedArtists(ctx, "a1", 5)
+	if err != nil || len(related) != 1 || related[0].ID != "r1" {
+		t.Fatalf("related: %#v %v", related, err)
+	}
+	top, err := client.ArtistTopTracks(ctx, "a1", 5)
+	if err != nil || len(top) != 1 || top[0].ID != "t1" {
+		t.Fatalf("top: %#v %v", top, err)
+	}
+}
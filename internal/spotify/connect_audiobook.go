@@ -0,0 +1,78 @@
+package spotify
+
+import "context"
+
+func (c *ConnectClient) GetAudiobook(ctx context.Context, id string) (Item, error) {
+	return c.infoWithWebFallback(ctx, id, "audiobook", func() (Item, error) {
+		item, err := c.infoByOperation(ctx, "queryPodcastEpisodes", map[string]any{
+			"uri":    "spotify:show:" + id,
+			"offset": 0,
+			"limit":  25,
+		}, "show")
+		return retypeItem(item, "audiobook"), err
+	}, func(web *Client) (Item, error) {
+		return web.GetAudiobook(ctx, id)
+	})
+}
+
+func (c *ConnectClient) GetChapter(ctx context.Context, id string) (Item, error) {
+	return c.infoWithWebFallback(ctx, id, "chapter", func() (Item, error) {
+		item, err := c.infoByOperation(ctx, "getEpisodeOrChapter", map[string]any{
+			"uri": "spotify:episode:" + id,
+		}, "episode")
+		return retypeItem(item, "chapter"), err
+	}, func(web *Client) (Item, error) {
+		return web.GetChapter(ctx, id)
+	})
+}
+
+// AudiobookChapters pages an audiobook's chapters, which pathfinder serves
+// through the podcast episode query.
+func (c *ConnectClient) AudiobookChapters(ctx context.Context, id string, limit, offset int) ([]Item, int, error) {
+	return withWebCollectionFallback(c, func() ([]Item, int, error) {
+		items, total, err := c.showEpisodes(ctx, id, limit, offset)
+		return retypeItems(items, "chapter"), total, err
+	}, func(web *Client) ([]Item, int, error) {
+		return web.AudiobookChapters(ctx, id, limit, offset)
+	})
+}
+
+func (c *ConnectClient) LibraryAudiobooks(ctx context.Context, limit, offset int) ([]Item, int, error) {
+	return withWebCollectionFallback(c, func() ([]Item, int, error) {
+		payload, err := c.graphQL(ctx, "libraryV3", libraryV3Variables("Audiobooks", normalizeLibraryLimit(limit), offset))
+		if err != nil {
+			return nil, 0, err
+		}
+		items, total := extractLibraryV3Items(payload, "show")
+		return retypeItems(items, "audiobook"), total, nil
+	}, func(web *Client) ([]Item, int, error) {
+		return web.LibraryAudiobooks(ctx, limit, offset)
+	})
+}
+
+// connectURIKind maps audiobooks and chapters to the show and episode URI
+// namespaces they share on the connect side.
+func connectURIKind(kind string) string {
+	switch kind {
+	case "audiobook":
+		return "show"
+	case "chapter":
+		return "episode"
+	default:
+		return kind
+	}
+}
+
+func retypeItem(item Item, kind string) Item {
+	if item.URI != "" {
+		item.Type = kind
+	}
+	return item
+}
+
+func retypeItems(items []Item, kind string) []Item {
+	for i := range items {
+		items[i] = retypeItem(items[i], kind)
+	}
+	return items
+}
@@ -0,0 +1,141 @@
+package spotify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestConnectAudiobookCatalog(t *testing.T) {
+	audiobook := map[string]any{
+		"__typename": "Audiobook",
+		"uri":        "spotify:show:b1",
+		"name":       "Dune",
+		"authors":    []any{map[string]any{"name": "Frank Herbert"}},
+		"narrators":  map[string]any{"items": []any{map[string]any{"name": "Scott Brick"}, map[string]any{"name": "Scott Brick"}}},
+	}
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		switch req.URL.Query().Get("operationName") {
+		case "queryPodcastEpisodes":
+			return jsonResponse(http.StatusOK, map[string]any{"data": map[string]any{"podcastUnionV2": map[string]any{
+				"__typename": "Audiobook",
+				"uri":        "spotify:show:b1",
+				"name":       "Dune",
+				"episodesV2": map[string]any{
+					"totalCount": 48,
+					"items": []any{map[string]any{"entity": map[string]any{"data": map[string]any{
+						"__typename":    "Chapter",
+						"uri":           "spotify:episode:c1",
+						"name":          "Chapter 1",
+						"chapterNumber": 1,
+						"playedState":   map[string]any{"playPositionMilliseconds": 5000, "state": "IN_PROGRESS"},
+					}}}},
+				},
+			}}}), nil
+		case "getEpisodeOrChapter":
+			return jsonResponse(http.StatusOK, map[string]any{"data": map[string]any{"episodeUnionV2": map[string]any{
+				"__typename": "Chapter", "uri": "spotify:episode:c1", "name": "Chapter 1",
+			}}}), nil
+		case "libraryV3":
+			return jsonResponse(http.StatusOK, map[string]any{"data": map[string]any{"me": map[string]any{"libraryV3": map[string]any{
+				"totalCount": 1,
+				"items":      []any{map[string]any{"item": map[string]any{"data": audiobook}}},
+			}}}}), nil
+		case "searchDesktop":
+			return jsonResponse(http.StatusOK, map[string]any{"data": map[string]any{"searchV2": map[string]any{
+				"audiobooks": map[string]any{"totalCount": 3, "items": []any{map[string]any{
+					"__typename": "AudiobookResponseWrapper",
+					"data":       audiobook,
+				}}},
+				"podcasts": map[string]any{"items": []any{map[string]any{"uri": "spotify:show:p1", "name": "Pod"}}},
+			}}}), nil
+		}
+		return textResponse(http.StatusNotFound, "missing"), nil
+	})
+	client := newConnectClientForTests(transport)
+	for _, op := range []string{"queryPodcastEpisodes", "getEpisodeOrChapter", "libraryV3", "searchDesktop"} {
+		client.hashes.hashes[op] = "hash"
+	}
+	ctx := context.Background()
+
+	book, err := client.GetAudiobook(ctx, "b1")
+	if err != nil || book.Type != "audiobook" || book.URI != "spotify:show:b1" {
+		t.Fatalf("audiobook: %#v %v", book, err)
+	}
+	chapter, err := client.GetChapter(ctx, "c1")
+	if err != nil || chapter.Type != "chapter" || chapter.Name != "Chapter 1" {
+		t.Fatalf("chapter: %#v %v", chapter, err)
+	}
+	chapters, total, err := client.AudiobookChapters(ctx, "b1", 10, 0)
+	if err != nil || total != 48 || len(chapters) != 1 {
+		t.Fatalf("chapters: %#v total=%d err=%v", chapters, total, err)
+	}
+	if got := chapters[0]; got.Type != "chapter" || got.ChapterNumber != 1 || got.ResumePositionMS != 5000 {
+		t.Fatalf("chapter item: %#v", got)
+	}
+	saved, total, err := client.LibraryAudiobooks(ctx, 10, 0)
+	if err != nil || total != 1 || len(saved) != 1 || saved[0].Type != "audiobook" {
+		t.Fatalf("library audiobooks: %#v total=%d err=%v", saved, total, err)
+	}
+	if len(saved[0].Authors) != 1 || saved[0].Authors[0] != "Frank Herbert" || len(saved[0].Narrators) != 1 {
+		t.Fatalf("people: %#v", saved[0])
+	}
+	result, err := client.Search(ctx, "audiobook", "dune", 5, 0)
+	if err != nil || result.Total != 3 || len(result.Items) != 1 || result.Items[0].Type != "audiobook" || result.Items[0].Name != "Dune" {
+		t.Fatalf("search: %#v %v", result, err)
+	}
+	shows, err := client.Search(ctx, "show", "dune", 5, 0)
+	if err != nil || len(shows.Items) != 1 || shows.Items[0].Type != "show" {
+		t.Fatalf("show search: %#v %v", shows, err)
+	}
+}
+
+func TestConnectAudiobookCatalogFallsBackToWeb(t *testing.T) {
+	webServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("market") == "" && r.URL.Path != "/me/audiobooks" {
+			t.Errorf("missing market for %s", r.URL.Path)
+		}
+		switch r.URL.Path {
+		case "/audiobooks/b1":
+			_ = json.NewEncoder(w).Encode(audiobookItem{ID: "b1", Name: "Dune", TotalChapters: 48})
+		case "/chapters/c1":
+			_ = json.NewEncoder(w).Encode(chapterItem{ID: "c1", Name: "Chapter 1", ChapterNumber: 1})
+		case "/audiobooks/b1/chapters":
+			_ = json.NewEncoder(w).Encode(audiobookChaptersResponse{Items: []chapterItem{{ID: "c1"}, {}}, Total: 48})
+		case "/me/audiobooks":
+			_ = json.NewEncoder(w).Encode(libraryAudiobooksResponse{Items: []audiobookItem{{ID: "b1"}, {}}, Total: 1})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(webServer.Close)
+	webClient, err := NewClient(Options{TokenProvider: staticTokenProvider{}, BaseURL: webServer.URL, HTTPClient: webServer.Client()})
+	if err != nil {
+		t.Fatalf("web client: %v", err)
+	}
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return textResponse(http.StatusInternalServerError, "boom"), nil
+	})
+	client := newConnectClientForTests(transport)
+	client.web = webClient
+	ctx := context.Background()
+
+	book, err := client.GetAudiobook(ctx, "b1")
+	if err != nil || book.TotalChapters != 48 || book.URI != "spotify:audiobook:b1" {
+		t.Fatalf("audiobook: %#v %v", book, err)
+	}
+	chapter, err := client.GetChapter(ctx, "c1")
+	if err != nil || chapter.ChapterNumber != 1 {
+		t.Fatalf("chapter: %#v %v", chapter, err)
+	}
+	chapters, total, err := client.AudiobookChapters(ctx, "b1", 10, 0)
+	if err != nil || total != 48 || len(chapters) != 1 {
+		t.Fatalf("chapters: %#v total=%d err=%v", chapters, total, err)
+	}
+	saved, _, err := client.LibraryAudiobooks(ctx, 10, 0)
+	if err != nil || len(saved) != 1 {
+		t.Fatalf("library audiobooks: %#v %v", saved, err)
+	}
+}
@@ -0,0 +1,210 @@
+package spotify
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+)
+
+// newReleasesPage is the Browse page behind "New releases" in the apps.
+const newReleasesPage = "0JQ5DAqbMKFz6FAsUtgAab"
+
+func (c *ConnectClient) BrowseHome(ctx context.Context, limit int) ([]BrowseSection, error) {
+	vars := map[string]any{
+		"timeZone":          time.Local.String(),
+		"sp_t":              "",
+		"country":           c.market,
+		"facet":             "",
+		"sectionItemsLimit": limit,
+	}
+	sections, err := c.browseSections(ctx, "home", vars, "data", "home", "sectionContainer", "sections")
+	if err == nil {
+		return sections, nil
+	}
+	web, werr := c.webClient()
+	if werr != nil {
+		return nil, err
+	}
+	return web.BrowseHome(ctx, limit)
+}
+
+// BrowseCategories reads the browseAll grid. Pathfinder returns the grid
+// in one response, so paging is applied locally.
+func (c *ConnectClient) BrowseCategories(ctx context.Context, limit, offset int) ([]Category, int, error) {
+	vars := map[string]any{
+		"pagePagination":    map[string]any{"offset": 0, "limit": 10},
+		"sectionPagination": map[string]any{"offset": 0, "limit": 99},
+	}
+	payload, err := c.graphQL(ctx, "browseAll", vars)
+	if err == nil {
+		if container, ok := getMap(payload, "data", "browseStart", "sections"); ok {
+			categories := extractCategories(container)
+			return pageSlice(categories, limit, offset), len(categories), nil
+		}
+		err = errors.New("browseAll payload missing browseStart.sections")
+	}
+	web, werr := c.webClient()
+	if werr != nil {
+		return nil, 0, err
+	}
+	return web.BrowseCategories(ctx, limit, offset)
+}
+
+func (c *ConnectClient) BrowseCategory(ctx context.Context, id string, limit int) ([]BrowseSection, error) {
+	sections, err := c.browsePage(ctx, id, limit)
+	if err == nil {
+		return sections, nil
+	}
+	web, werr := c.webClient()
+	if werr != nil {
+		return nil, err
+	}
+	return web.BrowseCategory(ctx, id, limit)
+}
+
+// NewReleases flattens the albums on the New releases browse page.
+func (c *ConnectClient) NewReleases(ctx context.Context, limit, offset int) ([]Item, int, error) {
+	return withWebCollectionFallback(c, func() ([]Item, int, error) {
+		sections, err := c.browsePage(ctx, newReleasesPage, 50)
+		if err != nil {
+			return nil, 0, err
+		}
+		items := []Item{}
+		seen := map[string]struct{}{}
+		for _, section := range sections {
+			for _, item := range section.Items {
+				if _, dup := seen[item.URI]; dup || item.Type != "album" {
+					continue
+				}
+				seen[item.URI] = struct{}{}
+				items = append(items, item)
+			}
+		}
+		if len(items) == 0 {
+			return nil, 0, errors.New("browsePage returned no new releases")
+		}
+		return pageSlice(items, limit, offset), len(items), nil
+	}, func(web *Client) ([]Item, int, error) {
+		return web.NewReleases(ctx, limit, offset)
+	})
+}
+
+func (c *ConnectClient) browsePage(ctx context.Context, id string, limit int) ([]BrowseSection, error) {
+	vars := map[string]any{
+		"uri":               "spotify:page:" + id,
+		"pagePagination":    map[string]any{"offset": 0, "limit": 10},
+		"sectionPagination": map[string]any{"offset": 0, "limit": limit},
+	}
+	return c.browseSections(ctx, "browsePage", vars, "data", "browse", "sections")
+}
+
+func (c *ConnectClient) browseSections(ctx context.Context, operation string, vars map[string]any, path ...string) ([]BrowseSection, error) {
+	payload, err := c.graphQL(ctx, operation, vars)
+	if err != nil {
+		return nil, err
+	}
+	container, ok := getMap(payload, path...)
+	if !ok {
+		return nil, errors.New(operation + " payload missing " + strings.Join(path[1:], "."))
+	}
+	return extractBrowseSections(container), nil
+}
+
+// extractBrowseSections reads sections.items[], each with a title under
+// data.title and its entries under sectionItems.items[].content. Sections
+// with nothing recognizable (ads, shortcuts) are dropped.
+func extractBrowseSections(container map[string]any) []BrowseSection {
+	rawSections, _ := container["items"].([]any)
+	sections := make([]BrowseSection, 0, len(rawSections))
+	for _, raw := range rawSections {
+		section, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		out := BrowseSection{URI: getString(section, "uri"), Items: []Item{}}
+		if data, ok := getMap(section, "data"); ok {
+			out.Title = textLabel(data["title"])
+		}
+		entries, _ := getMap(section, "sectionItems")
+		rawItems, _ := entries["items"].([]any)
+		for _, rawItem := range rawItems {
+			entry, ok := rawItem.(map[string]any)
+			if !ok {
+				continue
+			}
+			value := any(entry)
+			if content, ok := entry["content"].(map[string]any); ok {
+				value = content
+			}
+			if item, ok := extractItem(value, ""); ok {
+				out.Items = append(out.Items, item)
+			}
+		}
+		if len(out.Items) > 0 {
+			sections = append(sections, out)
+		}
+	}
+	return sections
+}
+
+// extractCategories collects the spotify:page: cards of every browseAll
+// section, named by their cardRepresentation title.
+func extractCategories(container map[string]any) []Category {
+	categories := []Category{}
+	seen := map[string]struct{}{}
+	rawSections, _ := container["items"].([]any)
+	for _, raw := range rawSections {
+		entries, _ := getMap(raw, "sectionItems")
+		rawItems, _ := entries["items"].([]any)
+		for _, rawItem := range rawItems {
+			entry, ok := rawItem.(map[string]any)
+			if !ok {
+				continue
+			}
+			uri := getString(entry, "uri")
+			if !strings.HasPrefix(uri, "spotify:page:") {
+				continue
+			}
+			if _, dup := seen[uri]; dup {
+				continue
+			}
+			name := ""
+			walkMap(entry["content"], func(m map[string]any) {
+				if card, ok := m["cardRepresentation"].(map[string]any); ok && name == "" {
+					name = textLabel(card["title"])
+				}
+			})
+			seen[uri] = struct{}{}
+			categories = append(categories, Category{ID: idFromURI(uri), Name: name, URI: uri})
+		}
+	}
+	return categories
+}
+
+// textLabel reads pathfinder's localized titles, which are either plain
+// strings or {"transformedLabel": ...} / {"text": ...} objects.
+func textLabel(value any) string {
+	switch typed := value.(type) {
+	case string:
+		return typed
+	case map[string]any:
+		if label := getString(typed, "transformedLabel"); label != "" {
+			return label
+		}
+		return getString(typed, "text")
+	}
+	return ""
+}
+
+func pageSlice[T any](items []T, limit, offset int) []T {
+	offset = max(offset, 0)
+	if offset >= len(items) {
+		return []T{}
+	}
+	items = items[offset:]
+	if limit > 0 && limit < len(items) {
+		items = items[:limit]
+	}
+	return items
+}
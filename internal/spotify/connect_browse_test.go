@@ -0,0 +1,163 @@
+package spotify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func browseSection(title string, entries ...map[string]any) map[string]any {
+	items := make([]any, 0, len(entries))
+	for _, entry := range entries {
+		items = append(items, entry)
+	}
+	return map[string]any{
+		"uri":          "spotify:section:" + title,
+		"data":         map[string]any{"title": map[string]any{"transformedLabel": title}},
+		"sectionItems": map[string]any{"items": items},
+	}
+}
+
+func wrapped(typename string, data map[string]any) map[string]any {
+	return map[string]any{"content": map[string]any{"__typename": typename, "data": data}}
+}
+
+func TestConnectBrowse(t *testing.T) {
+	var pageVars map[string]any
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		switch req.URL.Query().Get("operationName") {
+		case "home":
+			return jsonResponse(http.StatusOK, map[string]any{"data": map[string]any{"home": map[string]any{
+				"sectionContainer": map[string]any{"sections": map[string]any{"items": []any{
+					browseSection("Made For You",
+						wrapped("PlaylistResponseWrapper", map[string]any{"uri": "spotify:playlist:p1", "name": "Daily Mix 1"}),
+						wrapped("AlbumResponseWrapper", map[string]any{"uri": "spotify:album:a1", "name": "Album"}),
+					),
+					browseSection("Shortcuts"),
+				}}},
+			}}}), nil
+		case "browseAll":
+			return jsonResponse(http.StatusOK, map[string]any{"data": map[string]any{"browseStart": map[string]any{
+				"sections": map[string]any{"items": []any{
+					map[string]any{"sectionItems": map[string]any{"items": []any{
+						map[string]any{"uri": "spotify:page:pop", "content": map[string]any{"data": map[string]any{"data": map[string]any{
+							"cardRepresentation": map[string]any{"title": map[string]any{"transformedLabel": "Pop"}},
+						}}}},
+						map[string]any{"uri": "spotify:page:jazz", "content": map[string]any{"data": map[string]any{"data": map[string]any{
+							"cardRepresentation": map[string]any{"title": "Jazz"},
+						}}}},
+						map[string]any{"uri": "spotify:page:pop"},
+						map[string]any{"uri": "spotify:xlink:ignored"},
+					}}},
+				}},
+			}}}), nil
+		case "browsePage":
+			_ = json.Unmarshal([]byte(req.URL.Query().Get("variables")), &pageVars)
+			return jsonResponse(http.StatusOK, map[string]any{"data": map[string]any{"browse": map[string]any{
+				"sections": map[string]any{"items": []any{
+					browseSection("Albums",
+						wrapped("AlbumResponseWrapper", map[string]any{"uri": "spotify:album:n1", "name": "New One"}),
+						wrapped("AlbumResponseWrapper", map[string]any{"uri": "spotify:album:n2", "name": "New Two"}),
+					),
+					browseSection("Playlists",
+						wrapped("PlaylistResponseWrapper", map[string]any{"uri": "spotify:playlist:p2", "name": "Fresh"}),
+						wrapped("AlbumResponseWrapper", map[string]any{"uri": "spotify:album:n1", "name": "New One"}),
+					),
+				}},
+			}}}), nil
+		}
+		return textResponse(http.StatusNotFound, "missing"), nil
+	})
+	client := newConnectClientForTests(transport)
+	for _, op := range []string{"home", "browseAll", "browsePage"} {
+		client.hashes.hashes[op] = "hash"
+	}
+	ctx := context.Background()
+
+	home, err := client.BrowseHome(ctx, 10)
+	if err != nil {
+		t.Fatalf("home: %v", err)
+	}
+	if len(home) != 1 || home[0].Title != "Made For You" || len(home[0].Items) != 2 || home[0].Items[0].Type != "playlist" {
+		t.Fatalf("home %#v", home)
+	}
+
+	categories, total, err := client.BrowseCategories(ctx, 1, 1)
+	if err != nil {
+		t.Fatalf("categories: %v", err)
+	}
+	if total != 2 || len(categories) != 1 || categories[0] != (Category{ID: "jazz", Name: "Jazz", URI: "spotify:page:jazz"}) {
+		t.Fatalf("categories %d %#v", total, categories)
+	}
+	if categories, _, _ := client.BrowseCategories(ctx, 10, 5); len(categories) != 0 {
+		t.Fatalf("expected empty page, got %#v", categories)
+	}
+
+	sections, err := client.BrowseCategory(ctx, "pop", 7)
+	if err != nil || len(sections) != 2 || sections[1].Title != "Playlists" {
+		t.Fatalf("category: %v %#v", err, sections)
+	}
+	if pageVars["uri"] != "spotify:page:pop" {
+		t.Fatalf("vars %#v", pageVars)
+	}
+
+	releases, total, err := client.NewReleases(ctx, 1, 1)
+	if err != nil || total != 2 || len(releases) != 1 || releases[0].ID != "n2" {
+		t.Fatalf("new releases: %v %d %#v", err, total, releases)
+	}
+	if pageVars["uri"] != "spotify:page:"+newReleasesPage {
+		t.Fatalf("vars %#v", pageVars)
+	}
+	if releases, _, err := client.NewReleases(ctx, 1, -1); err != nil || len(releases) != 1 || releases[0].ID != "n1" {
+		t.Fatalf("negative offset: %v %#v", err, releases)
+	}
+}
+
+func TestBrowseFallsBackToWeb(t *testing.T) {
+	client := newConnectClientForTests(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return jsonResponse(http.StatusOK, map[string]any{"data": map[string]any{}}), nil
+	}))
+	for _, op := range []string{"home", "browseAll", "browsePage"} {
+		client.hashes.hashes[op] = "hash"
+	}
+	var paths []string
+	client.web = newInsightsWebClient(t, func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		switch r.URL.Path {
+		case "/browse/featured-playlists":
+			_, _ = w.Write([]byte(`{"message":"Good morning","playlists":{"items":[{"id":"p1","name":"Wake Up"},{"id":""}]}}`))
+		case "/browse/categories":
+			_, _ = w.Write([]byte(`{"categories":{"items":[{"id":"toplists","name":"Charts"}],"total":40}}`))
+		case "/browse/categories/toplists/playlists":
+			_, _ = w.Write([]byte(`{"playlists":{"items":[{"id":"p2","name":"Top 50"}]}}`))
+		case "/browse/new-releases":
+			_, _ = w.Write([]byte(`{"albums":{"items":[{"id":"a1","name":"Fresh"},{"id":""}],"total":100}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	ctx := context.Background()
+	home, err := client.BrowseHome(ctx, 5)
+	if err != nil || len(home) != 1 || home[0].Title != "Good morning" || len(home[0].Items) != 1 {
+		t.Fatalf("home: %v %#v", err, home)
+	}
+	categories, total, err := client.BrowseCategories(ctx, 5, 0)
+	if err != nil || total != 40 || categories[0] != (Category{ID: "toplists", Name: "Charts", URI: "spotify:page:toplists"}) {
+		t.Fatalf("categories: %v %#v", err, categories)
+	}
+	sections, err := client.BrowseCategory(ctx, "toplists", 5)
+	if err != nil || len(sections) != 1 || sections[0].Title != "Playlists" || sections[0].Items[0].ID != "p2" {
+		t.Fatalf("category: %v %#v", err, sections)
+	}
+	releases, total, err := client.NewReleases(ctx, 5, 0)
+	if err != nil || total != 100 || len(releases) != 1 || releases[0].Type != "album" {
+		t.Fatalf("new releases: %v %#v", err, releases)
+	}
+	if len(paths) != 4 {
+		t.Fatalf("paths %q", paths)
+	}
+	if _, err := client.web.BrowseCategory(ctx, "missing", 5); err == nil {
+		t.Fatalf("expected web error")
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"path/filepath"
 	"sync"
 	"time"
+
+	"github.com/steipete/spogo/internal/secrets"
 )
 
 const (
@@ -34,8 +36,10 @@ type connectCache struct {
 }
 
 type connectCacheStore struct {
-	path string
-	mu   sync.Mutex
+	path    string
+	secrets secrets.Store
+	key     string
+	mu      sync.Mutex
 }
 
 func newConnectCacheStore(path string) *connectCacheStore {
@@ -46,7 +50,7 @@ func newConnectCacheStore(path string) *connectCacheStore {
 }
 
 func (s *connectCacheStore) load() (connectCache, error) {
-	if s == nil || s.path == "" {
+	if s == nil || (s.path == "" && s.secrets == nil) {
 		return connectCache{}, os.ErrNotExist
 	}
 	s.mu.Lock()
@@ -55,7 +59,7 @@ func (s *connectCacheStore) load() (connectCache, error) {
 }
 
 func (s *connectCacheStore) update(fn func(*connectCache)) error {
-	if s == nil || s.path == "" || fn == nil {
+	if s == nil || (s.path == "" && s.secrets == nil) || fn == nil {
 		return nil
 	}
 	s.mu.Lock()
@@ -66,6 +70,13 @@ func (s *connectCacheStore) update(fn func(*connectCache)) error {
 	}
 	fn(&cache)
 	cache.Version = connectCacheVersion
+	if s.secrets != nil {
+		data, err := json.Marshal(cache)
+		if err != nil {
+			return err
+		}
+		return s.secrets.Set(s.key, data)
+	}
 	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
 		return err
 	}
@@ -77,7 +88,13 @@ func (s *connectCacheStore) update(fn func(*connectCache)) error {
 }
 
 func (s *connectCacheStore) loadLocked() (connectCache, error) {
-	data, err := os.ReadFile(s.path)
+	var data []byte
+	var err error
+	if s.secrets != nil {
+		data, err = s.secrets.Get(s.key)
+	} else {
+		data, err = os.ReadFile(s.path)
+	}
 	if err != nil {
 		return connectCache{}, err
 	}
@@ -89,6 +89,18 @@ func TestConnectUnsupported(t *testing.T) {
 	if _, _, err := client.Playlists(context.Background(), 1, 0); err == nil {
 		t.Fatalf("expected error")
 	}
+	if _, _, err := client.LibraryShows(context.Background(), 1, 0); err == nil {
+		t.Fatalf("expected error")
+	}
+	if _, _, err := client.LibraryEpisodes(context.Background(), 1, 0); err == nil {
+		t.Fatalf("expected error")
+	}
+	if _, _, err := client.ShowEpisodes(context.Background(), "s1", 1, 0); err == nil {
+		t.Fatalf("expected error")
+	}
+	if _, _, err := client.AlbumTracks(context.Background(), "a1", 1, 0); err == nil {
+		t.Fatalf("expected error")
+	}
 	if _, _, err := client.PlaylistTracks(context.Background(), "p1", 1, 0); err == nil {
 		t.Fatalf("expected error")
 	}
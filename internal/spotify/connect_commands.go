@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 )
 
 func (c *ConnectClient) playback(ctx context.Context) (PlaybackStatus, error) {
@@ -47,14 +48,18 @@ func (c *ConnectClient) transferViaWebAPI(ctx context.Context, deviceID string)
 	})
 }
 
+// connectDevicePoll is how often play re-reads Connect state while waiting
+// for the profile device to appear.
+var connectDevicePoll = time.Second
+
 func (c *ConnectClient) play(ctx context.Context, uri string) error {
 	return withConnectStateErr(ctx, c, func(state connectState) error {
-		if state.activeDeviceID == "" {
-			if targetID := resolveConnectTargetDeviceID(state, c.device); targetID != "" {
-				state.activeDeviceID = targetID
-			} else {
-				return c.playViaWebAPI(ctx, uri)
-			}
+		state, ok, err := c.playTarget(ctx, state)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return c.playViaWebAPI(ctx, uri)
 		}
 		if uri == "" {
 			return c.sendPlayerCommand(ctx, state, "resume", nil)
@@ -63,6 +68,66 @@ func (c *ConnectClient) play(ctx context.Context, uri string) error {
 	})
 }
 
+func (c *ConnectClient) playContext(ctx context.Context, contextURI, trackURI string) error {
+	return withConnectStateErr(ctx, c, func(state connectState) error {
+		state, ok, err := c.playTarget(ctx, state)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return withWebFallback(c, func(web *Client) error {
+				return web.PlayContext(ctx, contextURI, trackURI)
+			})
+		}
+		return c.sendPlayerCommand(ctx, state, "play", contextPlayCommandPayload(contextURI, trackURI))
+	})
+}
+
+// playTarget picks the device a play command goes to: the active one, else
+// the profile device. Headless speakers can take a while to register after
+// power-on, so with waitDevice set it polls until the profile device shows
+// up and fails with DeviceUnavailableError if it never does. A selector
+// matching several devices fails straight away with AmbiguousDeviceError.
+// ok is false when the caller should fall back to the Web API.
+func (c *ConnectClient) playTarget(ctx context.Context, state connectState) (connectState, bool, error) {
+	if state.activeDeviceID != "" {
+		return state, true, nil
+	}
+	targetID, err := resolveConnectTargetDeviceID(state, c.device)
+	if err != nil {
+		return state, false, err
+	}
+	if targetID != "" {
+		state.activeDeviceID = targetID
+		return state, true, nil
+	}
+	if strings.TrimSpace(c.device) == "" || c.waitDevice <= 0 {
+		return state, false, nil
+	}
+	deadline := time.Now().Add(c.waitDevice)
+	for time.Now().Before(deadline) {
+		select {
+		case <-time.After(min(connectDevicePoll, time.Until(deadline))):
+		case <-ctx.Done():
+			return state, false, ctx.Err()
+		}
+		next, err := c.connectState(ctx)
+		if err != nil {
+			return state, false, err
+		}
+		state = next
+		targetID, err := resolveConnectTargetDeviceID(state, c.device)
+		if err != nil {
+			return state, false, err
+		}
+		if targetID != "" {
+			state.activeDeviceID = targetID
+			return state, true, nil
+		}
+	}
+	return state, false, DeviceUnavailableError{Selector: c.device, Waited: c.waitDevice, Available: mapDevices(state)}
+}
+
 func (c *ConnectClient) playViaWebAPI(ctx context.Context, uri string) error {
 	return withWebFallback(c, func(web *Client) error {
 		return web.Play(ctx, uri)
@@ -97,13 +162,22 @@ func (c *ConnectClient) seek(ctx context.Context, positionMS int) error {
 }
 
 func (c *ConnectClient) volume(ctx context.Context, volume int) error {
+	return c.deviceVolume(ctx, "", volume)
+}
+
+// deviceVolume sets the volume of deviceID, or of the active device when
+// deviceID is empty, without moving playback.
+func (c *ConnectClient) deviceVolume(ctx context.Context, deviceID string, volume int) error {
 	volume = clampVolume(volume)
 	return withConnectStateErr(ctx, c, func(state connectState) error {
 		fromID := connectTransferSourceID(state)
-		if fromID == "" || state.activeDeviceID == "" {
+		if deviceID == "" {
+			deviceID = state.activeDeviceID
+		}
+		if fromID == "" || deviceID == "" {
 			return errors.New("missing device id")
 		}
-		url := fmt.Sprintf("%s/connect/volume/from/%s/to/%s", connectStateBase, fromID, state.activeDeviceID)
+		url := fmt.Sprintf("%s/connect/volume/from/%s/to/%s", connectStateBase, fromID, deviceID)
 		return c.sendConnectRequest(ctx, http.MethodPut, url, map[string]any{
 			"volume": int(float64(volume) / 100 * 65535),
 		})
@@ -226,30 +300,41 @@ func connectTransferSourceID(state connectState) string {
 	return fromID
 }
 
-func resolveConnectTargetDeviceID(state connectState, selector string) string {
+// resolveConnectTargetDeviceID returns "" when the selector matches nothing
+// (yet); an ambiguous selector is an error, since waiting will not fix it.
+func resolveConnectTargetDeviceID(state connectState, selector string) (string, error) {
 	selector = strings.TrimSpace(selector)
 	if selector == "" {
-		return ""
+		return "", nil
 	}
-	for _, device := range mapDevices(state) {
-		if strings.EqualFold(device.ID, selector) || strings.EqualFold(device.Name, selector) {
-			return device.ID
-		}
+	device, err := MatchDevice(mapDevices(state), selector)
+	if errors.Is(err, ErrDeviceNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
 	}
-	return ""
+	return device.ID, nil
 }
 
 func playCommandPayload(uri string) map[string]any {
+	if isContextURI(uri) {
+		return contextPlayCommandPayload(uri, "")
+	}
+	return contextPlayCommandPayload(uri, uri)
+}
+
+func contextPlayCommandPayload(contextURI, trackURI string) map[string]any {
 	command := map[string]any{
 		"endpoint": "play",
 		"logging_params": map[string]any{
 			"command_id": randomHex(32),
 		},
 	}
-	command["context"] = map[string]any{"uri": uri, "url": "context://" + uri}
-	if !isContextURI(uri) {
+	command["context"] = map[string]any{"uri": contextURI, "url": "context://" + contextURI}
+	if trackURI != "" {
 		command["options"] = map[string]any{
-			"skip_to": map[string]any{"track_uri": uri},
+			"skip_to": map[string]any{"track_uri": trackURI},
 		}
 	}
 	return map[string]any{"command": command}
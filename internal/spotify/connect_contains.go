@@ -0,0 +1,49 @@
+package spotify
+
+import (
+	"context"
+	"fmt"
+)
+
+func (c *ConnectClient) LibraryContains(ctx context.Context, uris []string) ([]bool, error) {
+	saved, err := c.libraryContains(ctx, uris)
+	if err == nil {
+		return saved, nil
+	}
+	web, werr := c.webClient()
+	if werr != nil {
+		return nil, err
+	}
+	return web.LibraryContains(ctx, uris)
+}
+
+// libraryContains asks pathfinder about every uri in one round trip; the
+// lookup list comes back in request order.
+func (c *ConnectClient) libraryContains(ctx context.Context, uris []string) ([]bool, error) {
+	resources, _, _, err := groupURIsByType(uris)
+	if err != nil {
+		return nil, err
+	}
+	normalized := make([]string, 0, len(resources))
+	for _, res := range resources {
+		normalized = append(normalized, res.URI)
+	}
+	payload, err := c.graphQL(ctx, "areEntitiesInLibrary", map[string]any{"uris": normalized})
+	if err != nil {
+		return nil, err
+	}
+	data, _ := getMap(payload, "data")
+	lookup, _ := data["lookup"].([]any)
+	if len(lookup) != len(uris) {
+		return nil, fmt.Errorf("areEntitiesInLibrary: got %d results for %d uris", len(lookup), len(uris))
+	}
+	saved := make([]bool, len(uris))
+	for i, raw := range lookup {
+		entry, _ := raw.(map[string]any)
+		if inner, ok := getMap(entry, "data"); ok {
+			entry = inner
+		}
+		saved[i] = getBool(entry, "saved") || getBool(entry, "isFollowing")
+	}
+	return saved, nil
+}
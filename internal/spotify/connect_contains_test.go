@@ -0,0 +1,97 @@
+package spotify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestConnectLibraryContains(t *testing.T) {
+	var vars map[string]any
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Query().Get("operationName") != "areEntitiesInLibrary" {
+			return textResponse(http.StatusNotFound, "missing"), nil
+		}
+		if err := json.Unmarshal([]byte(req.URL.Query().Get("variables")), &vars); err != nil {
+			t.Fatalf("variables: %v", err)
+		}
+		return jsonResponse(http.StatusOK, map[string]any{"data": map[string]any{"lookup": []any{
+			map[string]any{"__typename": "TrackResponseWrapper", "data": map[string]any{"saved": true}},
+			map[string]any{"__typename": "ArtistResponseWrapper", "data": map[string]any{"saved": false}},
+			map[string]any{"data": map[string]any{"isFollowing": true}},
+		}}}), nil
+	})
+	client := newConnectClientForTests(transport)
+	client.hashes.hashes["areEntitiesInLibrary"] = "hash"
+	uris := []string{"spotify:track:t1", "spotify:artist:a1", "spotify:playlist:p1"}
+	saved, err := client.LibraryContains(context.Background(), uris)
+	if err != nil {
+		t.Fatalf("contains: %v", err)
+	}
+	if !saved[0] || saved[1] || !saved[2] {
+		t.Fatalf("saved %#v", saved)
+	}
+	if got, _ := vars["uris"].([]any); len(got) != 3 {
+		t.Fatalf("variables %#v", vars)
+	}
+	if _, err := client.LibraryContains(context.Background(), []string{"t1"}); err == nil {
+		t.Fatalf("expected error for raw id")
+	}
+}
+
+func TestConnectLibraryContainsFallsBackToWeb(t *testing.T) {
+	var requests []string
+	webServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path+"?"+r.URL.RawQuery)
+		switch r.URL.Path {
+		case "/me":
+			_ = json.NewEncoder(w).Encode(userProfile{ID: "me"})
+		case "/me/tracks/contains":
+			ids := strings.Split(r.URL.Query().Get("ids"), ",")
+			out := make([]bool, len(ids))
+			for i, id := range ids {
+				out[i] = id == "t2"
+			}
+			_ = json.NewEncoder(w).Encode(out)
+		case "/me/following/contains":
+			_ = json.NewEncoder(w).Encode([]bool{true})
+		case "/playlists/p1/followers/contains":
+			_ = json.NewEncoder(w).Encode([]bool{true})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(webServer.Close)
+	webClient, err := NewClient(Options{TokenProvider: staticTokenProvider{}, BaseURL: webServer.URL, HTTPClient: webServer.Client()})
+	if err != nil {
+		t.Fatalf("web client: %v", err)
+	}
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return textResponse(http.StatusInternalServerError, "boom"), nil
+	})
+	client := newConnectClientForTests(transport)
+	client.web = webClient
+	uris := []string{"spotify:track:t1", "spotify:artist:a1", "spotify:track:t2", "https://open.spotify.com/playlist/p1"}
+	saved, err := client.LibraryContains(context.Background(), uris)
+	if err != nil {
+		t.Fatalf("contains: %v %q", err, requests)
+	}
+	if saved[0] || !saved[1] || !saved[2] || !saved[3] {
+		t.Fatalf("saved %#v", saved)
+	}
+	want := []string{
+		"/me/tracks/contains?ids=t1%2Ct2",
+		"/me/following/contains?ids=a1&type=artist",
+		"/me?",
+		"/playlists/p1/followers/contains?ids=me",
+	}
+	if strings.Join(requests, " ") != strings.Join(want, " ") {
+		t.Fatalf("requests %q", requests)
+	}
+	if _, err := webClient.LibraryContains(context.Background(), []string{"spotify:chapter:c1"}); err == nil {
+		t.Fatalf("expected unsupported type error")
+	}
+}
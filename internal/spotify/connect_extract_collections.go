@@ -19,17 +19,29 @@ func extractLibraryV3Items(payload map[string]any, kind string) ([]Item, int) {
 // The track URI lives at items[i].track._uri (not inside .data), so we
 // inject it into the data map before passing it to extractItem.
 func extractFetchLibraryTracks(payload map[string]any) ([]Item, int, error) {
-	tracks, ok := getMap(payload, "data", "me", "library", "tracks")
+	return extractFetchLibraryItems(payload, "fetchLibraryTracks", "tracks", "track")
+}
+
+// extractFetchLibraryEpisodes reads "Your Episodes" from the
+// fetchLibraryEpisodes response, which has the same shape as
+// fetchLibraryTracks under data.me.library.episodes.
+func extractFetchLibraryEpisodes(payload map[string]any) ([]Item, int, error) {
+	return extractFetchLibraryItems(payload, "fetchLibraryEpisodes", "episodes", "episode")
+}
+
+func extractFetchLibraryItems(payload map[string]any, operation, collection, kind string) ([]Item, int, error) {
+	path := "data.me.library." + collection
+	container, ok := getMap(payload, "data", "me", "library", collection)
 	if !ok {
-		return nil, 0, fmt.Errorf("fetchLibraryTracks payload missing data.me.library.tracks")
+		return nil, 0, fmt.Errorf("%s payload missing %s", operation, path)
 	}
-	rawItemsValue, ok := tracks["items"]
+	rawItemsValue, ok := container["items"]
 	if !ok {
-		return nil, 0, fmt.Errorf("fetchLibraryTracks payload missing data.me.library.tracks.items")
+		return nil, 0, fmt.Errorf("%s payload missing %s.items", operation, path)
 	}
 	rawItems, ok := rawItemsValue.([]any)
 	if !ok {
-		return nil, 0, fmt.Errorf("fetchLibraryTracks payload has invalid data.me.library.tracks.items")
+		return nil, 0, fmt.Errorf("%s payload has invalid %s.items", operation, path)
 	}
 	items := make([]Item, 0, len(rawItems))
 	seen := map[string]struct{}{}
@@ -38,7 +50,7 @@ func extractFetchLibraryTracks(payload map[string]any) ([]Item, int, error) {
 		if !ok {
 			continue
 		}
-		wrapper, ok := m["track"].(map[string]any)
+		wrapper, ok := m[kind].(map[string]any)
 		if !ok {
 			continue
 		}
@@ -50,7 +62,7 @@ func extractFetchLibraryTracks(payload map[string]any) ([]Item, int, error) {
 		if uri, ok := wrapper["_uri"].(string); ok && getString(dataM, "uri") == "" {
 			dataM["uri"] = uri
 		}
-		item, ok := extractItem(dataM, "track")
+		item, ok := extractItem(dataM, kind)
 		if !ok {
 			continue
 		}
@@ -60,7 +72,7 @@ func extractFetchLibraryTracks(payload map[string]any) ([]Item, int, error) {
 		seen[item.URI] = struct{}{}
 		items = append(items, item)
 	}
-	total := getInt(tracks, "totalCount")
+	total := getInt(container, "totalCount")
 	if total == 0 {
 		total = len(items)
 	}
@@ -37,6 +37,10 @@ func extractItem(value any, kind string) (Item, bool) {
 			m = inner
 		}
 	}
+	// Search results wrap audiobooks and podcasts as {"__typename": "...ResponseWrapper", "data": {...}}.
+	if inner, ok := m["data"].(map[string]any); ok && getString(m, "uri") == "" && getString(inner, "uri") != "" {
+		m = inner
+	}
 	uri := getString(m, "uri")
 	if uri == "" && kind != "" {
 		if id := getString(m, "id"); id != "" {
@@ -98,12 +102,26 @@ func extractItem(value any, kind string) (Item, bool) {
 	if item.DurationMS == 0 {
 		item.DurationMS = getNestedInt(m, "trackDuration", "totalMilliseconds")
 	}
+	item.TrackNumber = getInt(m, "trackNumber")
+	item.DiscNumber = getInt(m, "discNumber")
 	item.Owner = extractOwnerName(m)
 	item.TotalTracks = getInt(m, "totalTracks")
 	if item.TotalTracks == 0 {
 		item.TotalTracks = getInt(m, "total")
 	}
 	item.ReleaseDate = getString(m, "releaseDate")
+	if item.ReleaseDate == "" {
+		if release, ok := getMap(m, "releaseDate"); ok {
+			item.ReleaseDate = getString(release, "isoString")
+			if len(item.ReleaseDate) > len("2006-01-02") {
+				item.ReleaseDate = item.ReleaseDate[:len("2006-01-02")]
+			}
+		}
+	}
+	if played, ok := getMap(m, "playedState"); ok {
+		item.ResumePositionMS = getInt(played, "playPositionMilliseconds")
+		item.FullyPlayed = getString(played, "state") == "COMPLETED"
+	}
 	item.Description = getString(m, "description")
 	item.IsPlayable = getBool(m, "isPlayable")
 	if !item.IsPlayable {
@@ -111,6 +129,16 @@ func extractItem(value any, kind string) (Item, bool) {
 	}
 	item.Publisher = getString(m, "publisher")
 	item.TotalEpisodes = getInt(m, "totalEpisodes")
+	item.Authors = extractPeopleNames(m, "authors")
+	item.Narrators = extractPeopleNames(m, "narrators")
+	item.ChapterNumber = getInt(m, "chapterNumber")
+	// Audiobooks and chapters reuse show/episode URIs; only the typename tells them apart.
+	switch getString(m, "__typename") {
+	case "Audiobook":
+		item.Type = "audiobook"
+	case "Chapter":
+		item.Type = "chapter"
+	}
 	return item, true
 }
 
@@ -52,6 +52,30 @@ func extractArtistNames(value any) []string {
 	return dedupeStrings(artists)
 }
 
+// extractPeopleNames reads audiobook authors or narrators, given either as a
+// plain list or as {"items": [...]}.
+func extractPeopleNames(m map[string]any, key string) []string {
+	var entries []any
+	switch typed := m[key].(type) {
+	case []any:
+		entries = typed
+	case map[string]any:
+		entries, _ = typed["items"].([]any)
+	}
+	names := []string{}
+	for _, entry := range entries {
+		if person, ok := entry.(map[string]any); ok {
+			if name := getString(person, "name"); name != "" {
+				names = append(names, name)
+			}
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	return dedupeStrings(names)
+}
+
 func appendArtistNames(artists *[]string, entries []any) {
 	for _, entry := range entries {
 		if name := artistNameFromValue(entry); name != "" {
@@ -1,9 +1,13 @@
 package spotify
 
 func extractSearchItems(payload map[string]any, kind string) ([]Item, int) {
+	uriKind := connectURIKind(kind)
 	for _, path := range searchPaths(kind) {
 		if container, ok := getMap(payload, path...); ok {
-			items := extractItemsFromContainer(container, kind)
+			items := extractItemsFromContainer(container, uriKind)
+			if uriKind != kind {
+				items = retypeItems(items, kind)
+			}
 			total := getInt(container, "totalCount")
 			if total == 0 {
 				total = len(items)
@@ -11,6 +15,10 @@ func extractSearchItems(payload map[string]any, kind string) ([]Item, int) {
 			return items, total
 		}
 	}
+	if uriKind != kind {
+		// Without the dedicated section, shows and audiobooks are indistinguishable.
+		return []Item{}, 0
+	}
 	items := collectItemsByKind(payload, kind)
 	return items, len(items)
 }
@@ -49,6 +57,8 @@ func searchPaths(kind string) [][]string {
 		return [][]string{{"data", "searchV2", "podcasts"}, {"data", "searchV2", "shows"}}
 	case "episode":
 		return [][]string{{"data", "searchV2", "episodes"}}
+	case "audiobook":
+		return [][]string{{"data", "searchV2", "audiobooks"}}
 	default:
 		return nil
 	}
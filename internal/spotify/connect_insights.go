@@ -0,0 +1,65 @@
+package spotify
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Me prefers the Web API, which is the only source for country, product
+// and follower count; pathfinder's profileAttributes still yields the
+// name and username when the Web API is unavailable.
+func (c *ConnectClient) Me(ctx context.Context) (UserProfile, error) {
+	web, err := c.webClient()
+	if err == nil {
+		profile, werr := web.Me(ctx)
+		if werr == nil {
+			return profile, nil
+		}
+		err = werr
+	}
+	profile, perr := c.profileAttributes(ctx)
+	if perr != nil {
+		return UserProfile{}, err
+	}
+	return profile, nil
+}
+
+// TopItems and RecentlyPlayed have no pathfinder equivalent; they always go
+// through the Web API.
+func (c *ConnectClient) TopItems(ctx context.Context, kind string, timeRange TimeRange, limit, offset int) ([]Item, int, error) {
+	web, err := c.webClient()
+	if err != nil {
+		return nil, 0, err
+	}
+	return web.TopItems(ctx, kind, timeRange, limit, offset)
+}
+
+func (c *ConnectClient) RecentlyPlayed(ctx context.Context, limit int, after time.Time) ([]Item, error) {
+	web, err := c.webClient()
+	if err != nil {
+		return nil, err
+	}
+	return web.RecentlyPlayed(ctx, limit, after)
+}
+
+func (c *ConnectClient) profileAttributes(ctx context.Context) (UserProfile, error) {
+	payload, err := c.graphQL(ctx, "profileAttributes", map[string]any{})
+	if err != nil {
+		return UserProfile{}, err
+	}
+	profile, ok := getMap(payload, "data", "me", "profile")
+	if !ok {
+		return UserProfile{}, errors.New("profileAttributes payload missing profile")
+	}
+	username := getString(profile, "username")
+	if username == "" {
+		return UserProfile{}, errors.New("profileAttributes payload missing username")
+	}
+	return UserProfile{
+		ID:          username,
+		DisplayName: getString(profile, "name"),
+		URI:         "spotify:user:" + username,
+		URL:         "https://open.spotify.com/user/" + username,
+	}, nil
+}
@@ -0,0 +1,131 @@
+package spotify
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newInsightsWebClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	client, err := NewClient(Options{TokenProvider: staticTokenProvider{}, BaseURL: server.URL, HTTPClient: server.Client()})
+	if err != nil {
+		t.Fatalf("web client: %v", err)
+	}
+	return client
+}
+
+func TestClientAccountInsights(t *testing.T) {
+	var topQuery, recentQuery string
+	client := newInsightsWebClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/me":
+			_, _ = w.Write([]byte(`{"id":"u1","display_name":"Ada","country":"AT","product":"premium","uri":"spotify:user:u1","followers":{"total":42},"external_urls":{"spotify":"https://open.spotify.com/user/u1"}}`))
+		case "/me/top/tracks":
+			topQuery = r.URL.RawQuery
+			_, _ = w.Write([]byte(`{"items":[{"id":"t1","uri":"spotify:track:t1","name":"One","duration_ms":1000,"artists":[{"name":"A"}],"album":{"name":"Al"}},{"id":""}],"total":7}`))
+		case "/me/top/artists":
+			_, _ = w.Write([]byte(`{"items":[{"id":"a1","uri":"spotify:artist:a1","name":"Artist"}],"total":1}`))
+		case "/me/player/recently-played":
+			recentQuery = r.URL.RawQuery
+			_, _ = w.Write([]byte(`{"items":[{"played_at":"2026-01-02T03:04:05Z","track":{"id":"t1","uri":"spotify:track:t1","name":"One"}},{"track":{}}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	ctx := context.Background()
+	profile, err := client.Me(ctx)
+	if err != nil {
+		t.Fatalf("me: %v", err)
+	}
+	if profile.DisplayName != "Ada" || profile.Country != "AT" || profile.Product != "premium" || profile.Followers != 42 || profile.URL == "" {
+		t.Fatalf("profile %#v", profile)
+	}
+	tracks, total, err := client.TopItems(ctx, "track", TimeRangeShort, 10, 5)
+	if err != nil || total != 7 || len(tracks) != 1 || tracks[0].Type != "track" {
+		t.Fatalf("top tracks: %v %d %#v", err, total, tracks)
+	}
+	if topQuery != "limit=10&offset=5&time_range=short_term" {
+		t.Fatalf("top query %q", topQuery)
+	}
+	artists, _, err := client.TopItems(ctx, "artist", TimeRangeLong, 10, 0)
+	if err != nil || len(artists) != 1 || artists[0].Type != "artist" {
+		t.Fatalf("top artists: %v %#v", err, artists)
+	}
+	if _, _, err := client.TopItems(ctx, "album", TimeRangeLong, 10, 0); err == nil {
+		t.Fatalf("expected unsupported type error")
+	}
+	after := time.UnixMilli(1700000000000)
+	recent, err := client.RecentlyPlayed(ctx, 20, after)
+	if err != nil || len(recent) != 1 || recent[0].PlayedAt != "2026-01-02T03:04:05Z" {
+		t.Fatalf("recent: %v %#v", err, recent)
+	}
+	if recentQuery != "after=1700000000000&limit=20" {
+		t.Fatalf("recent query %q", recentQuery)
+	}
+	if _, err := client.RecentlyPlayed(ctx, 20, time.Time{}); err != nil || recentQuery != "limit=20" {
+		t.Fatalf("recent without after: %v %q", err, recentQuery)
+	}
+}
+
+func TestConnectMeFallsBackToProfileAttributes(t *testing.T) {
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Query().Get("operationName") != "profileAttributes" {
+			return textResponse(http.StatusNotFound, "missing"), nil
+		}
+		return jsonResponse(http.StatusOK, map[string]any{"data": map[string]any{"me": map[string]any{
+			"profile": map[string]any{"name": "Ada", "username": "u1"},
+		}}}), nil
+	})
+	client := newConnectClientForTests(transport)
+	client.hashes.hashes["profileAttributes"] = "hash"
+	client.web = newInsightsWebClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+	profile, err := client.Me(context.Background())
+	if err != nil {
+		t.Fatalf("me: %v", err)
+	}
+	if profile.ID != "u1" || profile.DisplayName != "Ada" || profile.URI != "spotify:user:u1" {
+		t.Fatalf("profile %#v", profile)
+	}
+
+	empty := newConnectClientForTests(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return jsonResponse(http.StatusOK, map[string]any{"data": map[string]any{"me": map[string]any{}}}), nil
+	}))
+	empty.hashes.hashes["profileAttributes"] = "hash"
+	empty.web = client.web
+	if _, err := empty.Me(context.Background()); err == nil {
+		t.Fatalf("expected error")
+	}
+}
+
+func TestConnectInsightsUseWeb(t *testing.T) {
+	client := newConnectClientForTests(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return textResponse(http.StatusInternalServerError, "boom"), nil
+	}))
+	client.web = newInsightsWebClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/me":
+			_, _ = w.Write([]byte(`{"id":"u1","country":"AT"}`))
+		case "/me/top/artists":
+			_, _ = w.Write([]byte(`{"items":[{"id":"a1","name":"Artist"}],"total":1}`))
+		case "/me/player/recently-played":
+			_, _ = w.Write([]byte(`{"items":[{"played_at":"2026-01-02T03:04:05Z","track":{"id":"t1","name":"One"}}]}`))
+		}
+	})
+	ctx := context.Background()
+	if profile, err := client.Me(ctx); err != nil || profile.Country != "AT" {
+		t.Fatalf("me: %v %#v", err, profile)
+	}
+	if items, total, err := client.TopItems(ctx, "artist", TimeRangeMedium, 10, 0); err != nil || total != 1 || len(items) != 1 {
+		t.Fatalf("top: %v %#v", err, items)
+	}
+	if items, err := client.RecentlyPlayed(ctx, 10, time.Time{}); err != nil || len(items) != 1 {
+		t.Fatalf("recent: %v %#v", err, items)
+	}
+}
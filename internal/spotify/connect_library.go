@@ -1,6 +1,9 @@
 package spotify
 
-import "context"
+import (
+	"context"
+	"errors"
+)
 
 func (c *ConnectClient) playlists(ctx context.Context, limit, offset int) ([]Item, int, error) {
 	payload, err := c.graphQL(ctx, "libraryV3", libraryV3Variables("Playlists", normalizeLibraryLimit(limit), offset))
@@ -20,6 +23,63 @@ func (c *ConnectClient) playlistTracks(ctx context.Context, id string, limit, of
 	return items, total, nil
 }
 
+// albumTracks reads the getAlbum track list. Pathfinder tracks omit the
+// album, so its name is copied onto each item.
+func (c *ConnectClient) albumTracks(ctx context.Context, id string, limit, offset int) ([]Item, int, error) {
+	payload, err := c.graphQL(ctx, "getAlbum", map[string]any{
+		"uri":    "spotify:album:" + id,
+		"locale": c.language,
+		"offset": offset,
+		"limit":  normalizeLibraryLimit(limit),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	album, ok := getMap(payload, "data", "albumUnion")
+	if !ok {
+		return nil, 0, errors.New("getAlbum payload missing albumUnion")
+	}
+	tracks, ok := getMap(album, "tracksV2")
+	if !ok {
+		if tracks, ok = getMap(album, "tracks"); !ok {
+			return nil, 0, errors.New("getAlbum payload missing tracks")
+		}
+	}
+	items := extractListItems(tracks, "track")
+	name := getString(album, "name")
+	for i := range items {
+		if items[i].Album == "" {
+			items[i].Album = name
+		}
+	}
+	total := getInt(tracks, "totalCount")
+	if total == 0 {
+		total = offset + len(items)
+	}
+	return items, total, nil
+}
+
+func (c *ConnectClient) showEpisodes(ctx context.Context, id string, limit, offset int) ([]Item, int, error) {
+	payload, err := c.graphQL(ctx, "queryPodcastEpisodes", map[string]any{
+		"uri":    "spotify:show:" + id,
+		"offset": offset,
+		"limit":  normalizeLibraryLimit(limit),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	episodes, ok := getMap(payload, "data", "podcastUnionV2", "episodesV2")
+	if !ok {
+		return nil, 0, errors.New("queryPodcastEpisodes payload missing episodesV2")
+	}
+	items := extractListItems(episodes, "episode")
+	total := getInt(episodes, "totalCount")
+	if total == 0 {
+		total = offset + len(items)
+	}
+	return items, total, nil
+}
+
 func (c *ConnectClient) libraryTracks(ctx context.Context, limit, offset int) ([]Item, int, error) {
 	vars := map[string]any{
 		"uri":    "spotify:collection:tracks",
@@ -42,6 +102,28 @@ func (c *ConnectClient) libraryAlbums(ctx context.Context, limit, offset int) ([
 	return items, total, nil
 }
 
+func (c *ConnectClient) libraryShows(ctx context.Context, limit, offset int) ([]Item, int, error) {
+	payload, err := c.graphQL(ctx, "libraryV3", libraryV3Variables("Podcasts", normalizeLibraryLimit(limit), offset))
+	if err != nil {
+		return nil, 0, err
+	}
+	items, total := extractLibraryV3Items(payload, "show")
+	return items, total, nil
+}
+
+func (c *ConnectClient) libraryEpisodes(ctx context.Context, limit, offset int) ([]Item, int, error) {
+	vars := map[string]any{
+		"uri":    "spotify:collection:your-episodes",
+		"offset": offset,
+		"limit":  normalizeLibraryLimit(limit),
+	}
+	payload, err := c.graphQL(ctx, "fetchLibraryEpisodes", vars)
+	if err != nil {
+		return nil, 0, err
+	}
+	return extractFetchLibraryEpisodes(payload)
+}
+
 func normalizeLibraryLimit(limit int) int {
 	if limit <= 0 {
 		return 50
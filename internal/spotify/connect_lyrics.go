@@ -0,0 +1,91 @@
+package spotify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const lyricsBase = "https://gue1-spclient.spotify.com/color-lyrics/v2/track"
+
+type colorLyricsResponse struct {
+	Lyrics struct {
+		SyncType string `json:"syncType"`
+		Provider string `json:"provider"`
+		Language string `json:"language"`
+		Lines    []struct {
+			StartTimeMs string `json:"startTimeMs"`
+			Words       string `json:"words"`
+		} `json:"lines"`
+	} `json:"lyrics"`
+}
+
+// Lyrics fetches a track's lyrics from the color-lyrics service the web
+// player uses, with the same spclient auth as Connect commands.
+func (c *ConnectClient) Lyrics(ctx context.Context, trackID string) (Lyrics, error) {
+	if c.session == nil {
+		return Lyrics{}, errors.New("connect client not initialized")
+	}
+	auth, err := c.session.auth(ctx)
+	if err != nil {
+		return Lyrics{}, err
+	}
+	params := url.Values{}
+	params.Set("format", "json")
+	params.Set("vocalRemoval", "false")
+	params.Set("market", "from_token")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, lyricsBase+"/"+url.PathEscape(trackID)+"?"+params.Encode(), nil)
+	if err != nil {
+		return Lyrics{}, err
+	}
+	applyRequestHeaders(req, requestHeaders{
+		AccessToken:   auth.AccessToken,
+		ClientToken:   auth.ClientToken,
+		ClientVersion: auth.ClientVersion,
+		Accept:        "application/json",
+		Language:      c.language,
+		AppPlatform:   defaultSpotifyAppPlatform,
+	})
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return Lyrics{}, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	// The service answers 404 or an empty body when a track has no lyrics.
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusNoContent {
+		return Lyrics{}, ErrNoLyrics
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return Lyrics{}, apiErrorFromResponse(resp)
+	}
+	var payload colorLyricsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return Lyrics{}, err
+	}
+	return mapColorLyrics(trackID, payload)
+}
+
+func mapColorLyrics(trackID string, payload colorLyricsResponse) (Lyrics, error) {
+	raw := payload.Lyrics
+	lyrics := Lyrics{
+		TrackID:  trackID,
+		Synced:   raw.SyncType == "LINE_SYNCED",
+		Provider: raw.Provider,
+		Language: raw.Language,
+		Lines:    make([]LyricLine, 0, len(raw.Lines)),
+	}
+	for _, line := range raw.Lines {
+		start, _ := strconv.Atoi(line.StartTimeMs)
+		// Instrumental breaks come through as a music note placeholder.
+		text := strings.TrimSpace(strings.ReplaceAll(line.Words, "♪", ""))
+		lyrics.Lines = append(lyrics.Lines, LyricLine{StartMS: start, Text: text})
+	}
+	if len(lyrics.Lines) == 0 {
+		return Lyrics{}, ErrNoLyrics
+	}
+	return lyrics, nil
+}
@@ -0,0 +1,79 @@
+package spotify
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestConnectLyrics(t *testing.T) {
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasSuffix(req.URL.Path, "/color-lyrics/v2/track/t1") {
+			return textResponse(http.StatusNotFound, "missing"), nil
+		}
+		if req.Header.Get("Authorization") != "Bearer access" || req.URL.Query().Get("format") != "json" {
+			return textResponse(http.StatusBadRequest, "bad"), nil
+		}
+		return jsonResponse(http.StatusOK, map[string]any{
+			"lyrics": map[string]any{
+				"syncType": "LINE_SYNCED",
+				"provider": "MusixMatch",
+				"language": "en",
+				"lines": []map[string]any{
+					{"startTimeMs": "1000", "words": "Hello"},
+					{"startTimeMs": "4500", "words": "♪"},
+					{"startTimeMs": "6000", "words": "World"},
+				},
+			},
+		}), nil
+	})
+	client := newConnectClientForTests(transport)
+	lyrics, err := client.Lyrics(context.Background(), "t1")
+	if err != nil {
+		t.Fatalf("lyrics: %v", err)
+	}
+	if !lyrics.Synced || lyrics.Provider != "MusixMatch" || len(lyrics.Lines) != 3 {
+		t.Fatalf("lyrics %#v", lyrics)
+	}
+	if lyrics.Lines[0] != (LyricLine{StartMS: 1000, Text: "Hello"}) || lyrics.Lines[1].Text != "" {
+		t.Fatalf("lines %#v", lyrics.Lines)
+	}
+	if _, err := client.Lyrics(context.Background(), "none"); !errors.Is(err, ErrNoLyrics) {
+		t.Fatalf("expected no lyrics, got %v", err)
+	}
+}
+
+func TestConnectLyricsErrors(t *testing.T) {
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if strings.HasSuffix(req.URL.Path, "/empty") {
+			return jsonResponse(http.StatusOK, map[string]any{"lyrics": map[string]any{"syncType": "UNSYNCED"}}), nil
+		}
+		return textResponse(http.StatusInternalServerError, "boom"), nil
+	})
+	client := newConnectClientForTests(transport)
+	if _, err := client.Lyrics(context.Background(), "empty"); !errors.Is(err, ErrNoLyrics) {
+		t.Fatalf("expected no lyrics, got %v", err)
+	}
+	var apiErr APIError
+	if _, err := client.Lyrics(context.Background(), "t1"); !errors.As(err, &apiErr) {
+		t.Fatalf("expected api error, got %v", err)
+	}
+	if _, err := (&ConnectClient{}).Lyrics(context.Background(), "t1"); err == nil {
+		t.Fatalf("expected uninitialized error")
+	}
+}
+
+func TestLyricsLineAt(t *testing.T) {
+	lyrics := Lyrics{Synced: true, Lines: []LyricLine{{StartMS: 1000}, {StartMS: 4000}, {StartMS: 9000}}}
+	for progress, want := range map[int]int{0: -1, 1000: 0, 3999: 0, 4000: 1, 60000: 2} {
+		if got := lyrics.LineAt(progress); got != want {
+			t.Fatalf("LineAt(%d) = %d, want %d", progress, got, want)
+		}
+	}
+	lyrics.Synced = false
+	if lyrics.LineAt(5000) != -1 {
+		t.Fatalf("unsynced lyrics have no current line")
+	}
+}
@@ -104,10 +104,7 @@ func TestConnectLibraryTracksFallsBackWhenFetchLibraryTracksPayloadDrifts(t *tes
 		switch r.URL.Path {
 		case "/me/tracks":
 			_ = json.NewEncoder(w).Encode(libraryResponse{
-				Items: []struct {
-					Track trackItem `json:"track"`
-					Album albumItem `json:"album"`
-				}{
+				Items: []libraryItem{
 					{Track: trackItem{ID: "t1", URI: "spotify:track:t1", Name: "Song"}},
 				},
 				Total: 1,
@@ -201,3 +198,142 @@ func TestConnectInfoFallbackToWeb(t *testing.T) {
 		t.Fatalf("episode: %#v err=%v", item, err)
 	}
 }
+
+func TestConnectAlbumTracks(t *testing.T) {
+	var vars map[string]any
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Query().Get("operationName") != "getAlbum" {
+			return textResponse(http.StatusNotFound, "missing"), nil
+		}
+		if err := json.Unmarshal([]byte(req.URL.Query().Get("variables")), &vars); err != nil {
+			t.Fatalf("variables: %v", err)
+		}
+		return jsonResponse(http.StatusOK, map[string]any{"data": map[string]any{"albumUnion": map[string]any{
+			"uri":  "spotify:album:a1",
+			"name": "Record",
+			"tracksV2": map[string]any{
+				"totalCount": 12,
+				"items": []any{
+					map[string]any{"uid": "u1", "track": map[string]any{
+						"uri":         "spotify:track:t1",
+						"name":        "Intro",
+						"trackNumber": 1,
+						"discNumber":  2,
+						"duration":    map[string]any{"totalMilliseconds": 61000},
+						"playability": map[string]any{"playable": true},
+						"artists":     map[string]any{"items": []any{map[string]any{"uri": "spotify:artist:ar1", "profile": map[string]any{"name": "Band"}}}},
+					}},
+					map[string]any{"uid": "u2", "track": map[string]any{"uri": "spotify:track:t2", "name": "Gone", "trackNumber": 2, "discNumber": 2}},
+				},
+			},
+		}}}), nil
+	})
+	client := newConnectClientForTests(transport)
+	client.hashes.hashes["getAlbum"] = "hash"
+
+	tracks, total, err := client.AlbumTracks(context.Background(), "a1", 2, 10)
+	if err != nil || total != 12 || len(tracks) != 2 {
+		t.Fatalf("album tracks: %#v total=%d err=%v", tracks, total, err)
+	}
+	first := tracks[0]
+	if first.TrackNumber != 1 || first.DiscNumber != 2 || first.DurationMS != 61000 || !first.IsPlayable ||
+		first.Album != "Record" || len(first.Artists) != 1 || first.Artists[0] != "Band" {
+		t.Fatalf("first track: %#v", first)
+	}
+	if tracks[1].IsPlayable {
+		t.Fatalf("expected second track unplayable")
+	}
+	if getInt(vars, "offset") != 10 || getInt(vars, "limit") != 2 || getString(vars, "uri") != "spotify:album:a1" {
+		t.Fatalf("variables: %#v", vars)
+	}
+}
+
+func TestConnectShowEpisodes(t *testing.T) {
+	episode := func(id, state string, position int) map[string]any {
+		return map[string]any{"uid": id, "entity": map[string]any{"_uri": "spotify:episode:" + id, "data": map[string]any{
+			"uri":         "spotify:episode:" + id,
+			"name":        "Episode " + id,
+			"releaseDate": map[string]any{"isoString": "2026-04-02T08:00:00Z"},
+			"duration":    map[string]any{"totalMilliseconds": 3600000},
+			"playedState": map[string]any{"state": state, "playPositionMilliseconds": position},
+			"podcastV2":   map[string]any{"data": map[string]any{"uri": "spotify:show:s1", "name": "Show"}},
+		}}}
+	}
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Query().Get("operationName") != "queryPodcastEpisodes" {
+			return textResponse(http.StatusNotFound, "missing"), nil
+		}
+		return jsonResponse(http.StatusOK, map[string]any{"data": map[string]any{"podcastUnionV2": map[string]any{
+			"episodesV2": map[string]any{
+				"totalCount": 80,
+				"items":      []any{episode("e1", "IN_PROGRESS", 125000), episode("e2", "COMPLETED", 0)},
+			},
+		}}}), nil
+	})
+	client := newConnectClientForTests(transport)
+	client.hashes.hashes["queryPodcastEpisodes"] = "hash"
+
+	items, total, err := client.ShowEpisodes(context.Background(), "s1", 20, 0)
+	if err != nil || total != 80 || len(items) != 2 {
+		t.Fatalf("show episodes: %#v total=%d err=%v", items, total, err)
+	}
+	first := items[0]
+	if first.ID != "e1" || first.ReleaseDate != "2026-04-02" || first.DurationMS != 3600000 || first.ResumePositionMS != 125000 || first.FullyPlayed {
+		t.Fatalf("first episode: %#v", first)
+	}
+	if !items[1].FullyPlayed {
+		t.Fatalf("expected second episode fully played: %#v", items[1])
+	}
+}
+
+func TestConnectLibraryShowsAndEpisodes(t *testing.T) {
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		variables := req.URL.Query().Get("variables")
+		switch req.URL.Query().Get("operationName") {
+		case "libraryV3":
+			if !strings.Contains(variables, `"Podcasts"`) || !strings.Contains(variables, `"YOUR_EPISODES"`) {
+				t.Fatalf("libraryV3 variables = %s", variables)
+			}
+			return jsonResponse(http.StatusOK, map[string]any{
+				"data": map[string]any{"me": map[string]any{"libraryV3": map[string]any{
+					"totalCount": 1,
+					"items": []any{map[string]any{"item": map[string]any{"data": map[string]any{
+						"uri":       "spotify:show:s1",
+						"name":      "Daily",
+						"publisher": map[string]any{"name": "Studio"},
+					}}}},
+				}}},
+			}), nil
+		case "fetchLibraryEpisodes":
+			if !strings.Contains(variables, "spotify:collection:your-episodes") {
+				t.Fatalf("fetchLibraryEpisodes variables = %s", variables)
+			}
+			return jsonResponse(http.StatusOK, map[string]any{
+				"data": map[string]any{"me": map[string]any{"library": map[string]any{"episodes": map[string]any{
+					"totalCount": 3,
+					"items": []any{map[string]any{"episode": map[string]any{
+						"_uri": "spotify:episode:e1",
+						"data": map[string]any{"name": "Saved", "playedState": map[string]any{"state": "IN_PROGRESS", "playPositionMilliseconds": 5000}},
+					}}},
+				}}}},
+			}), nil
+		}
+		return textResponse(http.StatusNotFound, "missing"), nil
+	})
+	client := newConnectClientForTests(transport)
+	for _, op := range []string{"libraryV3", "fetchLibraryEpisodes"} {
+		client.hashes.hashes[op] = "hash"
+	}
+
+	shows, total, err := client.LibraryShows(context.Background(), 10, 0)
+	if err != nil || total != 1 || len(shows) != 1 || shows[0].ID != "s1" || shows[0].Type != "show" {
+		t.Fatalf("library shows: %#v total=%d err=%v", shows, total, err)
+	}
+	episodes, total, err := client.LibraryEpisodes(context.Background(), 10, 0)
+	if err != nil || total != 3 || len(episodes) != 1 || episodes[0].ID != "e1" || episodes[0].ResumePositionMS != 5000 {
+		t.Fatalf("library episodes: %#v total=%d err=%v", episodes, total, err)
+	}
+	if _, _, err := extractFetchLibraryEpisodes(map[string]any{}); err == nil || !strings.Contains(err.Error(), "data.me.library.episodes") {
+		t.Fatalf("expected missing episodes error, got %v", err)
+	}
+}
@@ -30,11 +30,13 @@ func TestConnectPlaybackCommands(t *testing.T) {
 		},
 		"active_device_id": "device-1",
 	}
+	var volumePath string
 	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
 		switch {
 		case req.Method == http.MethodPut && strings.Contains(req.URL.Path, "/devices/hobs_"):
 			return jsonResponse(http.StatusOK, statePayload), nil
 		case strings.Contains(req.URL.Path, "/connect/volume/"):
+			volumePath = req.URL.Path
 			if req.Method != http.MethodPut {
 				return textResponse(http.StatusMethodNotAllowed, "method not allowed"), nil
 			}
@@ -86,6 +88,9 @@ func TestConnectPlaybackCommands(t *testing.T) {
 	if err := client.Volume(context.Background(), 200); err != nil {
 		t.Fatalf("volume high: %v", err)
 	}
+	if err := client.DeviceVolume(context.Background(), "device-2", 50); err != nil || !strings.HasSuffix(volumePath, "/to/device-2") {
+		t.Fatalf("device volume: %v %s", err, volumePath)
+	}
 	if err := client.Shuffle(context.Background(), true); err != nil {
 		t.Fatalf("shuffle: %v", err)
 	}
@@ -345,6 +350,110 @@ func TestConnectTransferFallsBackToWebAPIWithoutOriginDevice(t *testing.T) {
 	}
 }
 
+func TestConnectPlayWaitsForDevice(t *testing.T) {
+	orig := connectDevicePoll
+	connectDevicePoll = time.Millisecond
+	t.Cleanup(func() { connectDevicePoll = orig })
+	polls := 0
+	var commandPath string
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodPut && strings.Contains(req.URL.Path, "/devices/hobs_"):
+			polls++
+			devices := map[string]any{"desk": map[string]any{"name": "Desk"}}
+			if polls > 2 {
+				devices["kitchen-1"] = map[string]any{"name": "Echo Kitchen"}
+			}
+			return jsonResponse(http.StatusOK, map[string]any{"devices": devices}), nil
+		case req.Method == http.MethodPost:
+			commandPath = req.URL.Path
+			return textResponse(http.StatusOK, "ok"), nil
+		default:
+			return textResponse(http.StatusNotFound, "missing"), nil
+		}
+	})
+	client := newRegisteredConnectClientForTests(transport)
+	client.device = "Echo Kitchen"
+	client.waitDevice = time.Minute
+	if err := client.Play(context.Background(), "spotify:track:abc"); err != nil {
+		t.Fatalf("play: %v", err)
+	}
+	if polls != 3 || !strings.HasSuffix(commandPath, "/to/kitchen-1") {
+		t.Fatalf("polls %d command %s", polls, commandPath)
+	}
+}
+
+func TestConnectPlayWaitDeviceTimeout(t *testing.T) {
+	orig := connectDevicePoll
+	connectDevicePoll = time.Millisecond
+	t.Cleanup(func() { connectDevicePoll = orig })
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodPut && strings.Contains(req.URL.Path, "/devices/hobs_"):
+			return jsonResponse(http.StatusOK, map[string]any{
+				"devices": map[string]any{"desk": map[string]any{"name": "Desk"}},
+			}), nil
+		case req.Method == http.MethodPost:
+			t.Fatalf("unexpected connect command: %s", req.URL.Path)
+			return nil, errors.New("unexpected connect command")
+		default:
+			return textResponse(http.StatusNotFound, "missing"), nil
+		}
+	})
+	client := newRegisteredConnectClientForTests(transport)
+	client.device = "Kitchen"
+	client.waitDevice = 5 * time.Millisecond
+	err := client.PlayContext(context.Background(), "spotify:album:a1", "")
+	var unavailable DeviceUnavailableError
+	if !errors.As(err, &unavailable) || !errors.Is(err, ErrDeviceNotFound) {
+		t.Fatalf("expected unavailable, got %v", err)
+	}
+	if len(unavailable.Available) != 1 || !strings.Contains(err.Error(), `available: "Desk"`) {
+		t.Fatalf("error %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	client.waitDevice = time.Minute
+	if err := client.Play(ctx, ""); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected canceled, got %v", err)
+	}
+}
+
+func TestConnectPlayAmbiguousDevice(t *testing.T) {
+	polls := 0
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodPut && strings.Contains(req.URL.Path, "/devices/hobs_"):
+			polls++
+			return jsonResponse(http.StatusOK, map[string]any{
+				"devices": map[string]any{
+					"k1": map[string]any{"name": "Kitchen Echo"},
+					"k2": map[string]any{"name": "Kitchen Sonos"},
+				},
+			}), nil
+		case req.Method == http.MethodPost:
+			t.Fatalf("unexpected connect command: %s", req.URL.Path)
+			return nil, errors.New("unexpected connect command")
+		default:
+			return textResponse(http.StatusNotFound, "missing"), nil
+		}
+	})
+	for _, wait := range []time.Duration{0, time.Minute} {
+		polls = 0
+		client := newRegisteredConnectClientForTests(transport)
+		client.device = "kitchen"
+		client.waitDevice = wait
+		err := client.Play(context.Background(), "spotify:track:abc")
+		var ambiguous AmbiguousDeviceError
+		if !errors.As(err, &ambiguous) || len(ambiguous.Matches) != 2 {
+			t.Fatalf("wait %v: expected ambiguity, got %v", wait, err)
+		}
+		if polls != 1 {
+			t.Fatalf("wait %v: ambiguity should not poll, polls %d", wait, polls)
+		}
+	}
+}
+
 func TestConnectPlayFallsBackToWebAPIWithoutActiveDevice(t *testing.T) {
 	statePayload := map[string]any{
 		"devices": map[string]any{
@@ -388,6 +497,10 @@ func TestConnectPlayFallsBackToWebAPIWithoutActiveDevice(t *testing.T) {
 	if !sawWebPlay {
 		t.Fatalf("expected web play fallback")
 	}
+	sawWebPlay = false
+	if err := client.PlayContext(context.Background(), "spotify:album:a1", "spotify:track:abc"); err != nil || !sawWebPlay {
+		t.Fatalf("play context: %v web=%v", err, sawWebPlay)
+	}
 }
 
 func TestConnectPlayUsesConfiguredDeviceWithoutActiveDevice(t *testing.T) {
@@ -552,6 +665,15 @@ func TestConnectPlayContextURIPayload(t *testing.T) {
 	if !strings.Contains(capturedBody, `"context"`) {
 		t.Errorf("track play: expected context field in body, got: %s", capturedBody)
 	}
+
+	// Context at a track — context is the album, skip_to the track
+	capturedBody = ""
+	if err := newClient().PlayContext(context.Background(), "spotify:album:a1", "spotify:track:t2"); err != nil {
+		t.Fatalf("play context: %v", err)
+	}
+	if !strings.Contains(capturedBody, `"uri":"spotify:album:a1"`) || !strings.Contains(capturedBody, `"track_uri":"spotify:track:t2"`) {
+		t.Errorf("context play: unexpected body: %s", capturedBody)
+	}
 }
 
 func TestSendConnectCommandHTTPError(t *testing.T) {
@@ -0,0 +1,115 @@
+package spotify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const radioBase = "https://gue1-spclient.spotify.com/inspiredby-mix/v2/seed_to_playlist"
+
+type seedToPlaylistResponse struct {
+	MediaItems []struct {
+		URI string `json:"uri"`
+	} `json:"mediaItems"`
+}
+
+// Radio asks the inspiredby-mix service the apps use for a seed's radio
+// playlist. Seeds it has no mix for fall back to the legacy station
+// context, which Connect devices still play.
+func (c *ConnectClient) Radio(ctx context.Context, seedURI string) (string, error) {
+	res, err := ParseResource(seedURI)
+	if err != nil {
+		return "", err
+	}
+	if res.URI == "" {
+		return "", errors.New("radio seed must be a URI or URL")
+	}
+	switch res.Type {
+	case "track", "artist", "album", "playlist":
+	default:
+		return "", errors.New("radio seeds must be tracks, artists, albums or playlists")
+	}
+	if c.session == nil {
+		return "", errors.New("connect client not initialized")
+	}
+	auth, err := c.session.auth(ctx)
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, radioBase+"/"+url.PathEscape(res.URI)+"?response-format=json", nil)
+	if err != nil {
+		return "", err
+	}
+	applyRequestHeaders(req, requestHeaders{
+		AccessToken:   auth.AccessToken,
+		ClientToken:   auth.ClientToken,
+		ClientVersion: auth.ClientVersion,
+		Accept:        "application/json",
+		Language:      c.language,
+		AppPlatform:   defaultSpotifyAppPlatform,
+	})
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode == http.StatusNotFound {
+		return stationURI(res.URI), nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", apiErrorFromResponse(resp)
+	}
+	var payload seedToPlaylistResponse
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return "", err
+	}
+	for _, item := range payload.MediaItems {
+		if item.URI != "" {
+			return item.URI, nil
+		}
+	}
+	return stationURI(res.URI), nil
+}
+
+// Recommendations uses the Web API; when that fails (the endpoint is
+// closed to many apps) the first track or artist seed's radio playlist
+// stands in.
+func (c *ConnectClient) Recommendations(ctx context.Context, seeds RecommendationSeeds, limit int) ([]Item, error) {
+	if err := validateSeeds(seeds); err != nil {
+		return nil, err
+	}
+	web, err := c.webClient()
+	if err == nil {
+		items, werr := web.Recommendations(ctx, seeds, limit)
+		if werr == nil {
+			return items, nil
+		}
+		err = werr
+	}
+	seed := ""
+	switch {
+	case len(seeds.Tracks) > 0:
+		seed = "spotify:track:" + seeds.Tracks[0]
+	case len(seeds.Artists) > 0:
+		seed = "spotify:artist:" + seeds.Artists[0]
+	default:
+		return nil, err
+	}
+	radio, rerr := c.Radio(ctx, seed)
+	if rerr != nil || !strings.HasPrefix(radio, "spotify:playlist:") {
+		return nil, err
+	}
+	items, _, perr := c.PlaylistTracks(ctx, strings.TrimPrefix(radio, "spotify:playlist:"), limit, 0)
+	if perr != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func stationURI(seedURI string) string {
+	return "spotify:station:" + strings.TrimPrefix(seedURI, "spotify:")
+}
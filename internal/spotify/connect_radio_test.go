@@ -0,0 +1,95 @@
+package spotify
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func radioTransport(t *testing.T) roundTripperFunc {
+	t.Helper()
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case strings.HasSuffix(req.URL.Path, "/seed_to_playlist/spotify:track:t1"):
+			if req.Header.Get("Authorization") != "Bearer access" {
+				return textResponse(http.StatusBadRequest, "bad"), nil
+			}
+			return jsonResponse(http.StatusOK, map[string]any{"mediaItems": []any{
+				map[string]any{"uri": "spotify:playlist:mix1"},
+			}}), nil
+		case strings.HasSuffix(req.URL.Path, "/seed_to_playlist/spotify:album:a1"):
+			return jsonResponse(http.StatusOK, map[string]any{"mediaItems": []any{}}), nil
+		case strings.HasSuffix(req.URL.Path, "/seed_to_playlist/spotify:artist:boom"):
+			return textResponse(http.StatusInternalServerError, "boom"), nil
+		case strings.Contains(req.URL.Path, "/seed_to_playlist/"):
+			return textResponse(http.StatusNotFound, "missing"), nil
+		default:
+			return textResponse(http.StatusInternalServerError, "boom"), nil
+		}
+	})
+}
+
+func TestConnectRadio(t *testing.T) {
+	client := newConnectClientForTests(radioTransport(t))
+	ctx := context.Background()
+	tests := map[string]string{
+		"spotify:track:t1":                       "spotify:playlist:mix1",
+		"https://open.spotify.com/album/a1":      "spotify:station:album:a1",
+		"spotify:artist:x1":                      "spotify:station:artist:x1",
+		"https://open.spotify.com/playlist/p1?x": "spotify:station:playlist:p1",
+	}
+	for seed, want := range tests {
+		got, err := client.Radio(ctx, seed)
+		if err != nil || got != want {
+			t.Fatalf("%s: got %q %v", seed, got, err)
+		}
+	}
+	for _, seed := range []string{"t1", "spotify:show:s1", "spotify:artist:boom"} {
+		if _, err := client.Radio(ctx, seed); err == nil {
+			t.Fatalf("%s: expected error", seed)
+		}
+	}
+	if !isContextURI("spotify:station:track:t1") {
+		t.Fatalf("expected station to be a context")
+	}
+}
+
+func TestConnectRecommendations(t *testing.T) {
+	var query string
+	client := newConnectClientForTests(radioTransport(t))
+	client.web = newInsightsWebClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/recommendations":
+			query = r.URL.RawQuery
+			if r.URL.Query().Get("seed_tracks") == "t1" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			_, _ = w.Write([]byte(`{"tracks":[{"id":"r1","uri":"spotify:track:r1","name":"Rec"},{"id":""}]}`))
+		case "/playlists/mix1/tracks":
+			_, _ = w.Write([]byte(`{"items":[{"track":{"id":"m1","uri":"spotify:track:m1","name":"Mix"}}],"total":1}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	ctx := context.Background()
+	items, err := client.Recommendations(ctx, RecommendationSeeds{Artists: []string{"a1", "a2"}, Genres: []string{"jazz"}}, 10)
+	if err != nil || len(items) != 1 || items[0].ID != "r1" {
+		t.Fatalf("web: %v %#v", err, items)
+	}
+	if query != "limit=10&market=from_token&seed_artists=a1%2Ca2&seed_genres=jazz" {
+		t.Fatalf("query %q", query)
+	}
+	items, err = client.Recommendations(ctx, RecommendationSeeds{Tracks: []string{"t1"}}, 10)
+	if err != nil || len(items) != 1 || items[0].ID != "m1" {
+		t.Fatalf("radio fallback: %v %#v", err, items)
+	}
+	if _, err := client.Recommendations(ctx, RecommendationSeeds{}, 10); err == nil || !strings.Contains(err.Error(), "1-5 seeds") {
+		t.Fatalf("expected seed error, got %v", err)
+	}
+	six := RecommendationSeeds{Tracks: []string{"1", "2", "3"}, Artists: []string{"4", "5", "6"}}
+	if _, err := client.web.Recommendations(ctx, six, 10); err == nil {
+		t.Fatalf("expected seed error")
+	}
+}
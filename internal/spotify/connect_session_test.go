@@ -4,12 +4,16 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/steipete/spogo/internal/secrets"
 )
 
 type cookieSourceStub struct {
@@ -301,3 +305,34 @@ func TestConnectClientVersionOverride(t *testing.T) {
 		t.Fatalf("expected override")
 	}
 }
+
+func TestConnectCacheInSecretsStore(t *testing.T) {
+	store := &secrets.Memory{}
+	path := filepath.Join(t.TempDir(), "connect.json")
+	client, err := NewConnectClient(ConnectOptions{
+		Source:     cookieSourceStub{},
+		CachePath:  path,
+		CacheStore: store,
+		CacheKey:   "cache/work",
+	})
+	if err != nil {
+		t.Fatalf("client: %v", err)
+	}
+	if _, err := client.cache.load(); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected empty cache, got %v", err)
+	}
+	if err := client.cache.update(func(cache *connectCache) { cache.AccessToken = "access" }); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if _, err := os.Stat(path); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("cache should not touch the plaintext file")
+	}
+	data, err := store.Get("cache/work")
+	if err != nil || !strings.Contains(string(data), `"access_token":"access"`) {
+		t.Fatalf("stored %q %v", data, err)
+	}
+	cached, err := client.cache.load()
+	if err != nil || cached.AccessToken != "access" {
+		t.Fatalf("load %#v %v", cached, err)
+	}
+}
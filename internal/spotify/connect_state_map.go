@@ -64,6 +64,9 @@ func mapPlaybackStatus(state connectState) PlaybackStatus {
 	if track := extractPlaybackTrack(player); track.URI != "" {
 		status.Item = &track
 	}
+	if uri := getString(player, "context_uri"); strings.HasPrefix(uri, "spotify:") {
+		status.ContextURI = uri
+	}
 	for _, device := range mapDevices(state) {
 		if device.Active {
 			status.Device = device
@@ -22,6 +22,7 @@ func TestMapPlaybackStatusAndDevices(t *testing.T) {
 			"position_ms": 1200,
 			"shuffle":     true,
 			"repeat":      "context",
+			"context_uri": "spotify:playlist:pl",
 			"track": map[string]any{
 				"uri":  "spotify:track:abc",
 				"name": "Song",
@@ -46,7 +47,7 @@ func TestMapPlaybackStatusAndDevices(t *testing.T) {
 	if phone.Volume != 50 {
 		t.Fatalf("expected normalized volume, got %d", phone.Volume)
 	}
-	if status.Item == nil || status.Item.URI != "spotify:track:abc" {
+	if status.Item == nil || status.Item.URI != "spotify:track:abc" || status.ContextURI != "spotify:playlist:pl" {
 		t.Fatalf("expected item")
 	}
 }
@@ -0,0 +1,83 @@
+package spotify
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var ErrDeviceNotFound = errors.New("device not found")
+
+// AmbiguousDeviceError reports a selector that matched several devices
+// equally well.
+type AmbiguousDeviceError struct {
+	Selector string
+	Matches  []Device
+}
+
+func (e AmbiguousDeviceError) Error() string {
+	names := make([]string, 0, len(e.Matches))
+	for _, device := range e.Matches {
+		names = append(names, fmt.Sprintf("%q", device.Name))
+	}
+	return fmt.Sprintf("device %q is ambiguous: matches %s", e.Selector, strings.Join(names, ", "))
+}
+
+// DeviceUnavailableError reports a preferred device that did not show up
+// within the wait window. Available is the device list from the last poll.
+type DeviceUnavailableError struct {
+	Selector  string
+	Waited    time.Duration
+	Available []Device
+}
+
+func (e DeviceUnavailableError) Error() string {
+	if len(e.Available) == 0 {
+		return fmt.Sprintf("device %q not found after %s; no devices available", e.Selector, e.Waited)
+	}
+	names := make([]string, 0, len(e.Available))
+	for _, device := range e.Available {
+		names = append(names, fmt.Sprintf("%q", device.Name))
+	}
+	return fmt.Sprintf("device %q not found after %s; available: %s", e.Selector, e.Waited, strings.Join(names, ", "))
+}
+
+func (e DeviceUnavailableError) Unwrap() error {
+	return ErrDeviceNotFound
+}
+
+// MatchDevice finds the device a selector refers to. It tries, in order, an
+// exact id, an exact name, a name prefix and a name substring (names compare
+// case-insensitively) and stops at the first tier with any match; more than
+// one match in that tier is an AmbiguousDeviceError.
+func MatchDevice(devices []Device, selector string) (Device, error) {
+	selector = strings.TrimSpace(selector)
+	if selector == "" {
+		return Device{}, errors.New("device required")
+	}
+	lower := strings.ToLower(selector)
+	tiers := []func(Device) bool{
+		func(d Device) bool { return strings.EqualFold(d.ID, selector) },
+		func(d Device) bool { return strings.EqualFold(d.Name, selector) },
+		func(d Device) bool { return strings.HasPrefix(strings.ToLower(d.Name), lower) },
+		func(d Device) bool { return strings.Contains(strings.ToLower(d.Name), lower) },
+	}
+	for _, match := range tiers {
+		var found []Device
+		for _, device := range devices {
+			if match(device) {
+				found = append(found, device)
+			}
+		}
+		switch len(found) {
+		case 0:
+			continue
+		case 1:
+			return found[0], nil
+		default:
+			return Device{}, AmbiguousDeviceError{Selector: selector, Matches: found}
+		}
+	}
+	return Device{}, fmt.Errorf("%w: %q", ErrDeviceNotFound, selector)
+}
@@ -0,0 +1,52 @@
+package spotify
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMatchDevice(t *testing.T) {
+	devices := []Device{
+		{ID: "a1", Name: "Echo Kitchen"},
+		{ID: "b2", Name: "Echo Kitchen Annex"},
+		{ID: "c3", Name: "Office Speaker 1"},
+		{ID: "d4", Name: "Office Speaker 2"},
+		{ID: "e5", Name: "Laptop"},
+	}
+	cases := map[string]string{
+		"B2":           "b2",
+		"echo kitchen": "a1",
+		"lap":          "e5",
+		"speaker 2":    "d4",
+		"annex":        "b2",
+	}
+	for selector, want := range cases {
+		device, err := MatchDevice(devices, selector)
+		if err != nil || device.ID != want {
+			t.Fatalf("MatchDevice(%q) = %#v %v, want %s", selector, device, err, want)
+		}
+	}
+	_, err := MatchDevice(devices, "office")
+	var ambiguous AmbiguousDeviceError
+	if !errors.As(err, &ambiguous) || len(ambiguous.Matches) != 2 || !strings.Contains(err.Error(), `"Office Speaker 1", "Office Speaker 2"`) {
+		t.Fatalf("expected ambiguity, got %v", err)
+	}
+	if _, err := MatchDevice(devices, "echo"); !errors.As(err, &ambiguous) {
+		t.Fatalf("expected prefix ambiguity, got %v", err)
+	}
+	if _, err := MatchDevice(devices, "tv"); !errors.Is(err, ErrDeviceNotFound) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+	if _, err := MatchDevice(devices, " "); err == nil || errors.Is(err, ErrDeviceNotFound) {
+		t.Fatalf("expected required error, got %v", err)
+	}
+}
+
+func TestDeviceUnavailableError(t *testing.T) {
+	err := DeviceUnavailableError{Selector: "Kitchen", Waited: 20 * time.Second}
+	if err.Error() != `device "Kitchen" not found after 20s; no devices available` {
+		t.Fatalf("error %q", err.Error())
+	}
+}
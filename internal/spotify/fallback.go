@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"time"
 )
 
 type fallbackClient struct {
@@ -11,8 +12,16 @@ type fallbackClient struct {
 	connect API
 }
 
-type artistTopTracksAPI interface {
-	ArtistTopTracks(ctx context.Context, id string, limit int) ([]Item, error)
+type contextPlayerAPI interface {
+	PlayContext(ctx context.Context, contextURI, trackURI string) error
+}
+
+type deviceVolumeAPI interface {
+	DeviceVolume(ctx context.Context, deviceID string, volume int) error
+}
+
+type lyricsAPI interface {
+	Lyrics(ctx context.Context, trackID string) (Lyrics, error)
 }
 
 func NewPlaybackFallbackClient(web API, connect API) API {
@@ -47,7 +56,7 @@ func (c *fallbackClient) Search(ctx context.Context, kind, query string, limit,
 }
 
 func (c *fallbackClient) ArtistTopTracks(ctx context.Context, id string, limit int) ([]Item, error) {
-	web, ok := c.web.(artistTopTracksAPI)
+	web, ok := c.web.(ArtistCatalog)
 	if !ok {
 		return nil, ErrUnsupported
 	}
@@ -55,12 +64,124 @@ func (c *fallbackClient) ArtistTopTracks(ctx context.Context, id string, limit i
 	if err == nil || c.connect == nil || !c.shouldFallback(err) {
 		return items, err
 	}
-	if connect, ok := c.connect.(artistTopTracksAPI); ok {
+	if connect, ok := c.connect.(ArtistCatalog); ok {
 		return connect.ArtistTopTracks(ctx, id, limit)
 	}
 	return items, err
 }
 
+func (c *fallbackClient) ArtistAlbums(ctx context.Context, id string, group AlbumGroup, limit, offset int) ([]Item, int, error) {
+	web, ok := c.web.(ArtistCatalog)
+	if !ok {
+		return nil, 0, ErrUnsupported
+	}
+	return web.ArtistAlbums(ctx, id, group, limit, offset)
+}
+
+func (c *fallbackClient) RelatedArtists(ctx context.Context, id string, limit int) ([]Item, error) {
+	web, ok := c.web.(ArtistCatalog)
+	if !ok {
+		return nil, ErrUnsupported
+	}
+	return web.RelatedArtists(ctx, id, limit)
+}
+
+func (c *fallbackClient) GetAudiobook(ctx context.Context, id string) (Item, error) {
+	web, ok := c.web.(AudiobookCatalog)
+	if !ok {
+		return Item{}, ErrUnsupported
+	}
+	return web.GetAudiobook(ctx, id)
+}
+
+func (c *fallbackClient) GetChapter(ctx context.Context, id string) (Item, error) {
+	web, ok := c.web.(AudiobookCatalog)
+	if !ok {
+		return Item{}, ErrUnsupported
+	}
+	return web.GetChapter(ctx, id)
+}
+
+func (c *fallbackClient) AudiobookChapters(ctx context.Context, id string, limit, offset int) ([]Item, int, error) {
+	web, ok := c.web.(AudiobookCatalog)
+	if !ok {
+		return nil, 0, ErrUnsupported
+	}
+	return web.AudiobookChapters(ctx, id, limit, offset)
+}
+
+func (c *fallbackClient) LibraryAudiobooks(ctx context.Context, limit, offset int) ([]Item, int, error) {
+	web, ok := c.web.(AudiobookCatalog)
+	if !ok {
+		return nil, 0, ErrUnsupported
+	}
+	return web.LibraryAudiobooks(ctx, limit, offset)
+}
+
+func (c *fallbackClient) LibraryContains(ctx context.Context, uris []string) ([]bool, error) {
+	web, ok := c.web.(LibraryChecker)
+	if !ok {
+		return nil, ErrUnsupported
+	}
+	return web.LibraryContains(ctx, uris)
+}
+
+func (c *fallbackClient) Me(ctx context.Context) (UserProfile, error) {
+	web, ok := c.web.(AccountInsights)
+	if !ok {
+		return UserProfile{}, ErrUnsupported
+	}
+	return web.Me(ctx)
+}
+
+func (c *fallbackClient) TopItems(ctx context.Context, kind string, timeRange TimeRange, limit, offset int) ([]Item, int, error) {
+	web, ok := c.web.(AccountInsights)
+	if !ok {
+		return nil, 0, ErrUnsupported
+	}
+	return web.TopItems(ctx, kind, timeRange, limit, offset)
+}
+
+func (c *fallbackClient) RecentlyPlayed(ctx context.Context, limit int, after time.Time) ([]Item, error) {
+	web, ok := c.web.(AccountInsights)
+	if !ok {
+		return nil, ErrUnsupported
+	}
+	return web.RecentlyPlayed(ctx, limit, after)
+}
+
+func (c *fallbackClient) BrowseHome(ctx context.Context, limit int) ([]BrowseSection, error) {
+	web, ok := c.web.(Browser)
+	if !ok {
+		return nil, ErrUnsupported
+	}
+	return web.BrowseHome(ctx, limit)
+}
+
+func (c *fallbackClient) BrowseCategories(ctx context.Context, limit, offset int) ([]Category, int, error) {
+	web, ok := c.web.(Browser)
+	if !ok {
+		return nil, 0, ErrUnsupported
+	}
+	return web.BrowseCategories(ctx, limit, offset)
+}
+
+func (c *fallbackClient) BrowseCategory(ctx context.Context, id string, limit int) ([]BrowseSection, error) {
+	web, ok := c.web.(Browser)
+	if !ok {
+		return nil, ErrUnsupported
+	}
+	return web.BrowseCategory(ctx, id, limit)
+}
+
+func (c *fallbackClient) NewReleases(ctx context.Context, limit, offset int) ([]Item, int, error) {
+	web, ok := c.web.(Browser)
+	if !ok {
+		return nil, 0, ErrUnsupported
+	}
+	return web.NewReleases(ctx, limit, offset)
+}
+
 func (c *fallbackClient) GetTrack(ctx context.Context, id string) (Item, error) {
 	return fallbackCall(c, true, func(api API) (Item, error) {
 		return api.GetTrack(ctx, id)
@@ -109,6 +230,55 @@ func (c *fallbackClient) Play(ctx context.Context, uri string) error {
 	})
 }
 
+func (c *fallbackClient) PlayContext(ctx context.Context, contextURI, trackURI string) error {
+	return fallbackVoid(c, true, func(api API) error {
+		player, ok := api.(contextPlayerAPI)
+		if !ok {
+			return ErrUnsupported
+		}
+		return player.PlayContext(ctx, contextURI, trackURI)
+	})
+}
+
+func (c *fallbackClient) DeviceVolume(ctx context.Context, deviceID string, volume int) error {
+	return fallbackVoid(c, true, func(api API) error {
+		setter, ok := api.(deviceVolumeAPI)
+		if !ok {
+			return ErrUnsupported
+		}
+		return setter.DeviceVolume(ctx, deviceID, volume)
+	})
+}
+
+// Lyrics only exist on the Connect side, so there is nothing to fall back
+// from; use whichever client has them.
+func (c *fallbackClient) Lyrics(ctx context.Context, trackID string) (Lyrics, error) {
+	for _, api := range []API{c.web, c.connect} {
+		if fetcher, ok := api.(lyricsAPI); ok {
+			return fetcher.Lyrics(ctx, trackID)
+		}
+	}
+	return Lyrics{}, ErrUnsupported
+}
+
+// Radio, like Lyrics, only exists on the Connect side.
+func (c *fallbackClient) Radio(ctx context.Context, seedURI string) (string, error) {
+	for _, api := range []API{c.web, c.connect} {
+		if starter, ok := api.(RadioStarter); ok {
+			return starter.Radio(ctx, seedURI)
+		}
+	}
+	return "", ErrUnsupported
+}
+
+func (c *fallbackClient) Recommendations(ctx context.Context, seeds RecommendationSeeds, limit int) ([]Item, error) {
+	web, ok := c.web.(Recommender)
+	if !ok {
+		return nil, ErrUnsupported
+	}
+	return web.Recommendations(ctx, seeds, limit)
+}
+
 func (c *fallbackClient) Pause(ctx context.Context) error {
 	return fallbackVoid(c, true, func(api API) error {
 		return api.Pause(ctx)
@@ -183,6 +353,14 @@ func (c *fallbackClient) LibraryAlbums(ctx context.Context, limit, offset int) (
 	return c.web.LibraryAlbums(ctx, limit, offset)
 }
 
+func (c *fallbackClient) LibraryShows(ctx context.Context, limit, offset int) ([]Item, int, error) {
+	return c.web.LibraryShows(ctx, limit, offset)
+}
+
+func (c *fallbackClient) LibraryEpisodes(ctx context.Context, limit, offset int) ([]Item, int, error) {
+	return c.web.LibraryEpisodes(ctx, limit, offset)
+}
+
 func (c *fallbackClient) LibraryModify(ctx context.Context, path string, ids []string, method string) error {
 	return c.web.LibraryModify(ctx, path, ids, method)
 }
@@ -199,6 +377,14 @@ func (c *fallbackClient) Playlists(ctx context.Context, limit, offset int) ([]It
 	return c.web.Playlists(ctx, limit, offset)
 }
 
+func (c *fallbackClient) ShowEpisodes(ctx context.Context, id string, limit, offset int) ([]Item, int, error) {
+	return c.web.ShowEpisodes(ctx, id, limit, offset)
+}
+
+func (c *fallbackClient) AlbumTracks(ctx context.Context, id string, limit, offset int) ([]Item, int, error) {
+	return c.web.AlbumTracks(ctx, id, limit, offset)
+}
+
 func (c *fallbackClient) PlaylistTracks(ctx context.Context, id string, limit, offset int) ([]Item, int, error) {
 	return c.web.PlaylistTracks(ctx, id, limit, offset)
 }
@@ -2,21 +2,32 @@ package spotify
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 )
 
 type apiStub struct {
-	calls             map[string]int
-	playbackFn        func(context.Context) (PlaybackStatus, error)
-	pauseFn           func(context.Context) error
-	searchFn          func(context.Context, string, string, int, int) (SearchResult, error)
-	devicesFn         func(context.Context) ([]Device, error)
-	libraryTracksFn   func(context.Context, int, int) ([]Item, int, error)
-	libraryModifyFn   func(context.Context, string, []string, string) error
-	followedArtistsFn func(context.Context, int, string) ([]Item, int, string, error)
-	artistTopTracksFn func(context.Context, string, int) ([]Item, error)
-	addTracksFn       func(context.Context, string, []string) error
-	removeTracksFn    func(context.Context, string, []string) error
+	calls               map[string]int
+	playbackFn          func(context.Context) (PlaybackStatus, error)
+	pauseFn             func(context.Context) error
+	searchFn            func(context.Context, string, string, int, int) (SearchResult, error)
+	devicesFn           func(context.Context) ([]Device, error)
+	libraryTracksFn     func(context.Context, int, int) ([]Item, int, error)
+	libraryContainsFn   func(context.Context, []string) ([]bool, error)
+	meFn                func(context.Context) (UserProfile, error)
+	radioFn             func(context.Context, string) (string, error)
+	libraryModifyFn     func(context.Context, string, []string, string) error
+	followedArtistsFn   func(context.Context, int, string) ([]Item, int, string, error)
+	artistTopTracksFn   func(context.Context, string, int) ([]Item, error)
+	artistAlbumsFn      func(context.Context, string, AlbumGroup, int, int) ([]Item, int, error)
+	relatedArtistsFn    func(context.Context, string, int) ([]Item, error)
+	audiobookChaptersFn func(context.Context, string, int, int) ([]Item, int, error)
+	playContextFn       func(context.Context, string, string) error
+	deviceVolumeFn      func(context.Context, string, int) error
+	lyricsFn            func(context.Context, string) (Lyrics, error)
+	addTracksFn         func(context.Context, string, []string) error
+	removeTracksFn      func(context.Context, string, []string) error
 }
 
 func (a apiStub) Search(ctx context.Context, kind, query string, limit, offset int) (SearchResult, error) {
@@ -124,6 +135,132 @@ func (a apiStub) ArtistTopTracks(ctx context.Context, id string, limit int) ([]I
 	return nil, nil
 }
 
+func (a apiStub) ArtistAlbums(ctx context.Context, id string, group AlbumGroup, limit, offset int) ([]Item, int, error) {
+	a.note("ArtistAlbums")
+	if a.artistAlbumsFn != nil {
+		return a.artistAlbumsFn(ctx, id, group, limit, offset)
+	}
+	return nil, 0, nil
+}
+
+func (a apiStub) RelatedArtists(ctx context.Context, id string, limit int) ([]Item, error) {
+	a.note("RelatedArtists")
+	if a.relatedArtistsFn != nil {
+		return a.relatedArtistsFn(ctx, id, limit)
+	}
+	return nil, nil
+}
+
+func (a apiStub) GetAudiobook(_ context.Context, id string) (Item, error) {
+	a.note("GetAudiobook")
+	return Item{ID: id, Type: "audiobook"}, nil
+}
+
+func (a apiStub) GetChapter(_ context.Context, id string) (Item, error) {
+	a.note("GetChapter")
+	return Item{ID: id, Type: "chapter"}, nil
+}
+
+func (a apiStub) AudiobookChapters(ctx context.Context, id string, limit, offset int) ([]Item, int, error) {
+	a.note("AudiobookChapters")
+	if a.audiobookChaptersFn != nil {
+		return a.audiobookChaptersFn(ctx, id, limit, offset)
+	}
+	return nil, 0, nil
+}
+
+func (a apiStub) LibraryAudiobooks(context.Context, int, int) ([]Item, int, error) {
+	a.note("LibraryAudiobooks")
+	return nil, 0, nil
+}
+
+func (a apiStub) LibraryContains(ctx context.Context, uris []string) ([]bool, error) {
+	a.note("LibraryContains")
+	if a.libraryContainsFn != nil {
+		return a.libraryContainsFn(ctx, uris)
+	}
+	return make([]bool, len(uris)), nil
+}
+
+func (a apiStub) Me(ctx context.Context) (UserProfile, error) {
+	a.note("Me")
+	if a.meFn != nil {
+		return a.meFn(ctx)
+	}
+	return UserProfile{ID: "u1"}, nil
+}
+
+func (a apiStub) TopItems(context.Context, string, TimeRange, int, int) ([]Item, int, error) {
+	a.note("TopItems")
+	return []Item{{ID: "t1"}}, 1, nil
+}
+
+func (a apiStub) RecentlyPlayed(context.Context, int, time.Time) ([]Item, error) {
+	a.note("RecentlyPlayed")
+	return []Item{{ID: "t1"}}, nil
+}
+
+func (a apiStub) Recommendations(context.Context, RecommendationSeeds, int) ([]Item, error) {
+	a.note("Recommendations")
+	return []Item{{ID: "t2"}}, nil
+}
+
+type radioStub struct {
+	apiStub
+}
+
+func (r radioStub) Radio(ctx context.Context, seedURI string) (string, error) {
+	r.note("Radio")
+	if r.radioFn != nil {
+		return r.radioFn(ctx, seedURI)
+	}
+	return "spotify:playlist:radio", nil
+}
+
+func (a apiStub) BrowseHome(context.Context, int) ([]BrowseSection, error) {
+	a.note("BrowseHome")
+	return []BrowseSection{{Title: "Home", Items: []Item{{ID: "p1"}}}}, nil
+}
+
+func (a apiStub) BrowseCategories(context.Context, int, int) ([]Category, int, error) {
+	a.note("BrowseCategories")
+	return []Category{{ID: "c1"}}, 1, nil
+}
+
+func (a apiStub) BrowseCategory(context.Context, string, int) ([]BrowseSection, error) {
+	a.note("BrowseCategory")
+	return []BrowseSection{{Title: "Pop"}}, nil
+}
+
+func (a apiStub) NewReleases(context.Context, int, int) ([]Item, int, error) {
+	a.note("NewReleases")
+	return []Item{{ID: "a1"}}, 1, nil
+}
+
+func (a apiStub) PlayContext(ctx context.Context, contextURI, trackURI string) error {
+	a.note("PlayContext")
+	if a.playContextFn != nil {
+		return a.playContextFn(ctx, contextURI, trackURI)
+	}
+	return nil
+}
+
+func (a apiStub) DeviceVolume(ctx context.Context, deviceID string, volume int) error {
+	a.note("DeviceVolume")
+	if a.deviceVolumeFn != nil {
+		return a.deviceVolumeFn(ctx, deviceID, volume)
+	}
+	return nil
+}
+
+func (a apiStub) Lyrics(ctx context.Context, trackID string) (Lyrics, error) {
+	a.note("Lyrics")
+	if a.lyricsFn != nil {
+		return a.lyricsFn(ctx, trackID)
+	}
+	return Lyrics{}, nil
+}
+
 func (a apiStub) Transfer(context.Context, string) error {
 	a.note("Transfer")
 	return nil
@@ -178,6 +315,26 @@ func (a apiStub) Playlists(context.Context, int, int) ([]Item, int, error) {
 	return nil, 0, nil
 }
 
+func (a apiStub) LibraryShows(context.Context, int, int) ([]Item, int, error) {
+	a.note("LibraryShows")
+	return nil, 0, nil
+}
+
+func (a apiStub) LibraryEpisodes(context.Context, int, int) ([]Item, int, error) {
+	a.note("LibraryEpisodes")
+	return nil, 0, nil
+}
+
+func (a apiStub) ShowEpisodes(context.Context, string, int, int) ([]Item, int, error) {
+	a.note("ShowEpisodes")
+	return nil, 0, nil
+}
+
+func (a apiStub) AlbumTracks(context.Context, string, int, int) ([]Item, int, error) {
+	a.note("AlbumTracks")
+	return nil, 0, nil
+}
+
 func (a apiStub) PlaylistTracks(context.Context, string, int, int) ([]Item, int, error) {
 	a.note("PlaylistTracks")
 	return nil, 0, nil
@@ -253,7 +410,7 @@ func TestFallbackArtistTopTracksOnRateLimit(t *testing.T) {
 		},
 	}
 	client := NewPlaybackFallbackClient(web, connect)
-	fallback, ok := client.(artistTopTracksAPI)
+	fallback, ok := client.(ArtistCatalog)
 	if !ok {
 		t.Fatalf("expected artist top tracks support")
 	}
@@ -263,6 +420,230 @@ func TestFallbackArtistTopTracksOnRateLimit(t *testing.T) {
 	}
 }
 
+func TestFallbackArtistAlbumsUsesWeb(t *testing.T) {
+	ctx := context.Background()
+	web := apiStub{
+		artistAlbumsFn: func(_ context.Context, _ string, group AlbumGroup, _, _ int) ([]Item, int, error) {
+			return []Item{{URI: "spotify:album:" + string(group)}}, 1, nil
+		},
+		relatedArtistsFn: func(context.Context, string, int) ([]Item, error) {
+			return []Item{{URI: "spotify:artist:r1"}}, nil
+		},
+	}
+	client := NewPlaybackFallbackClient(web, apiStub{})
+	catalog, ok := client.(ArtistCatalog)
+	if !ok {
+		t.Fatalf("expected artist catalog support")
+	}
+	items, total, err := catalog.ArtistAlbums(ctx, "abc", AlbumGroupSingle, 10, 0)
+	if err != nil || total != 1 || items[0].URI != "spotify:album:single" {
+		t.Fatalf("artist albums: %v %#v", err, items)
+	}
+	related, err := catalog.RelatedArtists(ctx, "abc", 10)
+	if err != nil || len(related) != 1 {
+		t.Fatalf("related artists: %v %#v", err, related)
+	}
+}
+
+func TestFallbackAudiobookCatalogUsesWeb(t *testing.T) {
+	ctx := context.Background()
+	calls := map[string]int{}
+	client := NewPlaybackFallbackClient(apiStub{calls: calls}, apiStub{})
+	catalog, ok := client.(AudiobookCatalog)
+	if !ok {
+		t.Fatalf("expected audiobook catalog support")
+	}
+	if item, err := catalog.GetAudiobook(ctx, "b1"); err != nil || item.Type != "audiobook" {
+		t.Fatalf("audiobook: %v %#v", err, item)
+	}
+	if item, err := catalog.GetChapter(ctx, "c1"); err != nil || item.Type != "chapter" {
+		t.Fatalf("chapter: %v %#v", err, item)
+	}
+	_, _, _ = catalog.AudiobookChapters(ctx, "b1", 10, 0)
+	_, _, _ = catalog.LibraryAudiobooks(ctx, 10, 0)
+	for _, name := range []string{"GetAudiobook", "GetChapter", "AudiobookChapters", "LibraryAudiobooks"} {
+		if calls[name] != 1 {
+			t.Fatalf("expected web %s call, got %#v", name, calls)
+		}
+	}
+	unsupported := NewPlaybackFallbackClient(struct{ API }{apiStub{}}, apiStub{}).(AudiobookCatalog)
+	if _, err := unsupported.GetAudiobook(ctx, "b1"); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+	if _, err := unsupported.GetChapter(ctx, "c1"); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+	if _, _, err := unsupported.AudiobookChapters(ctx, "b1", 1, 0); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+	if _, _, err := unsupported.LibraryAudiobooks(ctx, 1, 0); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+}
+
+func TestFallbackLibraryContainsUsesWeb(t *testing.T) {
+	calls := map[string]int{}
+	client := NewPlaybackFallbackClient(apiStub{calls: calls}, apiStub{})
+	checker, ok := client.(LibraryChecker)
+	if !ok {
+		t.Fatalf("expected library checker support")
+	}
+	saved, err := checker.LibraryContains(context.Background(), []string{"spotify:track:t1"})
+	if err != nil || len(saved) != 1 || calls["LibraryContains"] != 1 {
+		t.Fatalf("contains: %v %#v %#v", err, saved, calls)
+	}
+	unsupported := NewPlaybackFallbackClient(struct{ API }{apiStub{}}, apiStub{}).(LibraryChecker)
+	if _, err := unsupported.LibraryContains(context.Background(), nil); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+}
+
+func TestFallbackAccountInsightsUseWeb(t *testing.T) {
+	calls := map[string]int{}
+	client := NewPlaybackFallbackClient(apiStub{calls: calls}, apiStub{})
+	insights, ok := client.(AccountInsights)
+	if !ok {
+		t.Fatalf("expected account insights support")
+	}
+	ctx := context.Background()
+	if profile, err := insights.Me(ctx); err != nil || profile.ID != "u1" {
+		t.Fatalf("me: %v %#v", err, profile)
+	}
+	if items, total, err := insights.TopItems(ctx, "track", TimeRangeShort, 10, 0); err != nil || len(items) != 1 || total != 1 {
+		t.Fatalf("top: %v %#v %d", err, items, total)
+	}
+	if items, err := insights.RecentlyPlayed(ctx, 10, time.Time{}); err != nil || len(items) != 1 {
+		t.Fatalf("recent: %v %#v", err, items)
+	}
+	if calls["Me"] != 1 || calls["TopItems"] != 1 || calls["RecentlyPlayed"] != 1 {
+		t.Fatalf("calls: %#v", calls)
+	}
+	unsupported := NewPlaybackFallbackClient(struct{ API }{apiStub{}}, apiStub{}).(AccountInsights)
+	if _, err := unsupported.Me(ctx); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+	if _, _, err := unsupported.TopItems(ctx, "track", TimeRangeShort, 10, 0); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+	if _, err := unsupported.RecentlyPlayed(ctx, 10, time.Time{}); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+}
+
+func TestFallbackRadioAndRecommendations(t *testing.T) {
+	calls := map[string]int{}
+	ctx := context.Background()
+	client := NewPlaybackFallbackClient(apiStub{calls: calls}, radioStub{apiStub{calls: calls}})
+	radio, err := client.(RadioStarter).Radio(ctx, "spotify:track:t1")
+	if err != nil || radio != "spotify:playlist:radio" || calls["Radio"] != 1 {
+		t.Fatalf("radio: %v %q %#v", err, radio, calls)
+	}
+	items, err := client.(Recommender).Recommendations(ctx, RecommendationSeeds{Tracks: []string{"t1"}}, 5)
+	if err != nil || len(items) != 1 || calls["Recommendations"] != 1 {
+		t.Fatalf("recommendations: %v %#v %#v", err, items, calls)
+	}
+	unsupported := NewPlaybackFallbackClient(struct{ API }{apiStub{}}, struct{ API }{apiStub{}})
+	if _, err := unsupported.(RadioStarter).Radio(ctx, "spotify:track:t1"); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+	if _, err := unsupported.(Recommender).Recommendations(ctx, RecommendationSeeds{}, 5); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+}
+
+func TestFallbackBrowserUsesWeb(t *testing.T) {
+	calls := map[string]int{}
+	ctx := context.Background()
+	browser, ok := NewPlaybackFallbackClient(apiStub{calls: calls}, apiStub{}).(Browser)
+	if !ok {
+		t.Fatalf("expected browser support")
+	}
+	if sections, err := browser.BrowseHome(ctx, 5); err != nil || len(sections) != 1 {
+		t.Fatalf("home: %v %#v", err, sections)
+	}
+	if categories, total, err := browser.BrowseCategories(ctx, 5, 0); err != nil || len(categories) != 1 || total != 1 {
+		t.Fatalf("categories: %v %#v", err, categories)
+	}
+	if sections, err := browser.BrowseCategory(ctx, "c1", 5); err != nil || len(sections) != 1 {
+		t.Fatalf("category: %v %#v", err, sections)
+	}
+	if items, total, err := browser.NewReleases(ctx, 5, 0); err != nil || len(items) != 1 || total != 1 {
+		t.Fatalf("new releases: %v %#v", err, items)
+	}
+	if calls["BrowseHome"] != 1 || calls["BrowseCategories"] != 1 || calls["BrowseCategory"] != 1 || calls["NewReleases"] != 1 {
+		t.Fatalf("calls %#v", calls)
+	}
+	unsupported := NewPlaybackFallbackClient(struct{ API }{apiStub{}}, apiStub{}).(Browser)
+	if _, err := unsupported.BrowseHome(ctx, 5); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+	if _, _, err := unsupported.BrowseCategories(ctx, 5, 0); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+	if _, err := unsupported.BrowseCategory(ctx, "c1", 5); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+	if _, _, err := unsupported.NewReleases(ctx, 5, 0); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+}
+
+func TestFallbackPlayContextOnRateLimit(t *testing.T) {
+	calls := map[string]int{}
+	web := apiStub{
+		calls: calls,
+		playContextFn: func(context.Context, string, string) error {
+			return APIError{Status: 429, Message: "rate limit"}
+		},
+	}
+	var got string
+	connect := apiStub{
+		calls: calls,
+		playContextFn: func(_ context.Context, contextURI, trackURI string) error {
+			got = contextURI + " " + trackURI
+			return nil
+		},
+	}
+	client := NewPlaybackFallbackClient(web, connect).(contextPlayerAPI)
+	if err := client.PlayContext(context.Background(), "spotify:album:a1", "spotify:track:t2"); err != nil {
+		t.Fatalf("play context: %v", err)
+	}
+	if calls["PlayContext"] != 2 || got != "spotify:album:a1 spotify:track:t2" {
+		t.Fatalf("calls %v got %q", calls, got)
+	}
+}
+
+func TestFallbackDeviceVolumeOnRateLimit(t *testing.T) {
+	calls := map[string]int{}
+	web := apiStub{
+		calls: calls,
+		deviceVolumeFn: func(context.Context, string, int) error {
+			return APIError{Status: 429, Message: "rate limit"}
+		},
+	}
+	connect := apiStub{calls: calls}
+	client := NewPlaybackFallbackClient(web, connect).(deviceVolumeAPI)
+	if err := client.DeviceVolume(context.Background(), "d1", 30); err != nil {
+		t.Fatalf("device volume: %v", err)
+	}
+	if calls["DeviceVolume"] != 2 {
+		t.Fatalf("calls %v", calls)
+	}
+}
+
+func TestFallbackLyricsUsesConnect(t *testing.T) {
+	calls := map[string]int{}
+	connect := apiStub{calls: calls}
+	client := NewPlaybackFallbackClient(struct{ API }{apiStub{calls: calls}}, connect).(lyricsAPI)
+	if _, err := client.Lyrics(context.Background(), "t1"); err != nil || calls["Lyrics"] != 1 {
+		t.Fatalf("lyrics: %v calls %v", err, calls)
+	}
+	none := NewPlaybackFallbackClient(struct{ API }{apiStub{}}, nil).(lyricsAPI)
+	if _, err := none.Lyrics(context.Background(), "t1"); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected unsupported, got %v", err)
+	}
+}
+
 func TestFallbackSkipsNonRateLimit(t *testing.T) {
 	ctx := context.Background()
 	webCalls := 0
@@ -438,6 +819,18 @@ func TestFallbackDelegatesToWeb(t *testing.T) {
 	if _, _, err := client.Playlists(ctx, 1, 0); err != nil {
 		t.Fatalf("playlists: %v", err)
 	}
+	if _, _, err := client.LibraryShows(ctx, 1, 0); err != nil {
+		t.Fatalf("library shows: %v", err)
+	}
+	if _, _, err := client.LibraryEpisodes(ctx, 1, 0); err != nil {
+		t.Fatalf("library episodes: %v", err)
+	}
+	if _, _, err := client.ShowEpisodes(ctx, "s1", 1, 0); err != nil {
+		t.Fatalf("show episodes: %v", err)
+	}
+	if _, _, err := client.AlbumTracks(ctx, "a1", 1, 0); err != nil {
+		t.Fatalf("album tracks: %v", err)
+	}
 	if _, _, err := client.PlaylistTracks(ctx, "p1", 1, 0); err != nil {
 		t.Fatalf("playlist tracks: %v", err)
 	}
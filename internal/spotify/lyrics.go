@@ -0,0 +1,33 @@
+package spotify
+
+import (
+	"errors"
+	"sort"
+)
+
+var ErrNoLyrics = errors.New("no lyrics available")
+
+// Lyrics are the lines for one track. Unsynced lyrics have no start times.
+type Lyrics struct {
+	TrackID  string      `json:"track_id"`
+	Synced   bool        `json:"synced"`
+	Provider string      `json:"provider,omitempty"`
+	Language string      `json:"language,omitempty"`
+	Lines    []LyricLine `json:"lines"`
+}
+
+type LyricLine struct {
+	StartMS int    `json:"start_ms"`
+	Text    string `json:"text"`
+}
+
+// LineAt returns the index of the line being sung at progressMS, or -1
+// before the first line and for unsynced lyrics.
+func (l Lyrics) LineAt(progressMS int) int {
+	if !l.Synced {
+		return -1
+	}
+	return sort.Search(len(l.Lines), func(i int) bool {
+		return l.Lines[i].StartMS > progressMS
+	}) - 1
+}
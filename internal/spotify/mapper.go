@@ -40,6 +40,12 @@ func mapSearchItem(kind string, raw json.RawMessage) (Item, error) {
 			return Item{}, err
 		}
 		return mapEpisode(e), nil
+	case "audiobook":
+		var a audiobookItem
+		if err := json.Unmarshal(raw, &a); err != nil {
+			return Item{}, err
+		}
+		return mapAudiobook(a), nil
 	default:
 		return Item{}, ErrUnsupportedType
 	}
@@ -47,16 +53,18 @@ func mapSearchItem(kind string, raw json.RawMessage) (Item, error) {
 
 func mapTrack(t trackItem) Item {
 	return Item{
-		ID:         t.ID,
-		URI:        t.URI,
-		Name:       t.Name,
-		Type:       "track",
-		URL:        externalURL(t.ExternalURLs),
-		Artists:    artistNames(t.Artists),
-		Album:      t.Album.Name,
-		DurationMS: t.DurationMS,
-		Explicit:   t.Explicit,
-		IsPlayable: t.IsPlayable,
+		ID:          t.ID,
+		URI:         t.URI,
+		Name:        t.Name,
+		Type:        "track",
+		URL:         externalURL(t.ExternalURLs),
+		Artists:     artistNames(t.Artists),
+		Album:       t.Album.Name,
+		DurationMS:  t.DurationMS,
+		TrackNumber: t.TrackNumber,
+		DiscNumber:  t.DiscNumber,
+		Explicit:    t.Explicit,
+		IsPlayable:  t.IsPlayable,
 	}
 }
 
@@ -113,13 +121,81 @@ func mapShow(s showItem) Item {
 
 func mapEpisode(e episodeItem) Item {
 	return Item{
-		ID:          e.ID,
-		URI:         e.URI,
-		Name:        e.Name,
-		Type:        "episode",
-		URL:         externalURL(e.ExternalURLs),
-		Description: e.Description,
-		DurationMS:  e.DurationMS,
+		ID:               e.ID,
+		URI:              e.URI,
+		Name:             e.Name,
+		Type:             "episode",
+		URL:              externalURL(e.ExternalURLs),
+		Description:      e.Description,
+		DurationMS:       e.DurationMS,
+		ReleaseDate:      e.ReleaseDate,
+		ResumePositionMS: e.ResumePoint.ResumePositionMS,
+		FullyPlayed:      e.ResumePoint.FullyPlayed,
+	}
+}
+
+func mapAudiobook(a audiobookItem) Item {
+	return Item{
+		ID:            a.ID,
+		URI:           orURI(a.URI, "audiobook", a.ID),
+		Name:          a.Name,
+		Type:          "audiobook",
+		URL:           externalURL(a.ExternalURLs),
+		Description:   a.Description,
+		Publisher:     a.Publisher,
+		Authors:       refNames(a.Authors),
+		Narrators:     refNames(a.Narrators),
+		TotalChapters: a.TotalChapters,
+	}
+}
+
+func mapChapter(c chapterItem) Item {
+	return Item{
+		ID:               c.ID,
+		URI:              orURI(c.URI, "chapter", c.ID),
+		Name:             c.Name,
+		Type:             "chapter",
+		URL:              externalURL(c.ExternalURLs),
+		Album:            c.Audiobook.Name,
+		Authors:          refNames(c.Audiobook.Authors),
+		Description:      c.Description,
+		DurationMS:       c.DurationMS,
+		ChapterNumber:    c.ChapterNumber,
+		ReleaseDate:      c.ReleaseDate,
+		ResumePositionMS: c.ResumePoint.ResumePositionMS,
+		FullyPlayed:      c.ResumePoint.FullyPlayed,
+	}
+}
+
+func orURI(uri, kind, id string) string {
+	if uri != "" || id == "" {
+		return uri
+	}
+	return "spotify:" + kind + ":" + id
+}
+
+func refNames(refs []namedRef) []string {
+	names := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		if ref.Name != "" {
+			names = append(names, ref.Name)
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	return names
+}
+
+func mapUserProfile(p userProfile) UserProfile {
+	return UserProfile{
+		ID:          p.ID,
+		DisplayName: p.DisplayName,
+		Country:     p.Country,
+		Product:     p.Product,
+		Followers:   p.Followers.Total,
+		URI:         p.URI,
+		URL:         externalURL(p.ExternalURLs),
 	}
 }
 
@@ -66,6 +66,33 @@ func TestMapSearchItemEpisode(t *testing.T) {
 	}
 }
 
+func TestMapSearchItemAudiobook(t *testing.T) {
+	raw := json.RawMessage(`{"id":"b1","name":"Dune","authors":[{"name":"Frank Herbert"}],"narrators":[{"name":""},{"name":"Scott Brick"}],"total_chapters":48}`)
+	item, err := mapSearchItem("audiobook", raw)
+	if err != nil {
+		t.Fatalf("audiobook: %v", err)
+	}
+	if item.URI != "spotify:audiobook:b1" || item.Type != "audiobook" || item.TotalChapters != 48 {
+		t.Fatalf("unexpected: %#v", item)
+	}
+	if len(item.Authors) != 1 || len(item.Narrators) != 1 || item.Narrators[0] != "Scott Brick" {
+		t.Fatalf("people: %#v", item)
+	}
+}
+
+func TestMapChapter(t *testing.T) {
+	item := mapChapter(chapterItem{
+		ID:            "c1",
+		Name:          "Chapter 1",
+		ChapterNumber: 1,
+		Audiobook:     audiobookItem{Name: "Dune"},
+		ResumePoint:   resumePoint{ResumePositionMS: 1000},
+	})
+	if item.URI != "spotify:chapter:c1" || item.Album != "Dune" || item.Authors != nil || item.ResumePositionMS != 1000 {
+		t.Fatalf("unexpected: %#v", item)
+	}
+}
+
 func TestMapSearchItemBadJSON(t *testing.T) {
 	if _, err := mapSearchItem("track", json.RawMessage(`{`)); err == nil {
 		t.Fatalf("expected error")
@@ -73,7 +100,7 @@ func TestMapSearchItemBadJSON(t *testing.T) {
 }
 
 func TestMapSearchItemBadJSONOtherKinds(t *testing.T) {
-	kinds := []string{"album", "artist", "playlist", "show", "episode"}
+	kinds := []string{"album", "artist", "playlist", "show", "episode", "audiobook"}
 	for _, kind := range kinds {
 		if _, err := mapSearchItem(kind, json.RawMessage(`{`)); err == nil {
 			t.Fatalf("expected error for %s", kind)
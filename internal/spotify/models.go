@@ -40,6 +40,8 @@ type trackItem struct {
 	URI          string            `json:"uri"`
 	Name         string            `json:"name"`
 	DurationMS   int               `json:"duration_ms"`
+	TrackNumber  int               `json:"track_number"`
+	DiscNumber   int               `json:"disc_number"`
 	Explicit     bool              `json:"explicit"`
 	IsPlayable   bool              `json:"is_playable"`
 	Album        albumRef          `json:"album"`
@@ -84,9 +86,45 @@ type episodeItem struct {
 	Name         string            `json:"name"`
 	Description  string            `json:"description"`
 	DurationMS   int               `json:"duration_ms"`
+	ReleaseDate  string            `json:"release_date"`
+	ResumePoint  resumePoint       `json:"resume_point"`
 	ExternalURLs map[string]string `json:"external_urls"`
 }
 
+type audiobookItem struct {
+	ID            string            `json:"id"`
+	URI           string            `json:"uri"`
+	Name          string            `json:"name"`
+	Authors       []namedRef        `json:"authors"`
+	Narrators     []namedRef        `json:"narrators"`
+	Publisher     string            `json:"publisher"`
+	Description   string            `json:"description"`
+	TotalChapters int               `json:"total_chapters"`
+	ExternalURLs  map[string]string `json:"external_urls"`
+}
+
+type chapterItem struct {
+	ID            string            `json:"id"`
+	URI           string            `json:"uri"`
+	Name          string            `json:"name"`
+	Description   string            `json:"description"`
+	ChapterNumber int               `json:"chapter_number"`
+	DurationMS    int               `json:"duration_ms"`
+	ReleaseDate   string            `json:"release_date"`
+	ResumePoint   resumePoint       `json:"resume_point"`
+	Audiobook     audiobookItem     `json:"audiobook"`
+	ExternalURLs  map[string]string `json:"external_urls"`
+}
+
+type namedRef struct {
+	Name string `json:"name"`
+}
+
+type resumePoint struct {
+	FullyPlayed      bool `json:"fully_played"`
+	ResumePositionMS int  `json:"resume_position_ms"`
+}
+
 type searchContainer struct {
 	Items  []json.RawMessage `json:"items"`
 	Limit  int               `json:"limit"`
@@ -95,12 +133,17 @@ type searchContainer struct {
 }
 
 type playbackResponse struct {
-	IsPlaying    bool       `json:"is_playing"`
-	ProgressMS   int        `json:"progress_ms"`
-	ShuffleState bool       `json:"shuffle_state"`
-	RepeatState  string     `json:"repeat_state"`
-	Device       deviceItem `json:"device"`
-	Item         trackItem  `json:"item"`
+	IsPlaying    bool        `json:"is_playing"`
+	ProgressMS   int         `json:"progress_ms"`
+	ShuffleState bool        `json:"shuffle_state"`
+	RepeatState  string      `json:"repeat_state"`
+	Device       deviceItem  `json:"device"`
+	Item         trackItem   `json:"item"`
+	Context      *contextRef `json:"context"`
+}
+
+type contextRef struct {
+	URI string `json:"uri"`
 }
 
 type deviceItem struct {
@@ -122,11 +165,17 @@ type queueResponse struct {
 }
 
 type libraryResponse struct {
-	Items []struct {
-		Track trackItem `json:"track"`
-		Album albumItem `json:"album"`
-	} `json:"items"`
-	Total int `json:"total"`
+	Items []libraryItem `json:"items"`
+	Total int           `json:"total"`
+}
+
+// libraryItem is one saved entry; exactly one field is set depending on
+// which /me collection was read.
+type libraryItem struct {
+	Track   trackItem   `json:"track"`
+	Album   albumItem   `json:"album"`
+	Show    showItem    `json:"show"`
+	Episode episodeItem `json:"episode"`
 }
 
 type playlistListResponse struct {
@@ -141,8 +190,76 @@ type playlistTracksResponse struct {
 	Total int `json:"total"`
 }
 
+type showEpisodesResponse struct {
+	Items []episodeItem `json:"items"`
+	Total int           `json:"total"`
+}
+
+type audiobookChaptersResponse struct {
+	Items []chapterItem `json:"items"`
+	Total int           `json:"total"`
+}
+
+type libraryAudiobooksResponse struct {
+	Items []audiobookItem `json:"items"`
+	Total int             `json:"total"`
+}
+
+type albumTracksResponse struct {
+	Items []trackItem `json:"items"`
+	Total int         `json:"total"`
+}
+
 type userProfile struct {
-	ID string `json:"id"`
+	ID          string `json:"id"`
+	DisplayName string `json:"display_name"`
+	Country     string `json:"country"`
+	Product     string `json:"product"`
+	URI         string `json:"uri"`
+	Followers   struct {
+		Total int `json:"total"`
+	} `json:"followers"`
+	ExternalURLs map[string]string `json:"external_urls"`
+}
+
+type topItemsResponse struct {
+	Items []json.RawMessage `json:"items"`
+	Total int               `json:"total"`
+}
+
+type featuredPlaylistsResponse struct {
+	Message   string `json:"message"`
+	Playlists struct {
+		Items []playlistItem `json:"items"`
+	} `json:"playlists"`
+}
+
+type categoriesResponse struct {
+	Categories struct {
+		Items []struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"items"`
+		Total int `json:"total"`
+	} `json:"categories"`
+}
+
+type newReleasesResponse struct {
+	Albums struct {
+		Items []albumItem `json:"items"`
+		Total int         `json:"total"`
+	} `json:"albums"`
+}
+
+type recommendationsResponse struct {
+	Tracks []trackItem `json:"tracks"`
+}
+
+type recentlyPlayedResponse struct {
+	Items []struct {
+		Track    trackItem `json:"track"`
+		PlayedAt string    `json:"played_at"`
+	} `json:"items"`
 }
 
 type followedArtistsResponse struct {
@@ -159,3 +276,12 @@ type artistsContainer struct {
 type artistTopTracksResponse struct {
 	Tracks []trackItem `json:"tracks"`
 }
+
+type artistAlbumsResponse struct {
+	Items []albumItem `json:"items"`
+	Total int         `json:"total"`
+}
+
+type relatedArtistsResponse struct {
+	Artists []artistItem `json:"artists"`
+}
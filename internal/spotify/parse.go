@@ -16,6 +16,10 @@ var supportedTypes = map[string]struct{}{
 	"playlist": {},
 	"show":     {},
 	"episode":  {},
+	// Audiobooks and chapters also surface as show/episode URIs; PlayableURI
+	// maps between the two.
+	"audiobook": {},
+	"chapter":   {},
 }
 
 type Resource struct {
@@ -88,5 +92,19 @@ func isSupportedType(kind string) bool {
 }
 
 func isContextURI(uri string) bool {
-	return strings.Contains(uri, ":album:") || strings.Contains(uri, ":playlist:") || strings.Contains(uri, ":show:")
+	return strings.Contains(uri, ":album:") || strings.Contains(uri, ":playlist:") || strings.Contains(uri, ":show:") ||
+		strings.Contains(uri, ":audiobook:") || strings.HasPrefix(uri, "spotify:station:")
+}
+
+// PlayableURI rewrites audiobook and chapter URIs to the show and episode
+// URIs Spotify's players accept for them; other URIs pass through.
+func PlayableURI(uri string) string {
+	switch {
+	case strings.HasPrefix(uri, "spotify:audiobook:"):
+		return "spotify:show:" + strings.TrimPrefix(uri, "spotify:audiobook:")
+	case strings.HasPrefix(uri, "spotify:chapter:"):
+		return "spotify:episode:" + strings.TrimPrefix(uri, "spotify:chapter:")
+	default:
+		return uri
+	}
 }
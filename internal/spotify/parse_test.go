@@ -47,4 +47,28 @@ func TestIsContextURI(t *testing.T) {
 	if isContextURI("spotify:track:t1") {
 		t.Fatalf("unexpected context uri")
 	}
+	if !isContextURI("spotify:audiobook:b1") {
+		t.Fatalf("expected audiobook context uri")
+	}
+}
+
+func TestParseAudiobookAndChapter(t *testing.T) {
+	res, err := ParseResource("https://open.spotify.com/audiobook/b1?si=x")
+	if err != nil || res.Type != "audiobook" || res.URI != "spotify:audiobook:b1" {
+		t.Fatalf("unexpected: %#v %v", res, err)
+	}
+	res, err = ParseResource("spotify:chapter:c1")
+	if err != nil || res.Type != "chapter" || res.ID != "c1" {
+		t.Fatalf("unexpected: %#v %v", res, err)
+	}
+	cases := map[string]string{
+		"spotify:audiobook:b1": "spotify:show:b1",
+		"spotify:chapter:c1":   "spotify:episode:c1",
+		"spotify:track:t1":     "spotify:track:t1",
+	}
+	for in, want := range cases {
+		if got := PlayableURI(in); got != want {
+			t.Fatalf("PlayableURI(%q) = %q, want %q", in, got, want)
+		}
+	}
 }
@@ -12,8 +12,14 @@ type Item struct {
 	Album         string   `json:"album,omitempty"`
 	Owner         string   `json:"owner,omitempty"`
 	DurationMS    int      `json:"duration_ms,omitempty"`
+	TrackNumber   int      `json:"track_number,omitempty"`
+	DiscNumber    int      `json:"disc_number,omitempty"`
 	Explicit      bool     `json:"-"`
 	ExplicitKnown bool     `json:"-"`
+	// Saved is only meaningful when SavedKnown is set; it is filled on
+	// request because checking costs an extra call.
+	Saved         bool     `json:"-"`
+	SavedKnown    bool     `json:"-"`
 	TotalTracks   int      `json:"total_tracks,omitempty"`
 	ReleaseDate   string   `json:"release_date,omitempty"`
 	Description   string   `json:"description,omitempty"`
@@ -23,6 +29,16 @@ type Item struct {
 	IsPlayable    bool     `json:"is_playable,omitempty"`
 	Publisher     string   `json:"publisher,omitempty"`
 	TotalEpisodes int      `json:"total_episodes,omitempty"`
+	Authors       []string `json:"authors,omitempty"`
+	Narrators     []string `json:"narrators,omitempty"`
+	TotalChapters int      `json:"total_chapters,omitempty"`
+	ChapterNumber int      `json:"chapter_number,omitempty"`
+	// ResumePositionMS and FullyPlayed are the account's saved playback
+	// state for episodes and chapters.
+	ResumePositionMS int  `json:"resume_position_ms,omitempty"`
+	FullyPlayed      bool `json:"fully_played,omitempty"`
+	// PlayedAt is set on recently played items (RFC 3339).
+	PlayedAt string `json:"played_at,omitempty"`
 }
 
 func (i Item) MarshalJSON() ([]byte, error) {
@@ -31,12 +47,18 @@ func (i Item) MarshalJSON() ([]byte, error) {
 	if i.Explicit || i.ExplicitKnown {
 		explicit = &i.Explicit
 	}
+	var saved *bool
+	if i.SavedKnown {
+		saved = &i.Saved
+	}
 	return json.Marshal(struct {
 		itemAlias
 		Explicit *bool `json:"explicit,omitempty"`
+		Saved    *bool `json:"saved,omitempty"`
 	}{
 		itemAlias: itemAlias(i),
 		Explicit:  explicit,
+		Saved:     saved,
 	})
 }
 
@@ -52,6 +74,7 @@ type PlaybackStatus struct {
 	IsPlaying  bool   `json:"is_playing"`
 	ProgressMS int    `json:"progress_ms"`
 	Item       *Item  `json:"item,omitempty"`
+	ContextURI string `json:"context_uri,omitempty"`
 	Device     Device `json:"device"`
 	Shuffle    bool   `json:"shuffle"`
 	Repeat     string `json:"repeat"`
@@ -66,6 +89,31 @@ type Device struct {
 	Restricted bool   `json:"is_restricted"`
 }
 
+type UserProfile struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"display_name"`
+	Country     string `json:"country,omitempty"`
+	Product     string `json:"product,omitempty"`
+	Followers   int    `json:"followers"`
+	URI         string `json:"uri,omitempty"`
+	URL         string `json:"url,omitempty"`
+}
+
+// BrowseSection is one shelf of the home feed or a browse page.
+type BrowseSection struct {
+	URI   string `json:"uri,omitempty"`
+	Title string `json:"title"`
+	Items []Item `json:"items"`
+}
+
+// Category is a genre or mood page in Browse; its ID is also the
+// spotify:page: ID pathfinder uses.
+type Category struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	URI  string `json:"uri,omitempty"`
+}
+
 type Queue struct {
 	CurrentlyPlaying *Item  `json:"currently_playing,omitempty"`
 	Queue            []Item `json:"queue"`
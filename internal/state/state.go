@@ -0,0 +1,72 @@
+// Package state persists small per-profile runtime state that must survive
+// between invocations but is not configuration, such as the volume to
+// restore on unmute or saved playback snapshots.
+package state
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/steipete/spogo/internal/spotify"
+)
+
+type State struct {
+	MutedVolume int                 `json:"muted_volume,omitempty"`
+	Snapshots   map[string]Snapshot `json:"snapshots,omitempty"`
+}
+
+// Snapshot is a saved playback position: what was playing, where, and how.
+// The queue is kept for reference only; Spotify has no way to rebuild it.
+type Snapshot struct {
+	SavedAt  time.Time              `json:"saved_at"`
+	Playback spotify.PlaybackStatus `json:"playback"`
+	Queue    []spotify.Item         `json:"queue,omitempty"`
+}
+
+// Read loads the state file. A missing file is an empty state.
+func Read(path string) (State, error) {
+	if path == "" {
+		return State{}, errors.New("state path required")
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return State{}, nil
+		}
+		return State{}, err
+	}
+	var st State
+	if err := json.Unmarshal(data, &st); err != nil {
+		return State{}, err
+	}
+	return st, nil
+}
+
+func Write(path string, st State) error {
+	if path == "" {
+		return errors.New("state path required")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(st, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
+// Update reads the state, applies fn and writes the result back.
+func Update(path string, fn func(*State) error) error {
+	st, err := Read(path)
+	if err != nil {
+		return err
+	}
+	if err := fn(&st); err != nil {
+		return err
+	}
+	return Write(path, st)
+}
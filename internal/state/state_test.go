@@ -0,0 +1,93 @@
+package state
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/steipete/spogo/internal/spotify"
+)
+
+func TestReadWrite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state", "default.json")
+	st, err := Read(path)
+	if err != nil || st.MutedVolume != 0 {
+		t.Fatalf("missing file: %#v %v", st, err)
+	}
+	if err := Write(path, State{MutedVolume: 42}); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	st, err = Read(path)
+	if err != nil || st.MutedVolume != 42 {
+		t.Fatalf("read: %#v %v", st, err)
+	}
+	info, err := os.Stat(path)
+	if err != nil || info.Mode().Perm() != 0o600 {
+		t.Fatalf("perm: %v %v", info, err)
+	}
+}
+
+func TestSnapshotsRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "default.json")
+	snap := Snapshot{
+		SavedAt:  time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC),
+		Playback: spotify.PlaybackStatus{ProgressMS: 1500, ContextURI: "spotify:album:a1", Item: &spotify.Item{URI: "spotify:track:t1"}},
+		Queue:    []spotify.Item{{URI: "spotify:track:t2"}},
+	}
+	if err := Write(path, State{Snapshots: map[string]Snapshot{"meeting": snap}}); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	st, err := Read(path)
+	got := st.Snapshots["meeting"]
+	if err != nil || !got.SavedAt.Equal(snap.SavedAt) || got.Playback.Item.URI != "spotify:track:t1" || got.Playback.ContextURI != "spotify:album:a1" || len(got.Queue) != 1 {
+		t.Fatalf("snapshot %#v %v", got, err)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "default.json")
+	if err := Update(path, func(st *State) error {
+		st.MutedVolume = 7
+		return nil
+	}); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if st, _ := Read(path); st.MutedVolume != 7 {
+		t.Fatalf("state %#v", st)
+	}
+	boom := errors.New("boom")
+	if err := Update(path, func(*State) error { return boom }); !errors.Is(err, boom) {
+		t.Fatalf("expected boom, got %v", err)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	if _, err := Read(""); err == nil {
+		t.Fatalf("expected error")
+	}
+	if err := Write("", State{}); err == nil {
+		t.Fatalf("expected error")
+	}
+	if err := Update("", func(*State) error { return nil }); err == nil {
+		t.Fatalf("expected error")
+	}
+	path := filepath.Join(t.TempDir(), "bad.json")
+	if err := os.WriteFile(path, []byte("{"), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if _, err := Read(path); err == nil {
+		t.Fatalf("expected parse error")
+	}
+	blocker := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(blocker, nil, 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if err := Write(filepath.Join(blocker, "x", "state.json"), State{}); err == nil {
+		t.Fatalf("expected mkdir error")
+	}
+	if _, err := Read(t.TempDir()); err == nil {
+		t.Fatalf("expected read error for directory")
+	}
+}
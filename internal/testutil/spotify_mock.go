@@ -3,6 +3,7 @@ package testutil
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/steipete/spogo/internal/spotify"
 )
@@ -10,35 +11,58 @@ import (
 var ErrNotImplemented = errors.New("not implemented")
 
 type SpotifyMock struct {
-	SearchFn          func(context.Context, string, string, int, int) (spotify.SearchResult, error)
-	GetTrackFn        func(context.Context, string) (spotify.Item, error)
-	GetAlbumFn        func(context.Context, string) (spotify.Item, error)
-	GetArtistFn       func(context.Context, string) (spotify.Item, error)
-	GetPlaylistFn     func(context.Context, string) (spotify.Item, error)
-	GetShowFn         func(context.Context, string) (spotify.Item, error)
-	GetEpisodeFn      func(context.Context, string) (spotify.Item, error)
-	ArtistTopTracksFn func(context.Context, string, int) ([]spotify.Item, error)
-	PlaybackFn        func(context.Context) (spotify.PlaybackStatus, error)
-	PlayFn            func(context.Context, string) error
-	PauseFn           func(context.Context) error
-	NextFn            func(context.Context) error
-	PreviousFn        func(context.Context) error
-	SeekFn            func(context.Context, int) error
-	VolumeFn          func(context.Context, int) error
-	ShuffleFn         func(context.Context, bool) error
-	RepeatFn          func(context.Context, string) error
-	DevicesFn         func(context.Context) ([]spotify.Device, error)
-	TransferFn        func(context.Context, string) error
-	QueueAddFn        func(context.Context, string) error
-	QueueFn           func(context.Context) (spotify.Queue, error)
-	LibraryTracksFn   func(context.Context, int, int) ([]spotify.Item, int, error)
-	LibraryAlbumsFn   func(context.Context, int, int) ([]spotify.Item, int, error)
-	LibraryModifyFn   func(context.Context, string, []string, string) error
-	FollowArtistsFn   func(context.Context, []string, string) error
-	FollowedArtistsFn func(context.Context, int, string) ([]spotify.Item, int, string, error)
-	PlaylistsFn       func(context.Context, int, int) ([]spotify.Item, int, error)
-	PlaylistTracksFn  func(context.Context, string, int, int) ([]spotify.Item, int, error)
-	CreatePlaylistFn  func(context.Context, string, bool, bool) (spotify.Item, error)
-	AddTracksFn       func(context.Context, string, []string) error
-	RemoveTracksFn    func(context.Context, string, []string) error
+	SearchFn            func(context.Context, string, string, int, int) (spotify.SearchResult, error)
+	GetTrackFn          func(context.Context, string) (spotify.Item, error)
+	GetAlbumFn          func(context.Context, string) (spotify.Item, error)
+	ShowEpisodesFn      func(context.Context, string, int, int) ([]spotify.Item, int, error)
+	AlbumTracksFn       func(context.Context, string, int, int) ([]spotify.Item, int, error)
+	GetArtistFn         func(context.Context, string) (spotify.Item, error)
+	GetPlaylistFn       func(context.Context, string) (spotify.Item, error)
+	GetShowFn           func(context.Context, string) (spotify.Item, error)
+	GetEpisodeFn        func(context.Context, string) (spotify.Item, error)
+	ArtistTopTracksFn   func(context.Context, string, int) ([]spotify.Item, error)
+	ArtistAlbumsFn      func(context.Context, string, spotify.AlbumGroup, int, int) ([]spotify.Item, int, error)
+	RelatedArtistsFn    func(context.Context, string, int) ([]spotify.Item, error)
+	GetAudiobookFn      func(context.Context, string) (spotify.Item, error)
+	GetChapterFn        func(context.Context, string) (spotify.Item, error)
+	AudiobookChaptersFn func(context.Context, string, int, int) ([]spotify.Item, int, error)
+	LibraryAudiobooksFn func(context.Context, int, int) ([]spotify.Item, int, error)
+	LyricsFn            func(context.Context, string) (spotify.Lyrics, error)
+	PlaybackFn          func(context.Context) (spotify.PlaybackStatus, error)
+	PlayFn              func(context.Context, string) error
+	PlayContextFn       func(context.Context, string, string) error
+	PauseFn             func(context.Context) error
+	NextFn              func(context.Context) error
+	PreviousFn          func(context.Context) error
+	SeekFn              func(context.Context, int) error
+	VolumeFn            func(context.Context, int) error
+	DeviceVolumeFn      func(context.Context, string, int) error
+	ShuffleFn           func(context.Context, bool) error
+	RepeatFn            func(context.Context, string) error
+	DevicesFn           func(context.Context) ([]spotify.Device, error)
+	TransferFn          func(context.Context, string) error
+	QueueAddFn          func(context.Context, string) error
+	QueueFn             func(context.Context) (spotify.Queue, error)
+	LibraryTracksFn     func(context.Context, int, int) ([]spotify.Item, int, error)
+	LibraryAlbumsFn     func(context.Context, int, int) ([]spotify.Item, int, error)
+	LibraryShowsFn      func(context.Context, int, int) ([]spotify.Item, int, error)
+	LibraryEpisodesFn   func(context.Context, int, int) ([]spotify.Item, int, error)
+	LibraryContainsFn   func(context.Context, []string) ([]bool, error)
+	MeFn                func(context.Context) (spotify.UserProfile, error)
+	TopItemsFn          func(context.Context, string, spotify.TimeRange, int, int) ([]spotify.Item, int, error)
+	RecentlyPlayedFn    func(context.Context, int, time.Time) ([]spotify.Item, error)
+	RadioFn             func(context.Context, string) (string, error)
+	RecommendationsFn   func(context.Context, spotify.RecommendationSeeds, int) ([]spotify.Item, error)
+	BrowseHomeFn        func(context.Context, int) ([]spotify.BrowseSection, error)
+	BrowseCategoriesFn  func(context.Context, int, int) ([]spotify.Category, int, error)
+	BrowseCategoryFn    func(context.Context, string, int) ([]spotify.BrowseSection, error)
+	NewReleasesFn       func(context.Context, int, int) ([]spotify.Item, int, error)
+	LibraryModifyFn     func(context.Context, string, []string, string) error
+	FollowArtistsFn     func(context.Context, []string, string) error
+	FollowedArtistsFn   func(context.Context, int, string) ([]spotify.Item, int, string, error)
+	PlaylistsFn         func(context.Context, int, int) ([]spotify.Item, int, error)
+	PlaylistTracksFn    func(context.Context, string, int, int) ([]spotify.Item, int, error)
+	CreatePlaylistFn    func(context.Context, string, bool, bool) (spotify.Item, error)
+	AddTracksFn         func(context.Context, string, []string) error
+	RemoveTracksFn      func(context.Context, string, []string) error
 }
@@ -2,6 +2,7 @@ package testutil
 
 import (
 	"context"
+	"time"
 
 	"github.com/steipete/spogo/internal/spotify"
 )
@@ -61,3 +62,115 @@ func (m *SpotifyMock) ArtistTopTracks(ctx context.Context, id string, limit int)
 	}
 	return m.ArtistTopTracksFn(ctx, id, limit)
 }
+
+func (m *SpotifyMock) ArtistAlbums(ctx context.Context, id string, group spotify.AlbumGroup, limit, offset int) ([]spotify.Item, int, error) {
+	if m.ArtistAlbumsFn == nil {
+		return nil, 0, ErrNotImplemented
+	}
+	return m.ArtistAlbumsFn(ctx, id, group, limit, offset)
+}
+
+func (m *SpotifyMock) RelatedArtists(ctx context.Context, id string, limit int) ([]spotify.Item, error) {
+	if m.RelatedArtistsFn == nil {
+		return nil, ErrNotImplemented
+	}
+	return m.RelatedArtistsFn(ctx, id, limit)
+}
+
+func (m *SpotifyMock) GetAudiobook(ctx context.Context, id string) (spotify.Item, error) {
+	if m.GetAudiobookFn == nil {
+		return spotify.Item{}, ErrNotImplemented
+	}
+	return m.GetAudiobookFn(ctx, id)
+}
+
+func (m *SpotifyMock) GetChapter(ctx context.Context, id string) (spotify.Item, error) {
+	if m.GetChapterFn == nil {
+		return spotify.Item{}, ErrNotImplemented
+	}
+	return m.GetChapterFn(ctx, id)
+}
+
+func (m *SpotifyMock) AudiobookChapters(ctx context.Context, id string, limit, offset int) ([]spotify.Item, int, error) {
+	if m.AudiobookChaptersFn == nil {
+		return nil, 0, ErrNotImplemented
+	}
+	return m.AudiobookChaptersFn(ctx, id, limit, offset)
+}
+
+func (m *SpotifyMock) LibraryAudiobooks(ctx context.Context, limit, offset int) ([]spotify.Item, int, error) {
+	if m.LibraryAudiobooksFn == nil {
+		return nil, 0, ErrNotImplemented
+	}
+	return m.LibraryAudiobooksFn(ctx, limit, offset)
+}
+
+func (m *SpotifyMock) Lyrics(ctx context.Context, trackID string) (spotify.Lyrics, error) {
+	if m.LyricsFn == nil {
+		return spotify.Lyrics{}, ErrNotImplemented
+	}
+	return m.LyricsFn(ctx, trackID)
+}
+
+func (m *SpotifyMock) Me(ctx context.Context) (spotify.UserProfile, error) {
+	if m.MeFn == nil {
+		return spotify.UserProfile{}, ErrNotImplemented
+	}
+	return m.MeFn(ctx)
+}
+
+func (m *SpotifyMock) TopItems(ctx context.Context, kind string, timeRange spotify.TimeRange, limit, offset int) ([]spotify.Item, int, error) {
+	if m.TopItemsFn == nil {
+		return nil, 0, ErrNotImplemented
+	}
+	return m.TopItemsFn(ctx, kind, timeRange, limit, offset)
+}
+
+func (m *SpotifyMock) RecentlyPlayed(ctx context.Context, limit int, after time.Time) ([]spotify.Item, error) {
+	if m.RecentlyPlayedFn == nil {
+		return nil, ErrNotImplemented
+	}
+	return m.RecentlyPlayedFn(ctx, limit, after)
+}
+
+func (m *SpotifyMock) Radio(ctx context.Context, seedURI string) (string, error) {
+	if m.RadioFn == nil {
+		return "", ErrNotImplemented
+	}
+	return m.RadioFn(ctx, seedURI)
+}
+
+func (m *SpotifyMock) Recommendations(ctx context.Context, seeds spotify.RecommendationSeeds, limit int) ([]spotify.Item, error) {
+	if m.RecommendationsFn == nil {
+		return nil, ErrNotImplemented
+	}
+	return m.RecommendationsFn(ctx, seeds, limit)
+}
+
+func (m *SpotifyMock) BrowseHome(ctx context.Context, limit int) ([]spotify.BrowseSection, error) {
+	if m.BrowseHomeFn == nil {
+		return nil, ErrNotImplemented
+	}
+	return m.BrowseHomeFn(ctx, limit)
+}
+
+func (m *SpotifyMock) BrowseCategories(ctx context.Context, limit, offset int) ([]spotify.Category, int, error) {
+	if m.BrowseCategoriesFn == nil {
+		return nil, 0, ErrNotImplemented
+	}
+	return m.BrowseCategoriesFn(ctx, limit, offset)
+}
+
+func (m *SpotifyMock) BrowseCategory(ctx context.Context, id string, limit int) ([]spotify.BrowseSection, error) {
+	if m.BrowseCategoryFn == nil {
+		return nil, ErrNotImplemented
+	}
+	return m.BrowseCategoryFn(ctx, id, limit)
+}
+
+func (m *SpotifyMock) NewReleases(ctx context.Context, limit, offset int) ([]spotify.Item, int, error) {
+	if m.NewReleasesFn == nil {
+		return nil, 0, ErrNotImplemented
+	}
+	return m.NewReleasesFn(ctx, limit, offset)
+}
@@ -48,6 +48,34 @@ func (m *SpotifyMock) Playlists(ctx context.Context, limit, offset int) ([]spoti
 	return m.PlaylistsFn(ctx, limit, offset)
 }
 
+func (m *SpotifyMock) LibraryShows(ctx context.Context, limit, offset int) ([]spotify.Item, int, error) {
+	if m.LibraryShowsFn == nil {
+		return nil, 0, ErrNotImplemented
+	}
+	return m.LibraryShowsFn(ctx, limit, offset)
+}
+
+func (m *SpotifyMock) LibraryEpisodes(ctx context.Context, limit, offset int) ([]spotify.Item, int, error) {
+	if m.LibraryEpisodesFn == nil {
+		return nil, 0, ErrNotImplemented
+	}
+	return m.LibraryEpisodesFn(ctx, limit, offset)
+}
+
+func (m *SpotifyMock) ShowEpisodes(ctx context.Context, id string, limit, offset int) ([]spotify.Item, int, error) {
+	if m.ShowEpisodesFn == nil {
+		return nil, 0, ErrNotImplemented
+	}
+	return m.ShowEpisodesFn(ctx, id, limit, offset)
+}
+
+func (m *SpotifyMock) AlbumTracks(ctx context.Context, id string, limit, offset int) ([]spotify.Item, int, error) {
+	if m.AlbumTracksFn == nil {
+		return nil, 0, ErrNotImplemented
+	}
+	return m.AlbumTracksFn(ctx, id, limit, offset)
+}
+
 func (m *SpotifyMock) PlaylistTracks(ctx context.Context, id string, limit, offset int) ([]spotify.Item, int, error) {
 	if m.PlaylistTracksFn == nil {
 		return nil, 0, ErrNotImplemented
@@ -75,3 +103,10 @@ func (m *SpotifyMock) RemoveTracks(ctx context.Context, playlistID string, uris
 	}
 	return m.RemoveTracksFn(ctx, playlistID, uris)
 }
+
+func (m *SpotifyMock) LibraryContains(ctx context.Context, uris []string) ([]bool, error) {
+	if m.LibraryContainsFn == nil {
+		return nil, ErrNotImplemented
+	}
+	return m.LibraryContainsFn(ctx, uris)
+}
@@ -3,6 +3,9 @@ package testutil
 import (
 	"context"
 	"testing"
+	"time"
+
+	"github.com/steipete/spogo/internal/spotify"
 )
 
 func TestSpotifyMockAllNotImplemented(t *testing.T) {
@@ -15,13 +18,22 @@ func TestSpotifyMockAllNotImplemented(t *testing.T) {
 	_, _ = m.GetShow(context.Background(), "1")
 	_, _ = m.GetEpisode(context.Background(), "1")
 	_, _ = m.ArtistTopTracks(context.Background(), "1", 10)
+	_, _, _ = m.ArtistAlbums(context.Background(), "1", "album", 10, 0)
+	_, _ = m.RelatedArtists(context.Background(), "1", 10)
+	_, _ = m.GetAudiobook(context.Background(), "1")
+	_, _ = m.GetChapter(context.Background(), "1")
+	_, _, _ = m.AudiobookChapters(context.Background(), "1", 1, 0)
+	_, _, _ = m.LibraryAudiobooks(context.Background(), 1, 0)
+	_, _ = m.Lyrics(context.Background(), "1")
 	_, _ = m.Playback(context.Background())
 	_ = m.Play(context.Background(), "uri")
+	_ = m.PlayContext(context.Background(), "ctx", "uri")
 	_ = m.Pause(context.Background())
 	_ = m.Next(context.Background())
 	_ = m.Previous(context.Background())
 	_ = m.Seek(context.Background(), 1)
 	_ = m.Volume(context.Background(), 1)
+	_ = m.DeviceVolume(context.Background(), "d", 1)
 	_ = m.Shuffle(context.Background(), true)
 	_ = m.Repeat(context.Background(), "off")
 	_, _ = m.Devices(context.Background())
@@ -30,10 +42,24 @@ func TestSpotifyMockAllNotImplemented(t *testing.T) {
 	_, _ = m.Queue(context.Background())
 	_, _, _ = m.LibraryTracks(context.Background(), 1, 0)
 	_, _, _ = m.LibraryAlbums(context.Background(), 1, 0)
+	_, _ = m.LibraryContains(context.Background(), []string{"spotify:track:1"})
+	_, _ = m.Me(context.Background())
+	_, _, _ = m.TopItems(context.Background(), "track", spotify.TimeRangeMedium, 1, 0)
+	_, _ = m.RecentlyPlayed(context.Background(), 1, time.Time{})
+	_, _ = m.Radio(context.Background(), "spotify:track:1")
+	_, _ = m.Recommendations(context.Background(), spotify.RecommendationSeeds{Tracks: []string{"1"}}, 1)
+	_, _ = m.BrowseHome(context.Background(), 1)
+	_, _, _ = m.BrowseCategories(context.Background(), 1, 0)
+	_, _ = m.BrowseCategory(context.Background(), "c1", 1)
+	_, _, _ = m.NewReleases(context.Background(), 1, 0)
 	_ = m.LibraryModify(context.Background(), "/me/tracks", []string{"1"}, "PUT")
 	_ = m.FollowArtists(context.Background(), []string{"1"}, "PUT")
 	_, _, _, _ = m.FollowedArtists(context.Background(), 1, "")
 	_, _, _ = m.Playlists(context.Background(), 1, 0)
+	_, _, _ = m.LibraryShows(context.Background(), 1, 0)
+	_, _, _ = m.LibraryEpisodes(context.Background(), 1, 0)
+	_, _, _ = m.ShowEpisodes(context.Background(), "1", 1, 0)
+	_, _, _ = m.AlbumTracks(context.Background(), "1", 1, 0)
 	_, _, _ = m.PlaylistTracks(context.Background(), "1", 1, 0)
 	_, _ = m.CreatePlaylist(context.Background(), "name", true, false)
 	_ = m.AddTracks(context.Background(), "p", []string{"u"})
@@ -20,6 +20,13 @@ func (m *SpotifyMock) Play(ctx context.Context, uri string) error {
 	return m.PlayFn(ctx, uri)
 }
 
+func (m *SpotifyMock) PlayContext(ctx context.Context, contextURI, trackURI string) error {
+	if m.PlayContextFn == nil {
+		return ErrNotImplemented
+	}
+	return m.PlayContextFn(ctx, contextURI, trackURI)
+}
+
 func (m *SpotifyMock) Pause(ctx context.Context) error {
 	if m.PauseFn == nil {
 		return ErrNotImplemented
@@ -55,6 +62,13 @@ func (m *SpotifyMock) Volume(ctx context.Context, volume int) error {
 	return m.VolumeFn(ctx, volume)
 }
 
+func (m *SpotifyMock) DeviceVolume(ctx context.Context, deviceID string, volume int) error {
+	if m.DeviceVolumeFn == nil {
+		return ErrNotImplemented
+	}
+	return m.DeviceVolumeFn(ctx, deviceID, volume)
+}
+
 func (m *SpotifyMock) Shuffle(ctx context.Context, enabled bool) error {
 	if m.ShuffleFn == nil {
 		return ErrNotImplemented
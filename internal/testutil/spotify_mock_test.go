@@ -3,6 +3,7 @@ package testutil
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/steipete/spogo/internal/spotify"
 )
@@ -19,29 +20,58 @@ func TestSpotifyMockAllMethods(t *testing.T) {
 		GetShowFn:         func(context.Context, string) (spotify.Item, error) { return spotify.Item{}, nil },
 		GetEpisodeFn:      func(context.Context, string) (spotify.Item, error) { return spotify.Item{}, nil },
 		ArtistTopTracksFn: func(context.Context, string, int) ([]spotify.Item, error) { return nil, nil },
-		PlaybackFn:        func(context.Context) (spotify.PlaybackStatus, error) { return spotify.PlaybackStatus{}, nil },
-		PlayFn:            func(context.Context, string) error { return nil },
-		PauseFn:           func(context.Context) error { return nil },
-		NextFn:            func(context.Context) error { return nil },
-		PreviousFn:        func(context.Context) error { return nil },
-		SeekFn:            func(context.Context, int) error { return nil },
-		VolumeFn:          func(context.Context, int) error { return nil },
-		ShuffleFn:         func(context.Context, bool) error { return nil },
-		RepeatFn:          func(context.Context, string) error { return nil },
-		DevicesFn:         func(context.Context) ([]spotify.Device, error) { return nil, nil },
-		TransferFn:        func(context.Context, string) error { return nil },
-		QueueAddFn:        func(context.Context, string) error { return nil },
-		QueueFn:           func(context.Context) (spotify.Queue, error) { return spotify.Queue{}, nil },
-		LibraryTracksFn:   func(context.Context, int, int) ([]spotify.Item, int, error) { return nil, 0, nil },
-		LibraryAlbumsFn:   func(context.Context, int, int) ([]spotify.Item, int, error) { return nil, 0, nil },
-		LibraryModifyFn:   func(context.Context, string, []string, string) error { return nil },
-		FollowArtistsFn:   func(context.Context, []string, string) error { return nil },
-		FollowedArtistsFn: func(context.Context, int, string) ([]spotify.Item, int, string, error) { return nil, 0, "", nil },
-		PlaylistsFn:       func(context.Context, int, int) ([]spotify.Item, int, error) { return nil, 0, nil },
-		PlaylistTracksFn:  func(context.Context, string, int, int) ([]spotify.Item, int, error) { return nil, 0, nil },
-		CreatePlaylistFn:  func(context.Context, string, bool, bool) (spotify.Item, error) { return spotify.Item{}, nil },
-		AddTracksFn:       func(context.Context, string, []string) error { return nil },
-		RemoveTracksFn:    func(context.Context, string, []string) error { return nil },
+		ArtistAlbumsFn: func(context.Context, string, spotify.AlbumGroup, int, int) ([]spotify.Item, int, error) {
+			return nil, 0, nil
+		},
+		RelatedArtistsFn: func(context.Context, string, int) ([]spotify.Item, error) { return nil, nil },
+		GetAudiobookFn:   func(context.Context, string) (spotify.Item, error) { return spotify.Item{}, nil },
+		GetChapterFn:     func(context.Context, string) (spotify.Item, error) { return spotify.Item{}, nil },
+		AudiobookChaptersFn: func(context.Context, string, int, int) ([]spotify.Item, int, error) {
+			return nil, 0, nil
+		},
+		LibraryAudiobooksFn: func(context.Context, int, int) ([]spotify.Item, int, error) { return nil, 0, nil },
+		LyricsFn:            func(context.Context, string) (spotify.Lyrics, error) { return spotify.Lyrics{}, nil },
+		PlaybackFn:          func(context.Context) (spotify.PlaybackStatus, error) { return spotify.PlaybackStatus{}, nil },
+		PlayFn:              func(context.Context, string) error { return nil },
+		PlayContextFn:       func(context.Context, string, string) error { return nil },
+		PauseFn:             func(context.Context) error { return nil },
+		NextFn:              func(context.Context) error { return nil },
+		PreviousFn:          func(context.Context) error { return nil },
+		SeekFn:              func(context.Context, int) error { return nil },
+		VolumeFn:            func(context.Context, int) error { return nil },
+		DeviceVolumeFn:      func(context.Context, string, int) error { return nil },
+		ShuffleFn:           func(context.Context, bool) error { return nil },
+		RepeatFn:            func(context.Context, string) error { return nil },
+		DevicesFn:           func(context.Context) ([]spotify.Device, error) { return nil, nil },
+		TransferFn:          func(context.Context, string) error { return nil },
+		QueueAddFn:          func(context.Context, string) error { return nil },
+		QueueFn:             func(context.Context) (spotify.Queue, error) { return spotify.Queue{}, nil },
+		LibraryTracksFn:     func(context.Context, int, int) ([]spotify.Item, int, error) { return nil, 0, nil },
+		LibraryAlbumsFn:     func(context.Context, int, int) ([]spotify.Item, int, error) { return nil, 0, nil },
+		LibraryContainsFn:   func(context.Context, []string) ([]bool, error) { return nil, nil },
+		MeFn:                func(context.Context) (spotify.UserProfile, error) { return spotify.UserProfile{}, nil },
+		TopItemsFn: func(context.Context, string, spotify.TimeRange, int, int) ([]spotify.Item, int, error) {
+			return nil, 0, nil
+		},
+		RecentlyPlayedFn:   func(context.Context, int, time.Time) ([]spotify.Item, error) { return nil, nil },
+		RadioFn:            func(context.Context, string) (string, error) { return "", nil },
+		RecommendationsFn:  func(context.Context, spotify.RecommendationSeeds, int) ([]spotify.Item, error) { return nil, nil },
+		BrowseHomeFn:       func(context.Context, int) ([]spotify.BrowseSection, error) { return nil, nil },
+		BrowseCategoriesFn: func(context.Context, int, int) ([]spotify.Category, int, error) { return nil, 0, nil },
+		BrowseCategoryFn:   func(context.Context, string, int) ([]spotify.BrowseSection, error) { return nil, nil },
+		NewReleasesFn:      func(context.Context, int, int) ([]spotify.Item, int, error) { return nil, 0, nil },
+		LibraryModifyFn:    func(context.Context, string, []string, string) error { return nil },
+		FollowArtistsFn:    func(context.Context, []string, string) error { return nil },
+		FollowedArtistsFn:  func(context.Context, int, string) ([]spotify.Item, int, string, error) { return nil, 0, "", nil },
+		PlaylistsFn:        func(context.Context, int, int) ([]spotify.Item, int, error) { return nil, 0, nil },
+		LibraryShowsFn:     func(context.Context, int, int) ([]spotify.Item, int, error) { return nil, 0, nil },
+		LibraryEpisodesFn:  func(context.Context, int, int) ([]spotify.Item, int, error) { return nil, 0, nil },
+		ShowEpisodesFn:     func(context.Context, string, int, int) ([]spotify.Item, int, error) { return nil, 0, nil },
+		AlbumTracksFn:      func(context.Context, string, int, int) ([]spotify.Item, int, error) { return nil, 0, nil },
+		PlaylistTracksFn:   func(context.Context, string, int, int) ([]spotify.Item, int, error) { return nil, 0, nil },
+		CreatePlaylistFn:   func(context.Context, string, bool, bool) (spotify.Item, error) { return spotify.Item{}, nil },
+		AddTracksFn:        func(context.Context, string, []string) error { return nil },
+		RemoveTracksFn:     func(context.Context, string, []string) error { return nil },
 	}
 	_, _ = m.Search(context.Background(), "track", "q", 1, 0)
 	_, _ = m.GetTrack(context.Background(), "1")
@@ -51,13 +81,22 @@ func TestSpotifyMockAllMethods(t *testing.T) {
 	_, _ = m.GetShow(context.Background(), "1")
 	_, _ = m.GetEpisode(context.Background(), "1")
 	_, _ = m.ArtistTopTracks(context.Background(), "1", 10)
+	_, _, _ = m.ArtistAlbums(context.Background(), "1", spotify.AlbumGroupAlbum, 10, 0)
+	_, _ = m.RelatedArtists(context.Background(), "1", 10)
+	_, _ = m.GetAudiobook(context.Background(), "1")
+	_, _ = m.GetChapter(context.Background(), "1")
+	_, _, _ = m.AudiobookChapters(context.Background(), "1", 1, 0)
+	_, _, _ = m.LibraryAudiobooks(context.Background(), 1, 0)
+	_, _ = m.Lyrics(context.Background(), "1")
 	_, _ = m.Playback(context.Background())
 	_ = m.Play(context.Background(), "uri")
+	_ = m.PlayContext(context.Background(), "ctx", "uri")
 	_ = m.Pause(context.Background())
 	_ = m.Next(context.Background())
 	_ = m.Previous(context.Background())
 	_ = m.Seek(context.Background(), 1)
 	_ = m.Volume(context.Background(), 1)
+	_ = m.DeviceVolume(context.Background(), "d", 1)
 	_ = m.Shuffle(context.Background(), true)
 	_ = m.Repeat(context.Background(), "off")
 	_, _ = m.Devices(context.Background())
@@ -66,10 +105,24 @@ func TestSpotifyMockAllMethods(t *testing.T) {
 	_, _ = m.Queue(context.Background())
 	_, _, _ = m.LibraryTracks(context.Background(), 1, 0)
 	_, _, _ = m.LibraryAlbums(context.Background(), 1, 0)
+	_, _ = m.LibraryContains(context.Background(), []string{"spotify:track:1"})
+	_, _ = m.Me(context.Background())
+	_, _, _ = m.TopItems(context.Background(), "track", spotify.TimeRangeMedium, 1, 0)
+	_, _ = m.RecentlyPlayed(context.Background(), 1, time.Time{})
+	_, _ = m.Radio(context.Background(), "spotify:track:1")
+	_, _ = m.Recommendations(context.Background(), spotify.RecommendationSeeds{Tracks: []string{"1"}}, 1)
+	_, _ = m.BrowseHome(context.Background(), 1)
+	_, _, _ = m.BrowseCategories(context.Background(), 1, 0)
+	_, _ = m.BrowseCategory(context.Background(), "c1", 1)
+	_, _, _ = m.NewReleases(context.Background(), 1, 0)
 	_ = m.LibraryModify(context.Background(), "/me/tracks", []string{"1"}, "PUT")
 	_ = m.FollowArtists(context.Background(), []string{"1"}, "PUT")
 	_, _, _, _ = m.FollowedArtists(context.Background(), 1, "")
 	_, _, _ = m.Playlists(context.Background(), 1, 0)
+	_, _, _ = m.LibraryShows(context.Background(), 1, 0)
+	_, _, _ = m.LibraryEpisodes(context.Background(), 1, 0)
+	_, _, _ = m.ShowEpisodes(context.Background(), "1", 1, 0)
+	_, _, _ = m.AlbumTracks(context.Background(), "1", 1, 0)
 	_, _, _ = m.PlaylistTracks(context.Background(), "1", 1, 0)
 	_, _ = m.CreatePlaylist(context.Background(), "name", true, false)
 	_ = m.AddTracks(context.Background(), "p", []string{"u"})
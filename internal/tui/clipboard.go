@@ -0,0 +1,46 @@
+package tui
+
+import (
+	"encoding/base64"
+	"io"
+	"os/exec"
+	"strings"
+)
+
+type clipboardCommand struct {
+	name string
+	args []string
+}
+
+var clipboardCommands = []clipboardCommand{
+	{name: "pbcopy"},
+	{name: "wl-copy"},
+	{name: "xclip", args: []string{"-selection", "clipboard"}},
+	{name: "xsel", args: []string{"--clipboard", "--input"}},
+	{name: "clip.exe"},
+}
+
+var lookPath = exec.LookPath
+
+var runClipboard = func(name string, args []string, text string) error {
+	cmd := exec.Command(name, args...)
+	cmd.Stdin = strings.NewReader(text)
+	return cmd.Run()
+}
+
+// Copy puts text on the system clipboard using the first clipboard tool on
+// PATH, falling back to an OSC 52 escape written to out, which most modern
+// terminals (including over SSH) honor.
+func Copy(out io.Writer, text string) error {
+	for _, candidate := range clipboardCommands {
+		path, err := lookPath(candidate.name)
+		if err != nil {
+			continue
+		}
+		if err := runClipboard(path, candidate.args, text); err == nil {
+			return nil
+		}
+	}
+	_, err := io.WriteString(out, "\x1b]52;c;"+base64.StdEncoding.EncodeToString([]byte(text))+"\a")
+	return err
+}
@@ -0,0 +1,50 @@
+package tui
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func stubClipboard(t *testing.T, available map[string]bool, run func(string, []string, string) error) {
+	t.Helper()
+	origLook, origRun := lookPath, runClipboard
+	t.Cleanup(func() {
+		lookPath, runClipboard = origLook, origRun
+	})
+	lookPath = func(name string) (string, error) {
+		if available[name] {
+			return "/bin/" + name, nil
+		}
+		return "", errors.New("not found")
+	}
+	runClipboard = run
+}
+
+func TestCopyUsesClipboardTool(t *testing.T) {
+	var gotName, gotText string
+	stubClipboard(t, map[string]bool{"xclip": true}, func(name string, args []string, text string) error {
+		gotName, gotText = name, text
+		return nil
+	})
+	var out bytes.Buffer
+	if err := Copy(&out, "spotify:track:1"); err != nil {
+		t.Fatalf("copy: %v", err)
+	}
+	if gotName != "/bin/xclip" || gotText != "spotify:track:1" || out.Len() != 0 {
+		t.Fatalf("name %q text %q out %q", gotName, gotText, out.String())
+	}
+}
+
+func TestCopyFallsBackToOSC52(t *testing.T) {
+	stubClipboard(t, map[string]bool{"pbcopy": true}, func(string, []string, string) error {
+		return errors.New("boom")
+	})
+	var out bytes.Buffer
+	if err := Copy(&out, "hi"); err != nil {
+		t.Fatalf("copy: %v", err)
+	}
+	if got := out.String(); got != "\x1b]52;c;aGk=\a" {
+		t.Fatalf("got %q", got)
+	}
+}
@@ -0,0 +1,120 @@
+package tui
+
+import (
+	"io"
+	"unicode/utf8"
+)
+
+type KeyCode int
+
+const (
+	KeyRune KeyCode = iota
+	KeyEnter
+	KeyTab
+	KeyBackspace
+	KeyEsc
+	KeyUp
+	KeyDown
+	KeyLeft
+	KeyRight
+	KeyHome
+	KeyEnd
+	KeyPageUp
+	KeyPageDown
+	KeyDelete
+	KeyCtrl
+)
+
+// Key is a decoded keypress. Rune holds the character for KeyRune and the
+// lower-case letter for KeyCtrl (so Ctrl-C is {KeyCtrl, 'c'}).
+type Key struct {
+	Code KeyCode
+	Rune rune
+}
+
+func (k Key) IsCtrl(r rune) bool {
+	return k.Code == KeyCtrl && k.Rune == r
+}
+
+var csiKeys = map[string]KeyCode{
+	"A":  KeyUp,
+	"B":  KeyDown,
+	"C":  KeyRight,
+	"D":  KeyLeft,
+	"H":  KeyHome,
+	"F":  KeyEnd,
+	"1~": KeyHome,
+	"3~": KeyDelete,
+	"4~": KeyEnd,
+	"5~": KeyPageUp,
+	"6~": KeyPageDown,
+	"7~": KeyHome,
+	"8~": KeyEnd,
+}
+
+// Decode turns one raw read from a terminal into keys. Terminals deliver an
+// escape sequence in a single read, so a lone ESC byte is the Esc key.
+func Decode(buf []byte) []Key {
+	var keys []Key
+	for len(buf) > 0 {
+		b := buf[0]
+		switch {
+		case b == 0x1b:
+			key, n := decodeEscape(buf)
+			keys = append(keys, key)
+			buf = buf[n:]
+			continue
+		case b == '\r' || b == '\n':
+			keys = append(keys, Key{Code: KeyEnter})
+		case b == '\t':
+			keys = append(keys, Key{Code: KeyTab})
+		case b == 0x7f || b == 0x08:
+			keys = append(keys, Key{Code: KeyBackspace})
+		case b < 0x20:
+			keys = append(keys, Key{Code: KeyCtrl, Rune: rune('a' + b - 1)})
+		default:
+			r, n := utf8.DecodeRune(buf)
+			keys = append(keys, Key{Code: KeyRune, Rune: r})
+			buf = buf[n:]
+			continue
+		}
+		buf = buf[1:]
+	}
+	return keys
+}
+
+func decodeEscape(buf []byte) (Key, int) {
+	if len(buf) < 2 || (buf[1] != '[' && buf[1] != 'O') {
+		return Key{Code: KeyEsc}, 1
+	}
+	for i := 2; i < len(buf); i++ {
+		c := buf[i]
+		if (c >= 'A' && c <= 'Z') || c == '~' {
+			if code, ok := csiKeys[string(buf[2:i+1])]; ok {
+				return Key{Code: code}, i + 1
+			}
+			return Key{Code: KeyEsc}, i + 1
+		}
+	}
+	return Key{Code: KeyEsc}, len(buf)
+}
+
+// ReadKeys decodes keys from r until it fails, sending them on the returned
+// channel. The channel is closed when reading stops.
+func ReadKeys(r io.Reader) <-chan Key {
+	ch := make(chan Key)
+	go func() {
+		defer close(ch)
+		buf := make([]byte, 64)
+		for {
+			n, err := r.Read(buf)
+			for _, key := range Decode(buf[:n]) {
+				ch <- key
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+	return ch
+}
@@ -0,0 +1,46 @@
+package tui
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDecode(t *testing.T) {
+	cases := []struct {
+		in   string
+		want []Key
+	}{
+		{"aé", []Key{{Code: KeyRune, Rune: 'a'}, {Code: KeyRune, Rune: 'é'}}},
+		{"\r\n\t\x7f\x08", []Key{{Code: KeyEnter}, {Code: KeyEnter}, {Code: KeyTab}, {Code: KeyBackspace}, {Code: KeyBackspace}}},
+		{"\x03\x15", []Key{{Code: KeyCtrl, Rune: 'c'}, {Code: KeyCtrl, Rune: 'u'}}},
+		{"\x1b", []Key{{Code: KeyEsc}}},
+		{"\x1b[A\x1b[B\x1b[C\x1b[D", []Key{{Code: KeyUp}, {Code: KeyDown}, {Code: KeyRight}, {Code: KeyLeft}}},
+		{"\x1bOH\x1b[4~\x1b[5~\x1b[6~\x1b[3~", []Key{{Code: KeyHome}, {Code: KeyEnd}, {Code: KeyPageUp}, {Code: KeyPageDown}, {Code: KeyDelete}}},
+		{"\x1b[1;5Cx", []Key{{Code: KeyEsc}, {Code: KeyRune, Rune: 'x'}}},
+		{"\x1b[12", []Key{{Code: KeyEsc}}},
+		{"\x1bx", []Key{{Code: KeyEsc}, {Code: KeyRune, Rune: 'x'}}},
+	}
+	for _, tc := range cases {
+		if got := Decode([]byte(tc.in)); !reflect.DeepEqual(got, tc.want) {
+			t.Fatalf("Decode(%q) = %#v, want %#v", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestKeyIsCtrl(t *testing.T) {
+	if !(Key{Code: KeyCtrl, Rune: 'c'}).IsCtrl('c') || (Key{Code: KeyRune, Rune: 'c'}).IsCtrl('c') {
+		t.Fatalf("IsCtrl mismatch")
+	}
+}
+
+func TestReadKeys(t *testing.T) {
+	var got []Key
+	for key := range ReadKeys(strings.NewReader("ab\x1b[A")) {
+		got = append(got, key)
+	}
+	want := []Key{{Code: KeyRune, Rune: 'a'}, {Code: KeyRune, Rune: 'b'}, {Code: KeyUp}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %#v", got)
+	}
+}
@@ -0,0 +1,87 @@
+// Package tui holds the small amount of terminal plumbing behind the
+// interactive picker and the full-screen UI: raw mode, key decoding and
+// whole-screen redraws.
+package tui
+
+import (
+	"errors"
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+const (
+	enterAltScreen = "\x1b[?1049h\x1b[?25l"
+	leaveAltScreen = "\x1b[?25h\x1b[?1049l"
+)
+
+// Terminal is a raw-mode session on the alternate screen.
+type Terminal struct {
+	in    *os.File
+	out   io.Writer
+	fd    int
+	state *term.State
+}
+
+func Open(in *os.File, out io.Writer) (*Terminal, error) {
+	if in == nil || !term.IsTerminal(int(in.Fd())) {
+		return nil, errors.New("stdin is not a terminal")
+	}
+	fd := int(in.Fd())
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		return nil, err
+	}
+	t := &Terminal{in: in, out: out, fd: fd, state: state}
+	_, _ = io.WriteString(out, enterAltScreen)
+	return t, nil
+}
+
+func (t *Terminal) Close() error {
+	if t == nil || t.state == nil {
+		return nil
+	}
+	_, _ = io.WriteString(t.out, leaveAltScreen)
+	err := term.Restore(t.fd, t.state)
+	t.state = nil
+	return err
+}
+
+func (t *Terminal) Keys() <-chan Key {
+	return ReadKeys(t.in)
+}
+
+// Size reports the terminal size, falling back to 80x24.
+func (t *Terminal) Size() (int, int) {
+	width, height, err := term.GetSize(t.fd)
+	if err != nil || width <= 0 || height <= 0 {
+		return 80, 24
+	}
+	return width, height
+}
+
+func (t *Terminal) Draw(lines []string) {
+	Draw(t.out, lines)
+}
+
+func (t *Terminal) Writer() io.Writer {
+	return t.out
+}
+
+// Draw repaints the screen from the top-left corner. Raw mode disables
+// output post-processing, so lines end in an explicit CRLF.
+func Draw(out io.Writer, lines []string) {
+	var b strings.Builder
+	b.WriteString("\x1b[H")
+	for i, line := range lines {
+		if i > 0 {
+			b.WriteString("\r\n")
+		}
+		b.WriteString(line)
+		b.WriteString("\x1b[K")
+	}
+	b.WriteString("\x1b[J")
+	_, _ = io.WriteString(out, b.String())
+}
@@ -0,0 +1,59 @@
+package tui
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Truncate cuts s to width runes, marking the cut with an ellipsis.
+func Truncate(s string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	if utf8.RuneCountInString(s) <= width {
+		return s
+	}
+	if width == 1 {
+		return "…"
+	}
+	runes := []rune(s)
+	return string(runes[:width-1]) + "…"
+}
+
+// Pad right-pads s with spaces to width runes, truncating if needed.
+func Pad(s string, width int) string {
+	s = Truncate(s, width)
+	if n := utf8.RuneCountInString(s); n < width {
+		s += strings.Repeat(" ", width-n)
+	}
+	return s
+}
+
+// Reverse renders s in reverse video, used for the selected row.
+func Reverse(s string) string {
+	return "\x1b[7m" + s + "\x1b[0m"
+}
+
+// Dim renders s faint.
+func Dim(s string) string {
+	return "\x1b[2m" + s + "\x1b[0m"
+}
+
+// Window returns the [start, end) slice of a list of n rows that keeps
+// cursor visible in height rows.
+func Window(n, cursor, height int) (int, int) {
+	if height <= 0 || n == 0 {
+		return 0, 0
+	}
+	if n <= height {
+		return 0, n
+	}
+	start := cursor - height/2
+	if start < 0 {
+		start = 0
+	}
+	if start > n-height {
+		start = n - height
+	}
+	return start, start + height
+}
@@ -0,0 +1,100 @@
+package tui
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestTruncateAndPad(t *testing.T) {
+	if got := Truncate("héllo", 10); got != "héllo" {
+		t.Fatalf("got %q", got)
+	}
+	if got := Truncate("héllo", 3); got != "hé…" {
+		t.Fatalf("got %q", got)
+	}
+	if Truncate("abc", 1) != "…" || Truncate("abc", 0) != "" {
+		t.Fatalf("edge truncation")
+	}
+	if got := Pad("ab", 4); got != "ab  " {
+		t.Fatalf("got %q", got)
+	}
+	if got := Pad("abcdef", 4); got != "abc…" {
+		t.Fatalf("got %q", got)
+	}
+}
+
+func TestWindow(t *testing.T) {
+	cases := []struct{ n, cursor, height, start, end int }{
+		{0, 0, 5, 0, 0},
+		{3, 2, 5, 0, 3},
+		{20, 0, 5, 0, 5},
+		{20, 10, 5, 8, 13},
+		{20, 19, 5, 15, 20},
+		{20, 3, 0, 0, 0},
+	}
+	for _, tc := range cases {
+		start, end := Window(tc.n, tc.cursor, tc.height)
+		if start != tc.start || end != tc.end {
+			t.Fatalf("Window(%d,%d,%d) = %d,%d", tc.n, tc.cursor, tc.height, start, end)
+		}
+	}
+}
+
+func TestStyles(t *testing.T) {
+	if !strings.Contains(Reverse("x"), "\x1b[7mx") || !strings.Contains(Dim("x"), "\x1b[2mx") {
+		t.Fatalf("styles")
+	}
+}
+
+func TestDraw(t *testing.T) {
+	var buf bytes.Buffer
+	Draw(&buf, []string{"one", "two"})
+	if got := buf.String(); got != "\x1b[Hone\x1b[K\r\ntwo\x1b[K\x1b[J" {
+		t.Fatalf("got %q", got)
+	}
+}
+
+func TestOpenRequiresTerminal(t *testing.T) {
+	file, err := os.CreateTemp(t.TempDir(), "in")
+	if err != nil {
+		t.Fatalf("temp: %v", err)
+	}
+	defer file.Close()
+	if _, err := Open(file, &bytes.Buffer{}); err == nil {
+		t.Fatalf("expected error")
+	}
+	if _, err := Open(nil, &bytes.Buffer{}); err == nil {
+		t.Fatalf("expected error")
+	}
+	var nilTerm *Terminal
+	if err := nilTerm.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+}
+
+func TestTerminalHelpersWithoutRawMode(t *testing.T) {
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("pipe: %v", err)
+	}
+	var buf bytes.Buffer
+	term := &Terminal{in: reader, out: &buf, fd: int(reader.Fd())}
+	if width, height := term.Size(); width != 80 || height != 24 {
+		t.Fatalf("size %dx%d", width, height)
+	}
+	term.Draw([]string{"hi"})
+	if term.Writer() != &buf || !strings.Contains(buf.String(), "hi") {
+		t.Fatalf("draw %q", buf.String())
+	}
+	keys := term.Keys()
+	_, _ = writer.Write([]byte("q"))
+	if key := <-keys; key.Rune != 'q' {
+		t.Fatalf("key %#v", key)
+	}
+	_ = writer.Close()
+	for range keys {
+	}
+	_ = reader.Close()
+}